@@ -20,6 +20,7 @@ import (
 	"github.com/kubenext/lissio/internal/log"
 	"github.com/kubenext/lissio/internal/mime"
 	"github.com/kubenext/lissio/internal/module"
+	"github.com/kubenext/lissio/internal/objectstore"
 )
 
 //go:generate mockgen -destination=./fake/mock_service.go -package=fake github.com/kubenext/lissio/internal/api Service
@@ -151,7 +152,20 @@ func (a *API) Handler(ctx context.Context) (*mux.Router, error) {
 
 	s.HandleFunc("/logs/namespace/{namespace}/pod/{pod}/container/{container}", containerLogsHandler(ctx, a.dashConfig.ClusterClient()))
 
-	manager := NewWebsocketClientManager(ctx, a.actionDispatcher)
+	if reporter, ok := a.dashConfig.ObjectStore().(objectstore.StatsReporter); ok {
+		s.HandleFunc("/diagnostics/informers", informerStatsHandler(ctx, reporter)).Methods(http.MethodGet)
+	}
+
+	s.HandleFunc("/snapshot", snapshotHandler(ctx, a.dashConfig, objectstore.NewResourceAccess)).Methods(http.MethodGet)
+
+	registerRESTRoutes(ctx, s, a.dashConfig, a.actionDispatcher)
+
+	navigationCache := NewNavigationCache()
+	if err := navigationCache.Watch(ctx, a.dashConfig); err != nil {
+		return nil, err
+	}
+
+	manager := NewWebsocketClientManager(ctx, a.actionDispatcher, WebsocketStateNavigationCache(navigationCache))
 	go manager.Run(ctx)
 	s.Handle("/stream", websocketService(manager, a.dashConfig))
 
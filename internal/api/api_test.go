@@ -28,6 +28,7 @@ import (
 	"github.com/kubenext/lissio/internal/module"
 	moduleFake "github.com/kubenext/lissio/internal/module/fake"
 	"github.com/kubenext/lissio/pkg/navigation"
+	storeFake "github.com/kubenext/lissio/pkg/store/fake"
 	"github.com/kubenext/lissio/pkg/view/component"
 )
 
@@ -59,6 +60,14 @@ func TestAPI_routes(t *testing.T) {
 			dashConfig.EXPECT().Logger().Return(logger).AnyTimes()
 			clusterClient := clusterFake.NewMockClientInterface(controller)
 			dashConfig.EXPECT().ClusterClient().Return(clusterClient).AnyTimes()
+			objectStore := storeFake.NewMockStore(controller)
+			dashConfig.EXPECT().ObjectStore().Return(objectStore).AnyTimes()
+			crdWatcher := configFake.NewMockCRDWatcher(controller)
+			crdWatcher.EXPECT().Watch(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			dashConfig.EXPECT().CRDWatcher().Return(crdWatcher).AnyTimes()
+			moduleManager := moduleFake.NewMockManagerInterface(controller)
+			moduleManager.EXPECT().OnChange(gomock.Any()).Return(func() {}).AnyTimes()
+			dashConfig.EXPECT().ModuleManager().Return(moduleManager).AnyTimes()
 
 			m := moduleFake.NewMockModule(controller)
 			m.EXPECT().
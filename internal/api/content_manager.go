@@ -7,7 +7,10 @@ package api
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -16,7 +19,9 @@ import (
 	"github.com/kubenext/lissio/internal/event"
 	"github.com/kubenext/lissio/internal/log"
 	"github.com/kubenext/lissio/internal/module"
+	"github.com/kubenext/lissio/internal/objectstore"
 	"github.com/kubenext/lissio/pkg/action"
+	"github.com/kubenext/lissio/pkg/store"
 	"github.com/kubenext/lissio/pkg/view/component"
 )
 
@@ -25,6 +30,16 @@ const (
 	RequestSetNamespace   = "setNamespace"
 )
 
+const (
+	// contentDebounce is how long changes are collected before content
+	// is generated again.
+	contentDebounce = 500 * time.Millisecond
+	// contentResyncInterval is how often content is generated when nothing
+	// it watches changes. It catches changes which can't be watched, such
+	// as content from plugins.
+	contentResyncInterval = 30 * time.Second
+)
+
 // ContentManagerOption is an option for configuring ContentManager.
 type ContentManagerOption func(manager *ContentManager)
 
@@ -46,12 +61,33 @@ func WithContentGeneratorPoller(poller Poller) ContentManagerOption {
 	}
 }
 
-// ContentManager manages content for websockets.
+// WithContentWatcher configures the watcher used to find out when objects
+// content was generated from change. Without a watcher, content is
+// generated on an interval.
+func WithContentWatcher(watcher *objectstore.Watcher) ContentManagerOption {
+	return func(manager *ContentManager) {
+		manager.watcher = watcher
+	}
+}
+
+// WithContentKeysObserver configures a function which is called with the
+// keys content was generated from each time it is generated.
+func WithContentKeysObserver(fn func(keys []store.Key)) ContentManagerOption {
+	return func(manager *ContentManager) {
+		manager.keysObserver = fn
+	}
+}
+
+// ContentManager manages content for websockets. Content is generated
+// again when objects it was generated from change, and is only sent when
+// it is different from the content sent last.
 type ContentManager struct {
 	moduleManager       module.ManagerInterface
 	logger              log.Logger
 	contentGenerateFunc ContentGenerateFunc
 	poller              Poller
+	watcher             *objectstore.Watcher
+	keysObserver        func(keys []store.Key)
 	updateContentCh     chan struct{}
 }
 
@@ -60,7 +96,7 @@ func NewContentManager(moduleManager module.ManagerInterface, logger log.Logger,
 	cm := &ContentManager{
 		moduleManager:   moduleManager,
 		logger:          logger,
-		poller:          NewInterruptiblePoller("content"),
+		poller:          NewTriggeredPoller("content", contentDebounce),
 		updateContentCh: make(chan struct{}, 1),
 	}
 	cm.contentGenerateFunc = cm.generateContent
@@ -80,32 +116,110 @@ func (cm *ContentManager) Start(ctx context.Context, state controllers.State, s
 		close(cm.updateContentCh)
 	}()
 
+	updates := newContentUpdates(cm.moduleManager, cm.watcher, cm.logger, cm.updateContentCh)
+	defer updates.close()
+	updates.subscribe(state.GetContentPath())
+
 	updateCancel := state.OnContentPathUpdate(func(contentPath string) {
-		cm.updateContentCh <- struct{}{}
+		updates.subscribe(contentPath)
+		cm.interrupt()
 	})
 	defer updateCancel()
 
-	cm.poller.Run(ctx, cm.updateContentCh, cm.runUpdate(state, s), event.DefaultScheduleDelay)
+	cm.poller.Run(ctx, cm.updateContentCh, cm.runUpdate(state, s, updates), contentResyncInterval)
+}
+
+// interrupter is a Poller which can cancel its running action and run it
+// again straight away.
+type interrupter interface {
+	Interrupt()
 }
 
-func (cm *ContentManager) runUpdate(state controllers.State, s LissioClient) PollerFunc {
+// interrupt generates content straight away after a user changes the
+// content path, namespace, filters or table queries. Content being
+// generated for the previous state is cancelled. Changes to objects are
+// sent on updateContentCh so they are debounced instead.
+func (cm *ContentManager) interrupt() {
+	if poller, ok := cm.poller.(interrupter); ok {
+		poller.Interrupt()
+		return
+	}
+
+	cm.updateContentCh <- struct{}{}
+}
+
+func (cm *ContentManager) runUpdate(state controllers.State, s LissioClient, updates *contentUpdates) PollerFunc {
+	var sent contentEventHash
+
 	return func(ctx context.Context) bool {
 		contentPath := state.GetContentPath()
 		if contentPath == "" {
 			return false
 		}
 
-		contentResponse, _, err := cm.contentGenerateFunc(ctx, state)
+		recordCtx, recorder := store.WithKeyRecorder(ctx)
+		contentResponse, rerun, err := cm.contentGenerateFunc(recordCtx, state)
 		if err != nil {
 			return false
 		}
 
-		if ctx.Err() == nil {
-			s.Send(CreateContentEvent(contentResponse, state.GetNamespace(), contentPath, state.GetQueryParams()))
+		if rerun {
+			// The content path changed, e.g. to the parent of a path
+			// which wasn't found, so content is generated again for it.
+			return true
+		}
+
+		keys := recorder.Keys()
+		updates.watch(keys)
+		if cm.keysObserver != nil {
+			cm.keysObserver(keys)
+		}
+
+		if ctx.Err() != nil || state.GetContentPath() != contentPath {
+			// The content path changed while content was generated.
+			return false
+		}
+
+		contentEvent := CreateContentEvent(contentResponse, state.GetNamespace(), contentPath, state.GetQueryParams())
+		if !sent.changed(contentEvent) {
+			return false
 		}
 
+		s.Send(contentEvent)
+
+		return false
+	}
+}
+
+// contentEventHash remembers the last content event sent so identical
+// content isn't sent again.
+type contentEventHash struct {
+	sum [sha256.Size]byte
+	set bool
+
+	mu sync.Mutex
+}
+
+// changed returns true if contentEvent is different from the event
+// previously passed to changed.
+func (h *contentEventHash) changed(contentEvent controllers.Event) bool {
+	data, err := json.Marshal(contentEvent)
+	if err != nil {
+		return true
+	}
+
+	sum := sha256.Sum256(data)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.set && sum == h.sum {
 		return false
 	}
+
+	h.sum = sum
+	h.set = true
+	return true
 }
 
 func (cm *ContentManager) generateContent(ctx context.Context, state controllers.State) (component.ContentResponse, bool, error) {
@@ -123,7 +237,8 @@ func (cm *ContentManager) generateContent(ctx context.Context, state controllers
 	}
 	modulePath := strings.TrimPrefix(contentPath, m.Name())
 	options := module.ContentOptions{
-		LabelSet: FiltersToLabelSet(state.GetFilters()),
+		LabelSet:     FiltersToLabelSet(state.GetFilters()),
+		TableQueries: state.GetTableQueries(),
 	}
 	contentResponse, err := m.Content(ctx, modulePath, options)
 	if err != nil {
@@ -150,6 +265,10 @@ func (cm *ContentManager) Handlers() []controllers.ClientRequestHandler {
 			RequestType: RequestSetNamespace,
 			Handler:     cm.SetNamespace,
 		},
+		{
+			RequestType: RequestSetTableQuery,
+			Handler:     cm.SetTableQuery,
+		},
 	}
 }
 
@@ -164,11 +283,35 @@ func (cm *ContentManager) SetQueryParams(state controllers.State, payload action
 			}
 			state.SetFilters(list)
 		}
+
+		// Tables without a query in the params show their first page.
+		tableQueries := map[string]component.TableQuery{}
+		if tables, ok := params["tables"]; ok {
+			queries, err := TableQueriesFromQueryParams(tables)
+			if err != nil {
+				return errors.Wrap(err, "extract table queries from query params")
+			}
+			tableQueries = queries
+		}
+		state.SetTableQueries(tableQueries)
 	}
 
 	return nil
 }
 
+// SetTableQuery sets the query for a paginated table.
+func (cm *ContentManager) SetTableQuery(state controllers.State, payload action.Payload) error {
+	name, query, err := TableQueryFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	queries := state.GetTableQueries()
+	queries[name] = query
+	state.SetTableQueries(queries)
+	return nil
+}
+
 // SetNamespace sets the current namespace.
 func (cm *ContentManager) SetNamespace(state controllers.State, payload action.Payload) error {
 	namespace, err := payload.String("namespace")
@@ -193,6 +336,152 @@ func (cm *ContentManager) SetContentPath(state controllers.State, payload action
 	return nil
 }
 
+// contentUpdates requests a content update when a module reports the
+// content for the current content path has changed, or when objects the
+// content was generated from change.
+type contentUpdates struct {
+	moduleManager module.ManagerInterface
+	watcher       *objectstore.Watcher
+	logger        log.Logger
+	ch            chan<- struct{}
+	cancel        func()
+	watches       map[store.Key]func()
+	pollTimer     *time.Timer
+	closed        bool
+
+	mu sync.Mutex
+}
+
+func newContentUpdates(moduleManager module.ManagerInterface, watcher *objectstore.Watcher, logger log.Logger, ch chan<- struct{}) *contentUpdates {
+	return &contentUpdates{
+		moduleManager: moduleManager,
+		watcher:       watcher,
+		logger:        logger,
+		ch:            ch,
+		cancel:        func() {},
+		watches:       make(map[store.Key]func()),
+	}
+}
+
+// subscribe replaces the current subscription with one for contentPath.
+func (cu *contentUpdates) subscribe(contentPath string) {
+	cu.mu.Lock()
+	defer cu.mu.Unlock()
+
+	cu.cancel()
+	cu.cancel = func() {}
+
+	if cu.closed || contentPath == "" {
+		return
+	}
+
+	m, ok := cu.moduleManager.ModuleForContentPath(contentPath)
+	if !ok {
+		return
+	}
+
+	notifier, ok := m.(module.ContentUpdateNotifier)
+	if !ok {
+		return
+	}
+
+	modulePath := strings.TrimPrefix(contentPath, m.Name())
+	cu.cancel = notifier.OnContentUpdate(modulePath, cu.trigger)
+}
+
+// watch replaces the store subscriptions with ones for the keys content
+// was generated from. Content which wasn't generated from the store can't
+// be watched, so it is generated again after event.DefaultScheduleDelay.
+func (cu *contentUpdates) watch(keys []store.Key) {
+	cu.mu.Lock()
+	defer cu.mu.Unlock()
+
+	if cu.pollTimer != nil {
+		cu.pollTimer.Stop()
+		cu.pollTimer = nil
+	}
+
+	if cu.closed {
+		return
+	}
+
+	current := make(map[store.Key]bool)
+	for _, key := range keys {
+		current[contentWatchKey(key)] = true
+	}
+
+	for key, cancel := range cu.watches {
+		if !current[key] {
+			cancel()
+			delete(cu.watches, key)
+		}
+	}
+
+	poll := cu.watcher == nil || len(current) == 0
+
+	if cu.watcher != nil {
+		for key := range current {
+			if _, ok := cu.watches[key]; ok {
+				continue
+			}
+
+			cancel, err := cu.watcher.Subscribe(context.Background(), key, cu.trigger)
+			if err != nil {
+				cu.logger.WithErr(err).Debugf("unable to watch content objects")
+				poll = true
+				continue
+			}
+
+			cu.watches[key] = cancel
+		}
+	}
+
+	if poll {
+		cu.pollTimer = time.AfterFunc(event.DefaultScheduleDelay, cu.trigger)
+	}
+}
+
+// trigger requests a content update. Requests are dropped if one is already pending.
+func (cu *contentUpdates) trigger() {
+	cu.mu.Lock()
+	defer cu.mu.Unlock()
+
+	if cu.closed {
+		return
+	}
+
+	select {
+	case cu.ch <- struct{}{}:
+	default:
+	}
+}
+
+func (cu *contentUpdates) close() {
+	cu.mu.Lock()
+	defer cu.mu.Unlock()
+
+	cu.cancel()
+	for key, cancel := range cu.watches {
+		cancel()
+		delete(cu.watches, key)
+	}
+	if cu.pollTimer != nil {
+		cu.pollTimer.Stop()
+	}
+	cu.closed = true
+}
+
+// contentWatchKey returns the key watched for a key content was generated
+// from. Changes to any object of the key's kind in its namespace are
+// watched because lists and label selectors can match new objects.
+func contentWatchKey(key store.Key) store.Key {
+	return store.Key{
+		Namespace:  key.Namespace,
+		APIVersion: key.APIVersion,
+		Kind:       key.Kind,
+	}
+}
+
 type notFound interface {
 	NotFound() bool
 	Path() string
@@ -8,6 +8,7 @@ package api_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -17,8 +18,12 @@ import (
 	"github.com/kubenext/lissio/internal/controllers"
 	lissioFake "github.com/kubenext/lissio/internal/controllers/fake"
 	"github.com/kubenext/lissio/internal/log"
+	"github.com/kubenext/lissio/internal/module"
 	moduleFake "github.com/kubenext/lissio/internal/module/fake"
+	"github.com/kubenext/lissio/internal/objectstore"
 	"github.com/kubenext/lissio/pkg/action"
+	"github.com/kubenext/lissio/pkg/store"
+	storeFake "github.com/kubenext/lissio/pkg/store/fake"
 	"github.com/kubenext/lissio/pkg/view/component"
 )
 
@@ -34,6 +39,7 @@ func TestContentManager_Handlers(t *testing.T) {
 	AssertHandlers(t, manager, []string{
 		api.RequestSetContentPath,
 		api.RequestSetNamespace,
+		api.RequestSetTableQuery,
 	})
 }
 
@@ -46,13 +52,14 @@ func TestContentManager_GenerateContent(t *testing.T) {
 	moduleManager := moduleFake.NewMockManagerInterface(controller)
 	state := lissioFake.NewMockState(controller)
 
-	state.EXPECT().GetContentPath().Return("/path")
+	state.EXPECT().GetContentPath().Return("/path").Times(3)
 	state.EXPECT().GetNamespace().Return("default")
 	state.EXPECT().GetQueryParams().Return(params)
 	state.EXPECT().OnContentPathUpdate(gomock.Any()).DoAndReturn(func(fn controllers.ContentPathUpdateFunc) controllers.UpdateCancelFunc {
 		fn("foo")
 		return func() {}
 	})
+	moduleManager.EXPECT().ModuleForContentPath(gomock.Any()).Return(nil, false).AnyTimes()
 	lissioClient := fake.NewMockLissioClient(controller)
 
 	contentResponse := component.ContentResponse{
@@ -76,6 +83,144 @@ func TestContentManager_GenerateContent(t *testing.T) {
 	manager.Start(ctx, state, lissioClient)
 }
 
+func TestContentManager_GenerateContent_unchanged(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	params := map[string][]string{}
+
+	moduleManager := moduleFake.NewMockManagerInterface(controller)
+	state := lissioFake.NewMockState(controller)
+
+	state.EXPECT().GetContentPath().Return("/path").AnyTimes()
+	state.EXPECT().GetNamespace().Return("default").AnyTimes()
+	state.EXPECT().GetQueryParams().Return(params).AnyTimes()
+	state.EXPECT().OnContentPathUpdate(gomock.Any()).Return(func() {})
+	moduleManager.EXPECT().ModuleForContentPath(gomock.Any()).Return(nil, false).AnyTimes()
+
+	contentResponse := component.ContentResponse{
+		IconName: "fake",
+	}
+
+	lissioClient := fake.NewMockLissioClient(controller)
+	lissioClient.EXPECT().Send(api.CreateContentEvent(contentResponse, "default", "/path", params))
+
+	key := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod", Name: "pod"}
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().RegisterOnUpdate(gomock.Any())
+	objectStore.EXPECT().
+		Watch(gomock.Any(), store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod"}, gomock.Any()).
+		Return(nil)
+
+	contentGenerator := func(ctx context.Context, state controllers.State) (component.ContentResponse, bool, error) {
+		store.RecordKey(ctx, key)
+		return contentResponse, false, nil
+	}
+
+	manager := api.NewContentManager(moduleManager, log.NopLogger(),
+		api.WithContentGenerator(contentGenerator),
+		api.WithContentGeneratorPoller(&repeatPoller{times: 3}),
+		api.WithContentWatcher(objectstore.NewWatcher(objectStore, log.NopLogger())))
+
+	manager.Start(context.Background(), state, lissioClient)
+}
+
+func TestContentManager_GenerateContent_rerun(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	params := map[string][]string{}
+
+	moduleManager := moduleFake.NewMockManagerInterface(controller)
+	state := lissioFake.NewMockState(controller)
+
+	state.EXPECT().GetContentPath().Return("/path").AnyTimes()
+	state.EXPECT().GetNamespace().Return("default").AnyTimes()
+	state.EXPECT().GetQueryParams().Return(params).AnyTimes()
+	state.EXPECT().OnContentPathUpdate(gomock.Any()).Return(func() {})
+	moduleManager.EXPECT().ModuleForContentPath(gomock.Any()).Return(nil, false).AnyTimes()
+
+	contentResponse := component.ContentResponse{
+		IconName: "fake",
+	}
+
+	lissioClient := fake.NewMockLissioClient(controller)
+	lissioClient.EXPECT().Send(api.CreateContentEvent(contentResponse, "default", "/path", params))
+
+	runs := 0
+	contentGenerator := func(ctx context.Context, state controllers.State) (component.ContentResponse, bool, error) {
+		runs++
+		if runs == 1 {
+			return component.EmptyContentResponse, true, nil
+		}
+		return contentResponse, false, nil
+	}
+
+	manager := api.NewContentManager(moduleManager, log.NopLogger(),
+		api.WithContentGenerator(contentGenerator),
+		api.WithContentGeneratorPoller(&rerunPoller{}))
+
+	manager.Start(context.Background(), state, lissioClient)
+
+	require.Equal(t, 2, runs)
+}
+
+func TestContentManager_content_path_update_interrupts(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	moduleManager := moduleFake.NewMockManagerInterface(controller)
+	moduleManager.EXPECT().ModuleForContentPath(gomock.Any()).Return(nil, false).AnyTimes()
+
+	state := lissioFake.NewMockState(controller)
+	state.EXPECT().GetContentPath().Return("").AnyTimes()
+	state.EXPECT().OnContentPathUpdate(gomock.Any()).DoAndReturn(func(fn controllers.ContentPathUpdateFunc) controllers.UpdateCancelFunc {
+		fn("overview/namespace/default")
+		return func() {}
+	})
+
+	poller := &interruptPoller{}
+	manager := api.NewContentManager(moduleManager, log.NopLogger(),
+		api.WithContentGeneratorPoller(poller))
+
+	manager.Start(context.Background(), state, fake.NewMockLissioClient(controller))
+
+	// Changes the user makes interrupt the poller instead of being debounced.
+	require.Equal(t, 1, poller.interrupts)
+}
+
+// interruptPoller counts interrupts.
+type interruptPoller struct {
+	interrupts int
+}
+
+func (p *interruptPoller) Run(ctx context.Context, ch <-chan struct{}, action api.PollerFunc, resetDuration time.Duration) {
+}
+
+func (p *interruptPoller) Interrupt() {
+	p.interrupts++
+}
+
+// rerunPoller runs the action until it doesn't ask to be rerun.
+type rerunPoller struct{}
+
+func (p *rerunPoller) Run(ctx context.Context, ch <-chan struct{}, action api.PollerFunc, resetDuration time.Duration) {
+	for action(ctx) {
+	}
+}
+
+// repeatPoller runs the action a number of times.
+type repeatPoller struct {
+	times int
+}
+
+func (p *repeatPoller) Run(ctx context.Context, ch <-chan struct{}, action api.PollerFunc, resetDuration time.Duration) {
+	for i := 0; i < p.times; i++ {
+		action(ctx)
+	}
+}
+
 func TestContentManager_SetContentPath(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
@@ -141,6 +286,7 @@ func TestContentManager_SetQueryParams(t *testing.T) {
 				state.EXPECT().SetFilters([]controllers.Filter{
 					{Key: "foo", Value: "bar"},
 				})
+				state.EXPECT().SetTableQueries(map[string]component.TableQuery{})
 			},
 		},
 		{
@@ -158,6 +304,24 @@ func TestContentManager_SetQueryParams(t *testing.T) {
 					{Key: "foo", Value: "bar"},
 					{Key: "baz", Value: "qux"},
 				})
+				state.EXPECT().SetTableQueries(map[string]component.TableQuery{})
+			},
+		},
+		{
+			name: "table queries",
+			payload: action.Payload{
+				"params": map[string]interface{}{
+					"tables": []interface{}{
+						"table=Pods&page=2&pageSize=50&sortBy=Age&reverse=true",
+						"table=Replica+Sets&filter=web",
+					},
+				},
+			},
+			setup: func(state *lissioFake.MockState) {
+				state.EXPECT().SetTableQueries(map[string]component.TableQuery{
+					"Pods":         {Page: 2, PageSize: 50, SortBy: "Age", Reverse: true},
+					"Replica Sets": {Filter: "web"},
+				})
 			},
 		},
 	}
@@ -184,3 +348,97 @@ func TestContentManager_SetQueryParams(t *testing.T) {
 		})
 	}
 }
+
+func TestContentManager_SetTableQuery(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	moduleManager := moduleFake.NewMockManagerInterface(controller)
+
+	state := lissioFake.NewMockState(controller)
+	state.EXPECT().GetTableQueries().Return(map[string]component.TableQuery{
+		"Deployments": {Page: 3},
+	})
+	state.EXPECT().SetTableQueries(map[string]component.TableQuery{
+		"Deployments": {Page: 3},
+		"Pods":        {Page: 2, PageSize: 20, SortBy: "Name", Reverse: true, Filter: "web"},
+	})
+
+	manager := api.NewContentManager(moduleManager, log.NopLogger(),
+		api.WithContentGeneratorPoller(api.NewSingleRunPoller()))
+
+	payload := action.Payload{
+		"table":    "Pods",
+		"page":     float64(2),
+		"pageSize": float64(20),
+		"sortBy":   "Name",
+		"reverse":  true,
+		"filter":   "web",
+	}
+	require.NoError(t, manager.SetTableQuery(state, payload))
+
+	require.Error(t, manager.SetTableQuery(state, action.Payload{}))
+}
+
+func TestContentManager_ContentUpdates(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	m := &notifyingModule{MockModule: moduleFake.NewMockModule(controller)}
+	m.EXPECT().Name().Return("module").AnyTimes()
+
+	moduleManager := moduleFake.NewMockManagerInterface(controller)
+	moduleManager.EXPECT().ModuleForContentPath("module/path").Return(m, true)
+
+	state := lissioFake.NewMockState(controller)
+	state.EXPECT().GetContentPath().Return("module/path")
+	state.EXPECT().OnContentPathUpdate(gomock.Any()).Return(func() {})
+
+	lissioClient := fake.NewMockLissioClient(controller)
+
+	poller := &updatePoller{module: m}
+
+	manager := api.NewContentManager(moduleManager, log.NopLogger(),
+		api.WithContentGeneratorPoller(poller))
+
+	manager.Start(context.Background(), state, lissioClient)
+
+	require.Equal(t, "/path", m.contentPath)
+	require.True(t, poller.updated)
+	require.True(t, m.canceled)
+}
+
+type notifyingModule struct {
+	*moduleFake.MockModule
+
+	contentPath string
+	fn          func()
+	canceled    bool
+}
+
+var _ module.ContentUpdateNotifier = (*notifyingModule)(nil)
+
+func (m *notifyingModule) OnContentUpdate(contentPath string, fn func()) func() {
+	m.contentPath = contentPath
+	m.fn = fn
+	return func() {
+		m.canceled = true
+	}
+}
+
+// updatePoller triggers a content update from the module and records
+// whether the content manager requested an update.
+type updatePoller struct {
+	module  *notifyingModule
+	updated bool
+}
+
+func (p *updatePoller) Run(ctx context.Context, ch <-chan struct{}, action api.PollerFunc, resetDuration time.Duration) {
+	p.module.fn()
+
+	select {
+	case <-ch:
+		p.updated = true
+	default:
+	}
+}
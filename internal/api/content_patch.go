@@ -0,0 +1,208 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/kubenext/lissio/internal/controllers"
+	"github.com/kubenext/lissio/pkg/action"
+)
+
+// contentPatchMaxRatio is the largest size of a patch, relative to the
+// content it updates, which is sent instead of the content.
+const contentPatchMaxRatio = 0.5
+
+// contentDiffer converts content events into content patch events. It
+// keeps the last content sent to a client and sends RFC 6902 JSON patches
+// against it when the change is small. Clients apply the patch to the
+// data of the last content or content patch event.
+type contentDiffer struct {
+	last interface{}
+}
+
+// event returns the event to send for an event. Events which aren't
+// content events are returned unchanged.
+func (d *contentDiffer) event(ev controllers.Event) controllers.Event {
+	if ev.Type != controllers.EventTypeContent {
+		return ev
+	}
+
+	data, err := json.Marshal(ev.Data)
+	if err != nil {
+		d.last = nil
+		return ev
+	}
+
+	current, err := decodeJSONDocument(data)
+	if err != nil {
+		d.last = nil
+		return ev
+	}
+
+	previous := d.last
+	d.last = current
+
+	if previous == nil {
+		return ev
+	}
+
+	operations := createJSONPatch(previous, current)
+	if len(operations) == 0 {
+		return ev
+	}
+
+	patch, err := json.Marshal(operations)
+	if err != nil || float64(len(patch)) > float64(len(data))*contentPatchMaxRatio {
+		return ev
+	}
+
+	return CreateEvent(controllers.EventTypeContentPatch, action.Payload{
+		"patch": json.RawMessage(patch),
+	})
+}
+
+// decodeJSONDocument decodes JSON keeping numbers as they were encoded.
+func decodeJSONDocument(data []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return nil, errors.Wrap(err, "decode json document")
+	}
+
+	return document, nil
+}
+
+// jsonPatchOperation is an RFC 6902 JSON patch operation.
+type jsonPatchOperation struct {
+	Op    string
+	Path  string
+	Value interface{}
+}
+
+// MarshalJSON marshals the operation. Remove operations don't have a value.
+func (o jsonPatchOperation) MarshalJSON() ([]byte, error) {
+	m := map[string]interface{}{
+		"op":   o.Op,
+		"path": o.Path,
+	}
+
+	if o.Op != "remove" {
+		m["value"] = o.Value
+	}
+
+	return json.Marshal(m)
+}
+
+// createJSONPatch creates the operations which turn the document a into b.
+// Documents are values decoded from JSON.
+func createJSONPatch(a, b interface{}) []jsonPatchOperation {
+	var operations []jsonPatchOperation
+	diffJSON("", a, b, &operations)
+	return operations
+}
+
+func diffJSON(path string, a, b interface{}, operations *[]jsonPatchOperation) {
+	if reflect.DeepEqual(a, b) {
+		return
+	}
+
+	switch a := a.(type) {
+	case map[string]interface{}:
+		if b, ok := b.(map[string]interface{}); ok {
+			diffJSONObject(path, a, b, operations)
+			return
+		}
+	case []interface{}:
+		if b, ok := b.([]interface{}); ok {
+			diffJSONArray(path, a, b, operations)
+			return
+		}
+	}
+
+	*operations = append(*operations, jsonPatchOperation{Op: "replace", Path: path, Value: b})
+}
+
+func diffJSONObject(path string, a, b map[string]interface{}, operations *[]jsonPatchOperation) {
+	var removed []string
+	for key := range a {
+		if _, ok := b[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+	sort.Strings(removed)
+
+	for _, key := range removed {
+		*operations = append(*operations, jsonPatchOperation{Op: "remove", Path: jsonPointer(path, key)})
+	}
+
+	var keys []string
+	for key := range b {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value, ok := a[key]
+		if !ok {
+			*operations = append(*operations, jsonPatchOperation{Op: "add", Path: jsonPointer(path, key), Value: b[key]})
+			continue
+		}
+
+		diffJSON(jsonPointer(path, key), value, b[key], operations)
+	}
+}
+
+// diffJSONArray diffs arrays. Items at the start and end which are the
+// same are skipped, so inserting or removing items, such as table rows,
+// creates a small patch.
+func diffJSONArray(path string, a, b []interface{}, operations *[]jsonPatchOperation) {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && reflect.DeepEqual(a[prefix], b[prefix]) {
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix &&
+		reflect.DeepEqual(a[len(a)-1-suffix], b[len(b)-1-suffix]) {
+		suffix++
+	}
+
+	aMiddle := a[prefix : len(a)-suffix]
+	bMiddle := b[prefix : len(b)-suffix]
+
+	if len(aMiddle) == len(bMiddle) {
+		for i := range aMiddle {
+			diffJSON(jsonPointer(path, strconv.Itoa(prefix+i)), aMiddle[i], bMiddle[i], operations)
+		}
+		return
+	}
+
+	// Remove from the end so earlier indexes stay the same.
+	for i := len(aMiddle) - 1; i >= 0; i-- {
+		*operations = append(*operations, jsonPatchOperation{Op: "remove", Path: jsonPointer(path, strconv.Itoa(prefix+i))})
+	}
+
+	for i := range bMiddle {
+		*operations = append(*operations, jsonPatchOperation{Op: "add", Path: jsonPointer(path, strconv.Itoa(prefix+i)), Value: bMiddle[i]})
+	}
+}
+
+var jsonPointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// jsonPointer appends a reference token to a JSON pointer.
+func jsonPointer(path, token string) string {
+	return path + "/" + jsonPointerEscaper.Replace(token)
+}
@@ -0,0 +1,151 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubenext/lissio/internal/controllers"
+	"github.com/kubenext/lissio/pkg/view/component"
+)
+
+func Test_createJSONPatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		expected string
+	}{
+		{
+			name:     "same",
+			a:        `{"a":[1,2],"b":"c"}`,
+			b:        `{"a":[1,2],"b":"c"}`,
+			expected: `null`,
+		},
+		{
+			name:     "object fields",
+			a:        `{"a":1,"b":{"c":"d"},"e":true}`,
+			b:        `{"a":2,"b":{"c":"f"},"g":null}`,
+			expected: `[{"op":"remove","path":"/e"},{"op":"replace","path":"/a","value":2},{"op":"replace","path":"/b/c","value":"f"},{"op":"add","path":"/g","value":null}]`,
+		},
+		{
+			name:     "escaped keys",
+			a:        `{"a/b":1,"c~d":1}`,
+			b:        `{"a/b":2,"c~d":2}`,
+			expected: `[{"op":"replace","path":"/a~1b","value":2},{"op":"replace","path":"/c~0d","value":2}]`,
+		},
+		{
+			name:     "array item added",
+			a:        `{"rows":[1,2,4]}`,
+			b:        `{"rows":[1,2,3,4]}`,
+			expected: `[{"op":"add","path":"/rows/2","value":3}]`,
+		},
+		{
+			name:     "array items removed",
+			a:        `{"rows":[1,2,3,4]}`,
+			b:        `{"rows":[1,4]}`,
+			expected: `[{"op":"remove","path":"/rows/2"},{"op":"remove","path":"/rows/1"}]`,
+		},
+		{
+			name:     "array item changed",
+			a:        `{"rows":[{"name":"a"},{"name":"b"}]}`,
+			b:        `{"rows":[{"name":"a"},{"name":"c"}]}`,
+			expected: `[{"op":"replace","path":"/rows/1/name","value":"c"}]`,
+		},
+		{
+			name:     "type changed",
+			a:        `{"a":[1]}`,
+			b:        `{"a":{"b":1}}`,
+			expected: `[{"op":"replace","path":"/a","value":{"b":1}}]`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a, err := decodeJSONDocument([]byte(test.a))
+			require.NoError(t, err)
+			b, err := decodeJSONDocument([]byte(test.b))
+			require.NoError(t, err)
+
+			operations := createJSONPatch(a, b)
+
+			actual, err := json.Marshal(operations)
+			require.NoError(t, err)
+			assert.JSONEq(t, test.expected, string(actual))
+
+			if len(operations) == 0 {
+				return
+			}
+
+			patch, err := jsonpatch.DecodePatch(actual)
+			require.NoError(t, err)
+			patched, err := patch.Apply([]byte(test.a))
+			require.NoError(t, err)
+			assert.JSONEq(t, test.b, string(patched))
+		})
+	}
+}
+
+func Test_contentDiffer(t *testing.T) {
+	var d contentDiffer
+
+	other := CreateEvent(controllers.EventTypeNamespaces, nil)
+	assert.Equal(t, other, d.event(other))
+
+	first := contentEvent(t, 20)
+	assert.Equal(t, first, d.event(first))
+
+	// A small change is sent as a patch.
+	second := contentEvent(t, 21)
+	patchEvent := d.event(second)
+	require.Equal(t, controllers.EventTypeContentPatch, patchEvent.Type)
+
+	patchData, err := json.Marshal(patchEvent.Data)
+	require.NoError(t, err)
+	var payload struct {
+		Patch json.RawMessage `json:"patch"`
+	}
+	require.NoError(t, json.Unmarshal(patchData, &payload))
+
+	patch, err := jsonpatch.DecodePatch(payload.Patch)
+	require.NoError(t, err)
+	patched, err := patch.Apply(marshalEventData(t, first))
+	require.NoError(t, err)
+	assert.JSONEq(t, string(marshalEventData(t, second)), string(patched))
+
+	// The same content is sent in full.
+	assert.Equal(t, second, d.event(second))
+
+	// A large change is sent in full.
+	third := contentEvent(t, 2)
+	assert.Equal(t, third, d.event(third))
+}
+
+func contentEvent(t *testing.T, rows int) controllers.Event {
+	table := component.NewTableWithRows("Pods", "placeholder", component.NewTableCols("Name"), nil)
+	for i := 0; i < rows; i++ {
+		table.Add(component.TableRow{"Name": component.NewText(fmt.Sprintf("pod-%d", i))})
+	}
+
+	contentResponse := component.ContentResponse{
+		Title:      component.TitleFromString("Pods"),
+		Components: []component.Component{table},
+	}
+
+	return CreateContentEvent(contentResponse, "default", "overview/namespace/default/workloads/pods", nil)
+}
+
+func marshalEventData(t *testing.T, event controllers.Event) []byte {
+	data, err := json.Marshal(event.Data)
+	require.NoError(t, err)
+	return data
+}
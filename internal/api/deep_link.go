@@ -0,0 +1,141 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package api
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/kubenext/lissio/internal/controllers"
+	"github.com/kubenext/lissio/pkg/view/component"
+)
+
+const (
+	// DeepLinkParam is the stream query parameter with the link the
+	// client was opened with.
+	DeepLinkParam = "link"
+
+	linkContextParam   = "context"
+	linkNamespaceParam = "namespace"
+	linkFiltersParam   = "filters"
+	linkTablesParam    = "tables"
+)
+
+// DeepLink describes a view in the dashboard: the context, namespace and
+// content path, the label filters, the selected tab and how tables are
+// sorted and paged. Opening the link shows the same view.
+type DeepLink struct {
+	Context      string
+	Namespace    string
+	ContentPath  string
+	Filters      []controllers.Filter
+	TableQueries map[string]component.TableQuery
+	Tab          string
+}
+
+// String returns the canonical encoding of the link. It is the fragment
+// of a dashboard URL, e.g.
+// `#/overview/namespace/default?context=dev&filters=app:nginx#pods`.
+// Parameters and filters are sorted so a view always has the same link.
+func (l DeepLink) String() string {
+	var segments []string
+	for _, segment := range strings.Split(strings.Trim(l.ContentPath, "/"), "/") {
+		segments = append(segments, url.PathEscape(segment))
+	}
+
+	values := url.Values{}
+	if l.Context != "" {
+		values.Set(linkContextParam, l.Context)
+	}
+	if l.Namespace != "" {
+		values.Set(linkNamespaceParam, l.Namespace)
+	}
+
+	var filters []string
+	for _, filter := range l.Filters {
+		filters = append(filters, filter.ToQueryParam())
+	}
+	sort.Strings(filters)
+	values[linkFiltersParam] = filters
+
+	values[linkTablesParam] = TableQueriesToQueryParams(l.TableQueries)
+
+	s := "#/" + strings.Join(segments, "/")
+	if query := values.Encode(); query != "" {
+		s += "?" + query
+	}
+	if l.Tab != "" {
+		s += "#" + url.PathEscape(l.Tab)
+	}
+
+	return s
+}
+
+// ParseDeepLink parses a link. The link can be a dashboard URL or its
+// fragment.
+func ParseDeepLink(s string) (DeepLink, error) {
+	// The fragment is split from the link by hand because url.URL only
+	// keeps the unescaped fragment, which would mangle escaped query
+	// parameters such as table queries.
+	i := strings.Index(s, "#")
+	if i < 0 {
+		return DeepLink{}, errors.Errorf("link %q does not have a view", s)
+	}
+	fragment := s[i+1:]
+
+	var link DeepLink
+
+	if i := strings.Index(fragment, "#"); i >= 0 {
+		tab, err := url.PathUnescape(fragment[i+1:])
+		if err != nil {
+			return DeepLink{}, errors.Wrap(err, "parse link tab")
+		}
+		link.Tab = tab
+		fragment = fragment[:i]
+	}
+
+	rawQuery := ""
+	if i := strings.Index(fragment, "?"); i >= 0 {
+		rawQuery = fragment[i+1:]
+		fragment = fragment[:i]
+	}
+
+	contentPath, err := url.PathUnescape(strings.Trim(fragment, "/"))
+	if err != nil {
+		return DeepLink{}, errors.Wrap(err, "parse link content path")
+	}
+	link.ContentPath = contentPath
+
+	values, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return DeepLink{}, errors.Wrap(err, "parse link query")
+	}
+
+	link.Context = values.Get(linkContextParam)
+	link.Namespace = values.Get(linkNamespaceParam)
+
+	for _, raw := range values[linkFiltersParam] {
+		filter, err := ParseFilterQueryParam(raw)
+		if err != nil {
+			return DeepLink{}, err
+		}
+		link.Filters = append(link.Filters, filter)
+	}
+
+	link.TableQueries = map[string]component.TableQuery{}
+	for _, raw := range values[linkTablesParam] {
+		name, query, err := ParseTableQueryParam(raw)
+		if err != nil {
+			return DeepLink{}, err
+		}
+		link.TableQueries[name] = query
+	}
+
+	return link, nil
+}
@@ -0,0 +1,130 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package api_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubenext/lissio/internal/api"
+	"github.com/kubenext/lissio/internal/controllers"
+	"github.com/kubenext/lissio/pkg/view/component"
+)
+
+func TestDeepLink_String(t *testing.T) {
+	tests := []struct {
+		name     string
+		link     api.DeepLink
+		expected string
+	}{
+		{
+			name:     "content path",
+			link:     api.DeepLink{ContentPath: "overview/namespace/default"},
+			expected: "#/overview/namespace/default",
+		},
+		{
+			name: "full view",
+			link: api.DeepLink{
+				Context:     "dev",
+				Namespace:   "default",
+				ContentPath: "overview/namespace/default/workloads/pods/nginx",
+				Filters: []controllers.Filter{
+					{Key: "tier", Value: "web"},
+					{Key: "app", Value: "nginx"},
+				},
+				TableQueries: map[string]component.TableQuery{
+					"Pods": {Page: 2, SortBy: "Age"},
+				},
+				Tab: "yaml",
+			},
+			expected: "#/overview/namespace/default/workloads/pods/nginx" +
+				"?context=dev&filters=app%3Anginx&filters=tier%3Aweb&namespace=default" +
+				"&tables=page%3D2%26sortBy%3DAge%26table%3DPods#yaml",
+		},
+		{
+			name:     "escaped content path",
+			link:     api.DeepLink{ContentPath: "cluster-overview/rbac/cluster-roles/system:node proxier"},
+			expected: "#/cluster-overview/rbac/cluster-roles/system:node%20proxier",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.link.String())
+		})
+	}
+}
+
+func TestParseDeepLink(t *testing.T) {
+	link := api.DeepLink{
+		Context:     "dev",
+		Namespace:   "default",
+		ContentPath: "overview/namespace/default/workloads/pods/nginx",
+		Filters: []controllers.Filter{
+			{Key: "app", Value: "nginx"},
+		},
+		TableQueries: map[string]component.TableQuery{
+			"Pods": {Page: 2, PageSize: 50, SortBy: "Age", Reverse: true},
+		},
+		Tab: "yaml",
+	}
+
+	tests := []struct {
+		name     string
+		in       string
+		expected api.DeepLink
+		isErr    bool
+	}{
+		{
+			name:     "fragment",
+			in:       link.String(),
+			expected: link,
+		},
+		{
+			name:     "url",
+			in:       "http://127.0.0.1:7777/" + link.String(),
+			expected: link,
+		},
+		{
+			name: "content path",
+			in:   "http://127.0.0.1:7777/#/overview/namespace/default",
+			expected: api.DeepLink{
+				ContentPath:  "overview/namespace/default",
+				TableQueries: map[string]component.TableQuery{},
+			},
+		},
+		{
+			name:  "no view",
+			in:    "http://127.0.0.1:7777/",
+			isErr: true,
+		},
+		{
+			name:  "invalid filter",
+			in:    "#/overview?filters=app",
+			isErr: true,
+		},
+		{
+			name:  "invalid table query",
+			in:    "#/overview?tables=page%3D2",
+			isErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := api.ParseDeepLink(test.in)
+			if test.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
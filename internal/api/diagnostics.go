@@ -0,0 +1,35 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/kubenext/lissio/internal/log"
+	"github.com/kubenext/lissio/internal/objectstore"
+)
+
+type informerStatsResponse struct {
+	Informers []objectstore.InformerStats `json:"informers"`
+}
+
+// informerStatsHandler serves the object count and approximate size of
+// each group version kind cached by the object store.
+func informerStatsHandler(ctx context.Context, reporter objectstore.StatsReporter) http.HandlerFunc {
+	logger := log.From(ctx)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		resp := informerStatsResponse{
+			Informers: reporter.Stats(),
+		}
+		if resp.Informers == nil {
+			resp.Informers = []objectstore.InformerStats{}
+		}
+
+		serveAsJSON(w, resp, logger)
+	}
+}
@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package api
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/kubenext/lissio/internal/controllers"
+	"github.com/kubenext/lissio/pkg/action"
+)
+
+const (
+	RequestCopyLink = "copyLink"
+)
+
+// LinkManagerConfig is configuration for LinkManager.
+type LinkManagerConfig interface {
+	ContextName() string
+}
+
+// LinkManager creates links to the view a client is showing.
+type LinkManager struct {
+	config LinkManagerConfig
+
+	client LissioClient
+	mu     sync.Mutex
+}
+
+var _ StateManager = (*LinkManager)(nil)
+
+// NewLinkManager creates an instance of LinkManager.
+func NewLinkManager(config LinkManagerConfig) *LinkManager {
+	return &LinkManager{
+		config: config,
+	}
+}
+
+// Start starts the manager. Links are sent to the client.
+func (lm *LinkManager) Start(ctx context.Context, state controllers.State, s LissioClient) {
+	lm.mu.Lock()
+	defer lm.mu.Unlock()
+
+	lm.client = s
+}
+
+// Handlers returns a slice of handlers.
+func (lm *LinkManager) Handlers() []controllers.ClientRequestHandler {
+	return []controllers.ClientRequestHandler{
+		{
+			RequestType: RequestCopyLink,
+			Handler:     lm.CopyLink,
+		},
+	}
+}
+
+// CopyLink sends the client a link to the view it is showing. The state
+// doesn't know which tab is selected, so the client sends it as `tab`.
+func (lm *LinkManager) CopyLink(state controllers.State, payload action.Payload) error {
+	tab, err := payload.OptionalString("tab")
+	if err != nil {
+		return errors.Wrap(err, "extract tab from payload")
+	}
+
+	lm.mu.Lock()
+	client := lm.client
+	lm.mu.Unlock()
+
+	if client == nil {
+		return errors.New("link manager has not been started")
+	}
+
+	link := DeepLink{
+		Context:      lm.config.ContextName(),
+		Namespace:    state.GetNamespace(),
+		ContentPath:  state.GetContentPath(),
+		Filters:      state.GetFilters(),
+		TableQueries: state.GetTableQueries(),
+		Tab:          tab,
+	}
+
+	client.Send(CreateLinkEvent(link))
+	return nil
+}
+
+// CreateLinkEvent creates a link event.
+func CreateLinkEvent(link DeepLink) controllers.Event {
+	return controllers.Event{
+		Type: controllers.EventTypeLink,
+		Data: map[string]interface{}{
+			"link": link.String(),
+		},
+	}
+}
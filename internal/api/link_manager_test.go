@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package api_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubenext/lissio/internal/api"
+	"github.com/kubenext/lissio/internal/api/fake"
+	configFake "github.com/kubenext/lissio/internal/config/fake"
+	"github.com/kubenext/lissio/internal/controllers"
+	lissioFake "github.com/kubenext/lissio/internal/controllers/fake"
+	"github.com/kubenext/lissio/pkg/action"
+	"github.com/kubenext/lissio/pkg/view/component"
+)
+
+func TestLinkManager_Handlers(t *testing.T) {
+	manager := api.NewLinkManager(nil)
+	AssertHandlers(t, manager, []string{api.RequestCopyLink})
+}
+
+func TestLinkManager_CopyLink(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ContextName().Return("dev")
+
+	filters := []controllers.Filter{{Key: "app", Value: "nginx"}}
+	tableQueries := map[string]component.TableQuery{"Pods": {Page: 2}}
+
+	state := lissioFake.NewMockState(controller)
+	state.EXPECT().GetNamespace().Return("default")
+	state.EXPECT().GetContentPath().Return("overview/namespace/default/workloads")
+	state.EXPECT().GetFilters().Return(filters)
+	state.EXPECT().GetTableQueries().Return(tableQueries)
+
+	client := fake.NewMockLissioClient(controller)
+	client.EXPECT().Send(api.CreateLinkEvent(api.DeepLink{
+		Context:      "dev",
+		Namespace:    "default",
+		ContentPath:  "overview/namespace/default/workloads",
+		Filters:      filters,
+		TableQueries: tableQueries,
+		Tab:          "summary",
+	}))
+
+	manager := api.NewLinkManager(dashConfig)
+	manager.Start(context.Background(), state, client)
+
+	require.NoError(t, manager.CopyLink(state, action.Payload{"tab": "summary"}))
+}
+
+func TestLinkManager_CopyLink_not_started(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	state := lissioFake.NewMockState(controller)
+
+	manager := api.NewLinkManager(configFake.NewMockDash(controller))
+	require.Error(t, manager.CopyLink(state, action.Payload{}))
+}
@@ -0,0 +1,129 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package api
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kubenext/lissio/internal/config"
+	"github.com/kubenext/lissio/internal/module"
+	"github.com/kubenext/lissio/pkg/navigation"
+)
+
+// NavigationCacheConfig is configuration for NavigationCache.
+type NavigationCacheConfig interface {
+	ModuleManager() module.ManagerInterface
+	CRDWatcher() config.CRDWatcher
+}
+
+// NavigationCache caches navigation trees by namespace. Navigation only
+// changes when CRDs are added or deleted, or modules change, so trees
+// are kept until then. The cache is shared by websocket clients.
+type NavigationCache struct {
+	trees       map[string][]navigation.Navigation
+	generation  int
+	subscribers map[chan struct{}]bool
+
+	mu sync.Mutex
+}
+
+// NewNavigationCache creates an instance of NavigationCache.
+func NewNavigationCache() *NavigationCache {
+	return &NavigationCache{
+		trees:       make(map[string][]navigation.Navigation),
+		subscribers: make(map[chan struct{}]bool),
+	}
+}
+
+// Watch invalidates the cache when CRDs are added or deleted, or modules
+// change, until the context is done.
+func (c *NavigationCache) Watch(ctx context.Context, cacheConfig NavigationCacheConfig) error {
+	invalidate := func(context.Context, *unstructured.Unstructured) {
+		c.Invalidate()
+	}
+
+	for _, isNamespaced := range []bool{true, false} {
+		watchConfig := &config.CRDWatchConfig{
+			Add:          invalidate,
+			Delete:       invalidate,
+			IsNamespaced: isNamespaced,
+		}
+
+		if err := cacheConfig.CRDWatcher().Watch(ctx, watchConfig); err != nil {
+			return errors.Wrap(err, "watch CRDs for navigation")
+		}
+	}
+
+	cancel := cacheConfig.ModuleManager().OnChange(c.Invalidate)
+	go func() {
+		<-ctx.Done()
+		cancel()
+	}()
+
+	return nil
+}
+
+// Get returns the navigation tree for a namespace and the cache's
+// generation. The generation is passed to Set, so a tree generated
+// before the cache was invalidated isn't cached.
+func (c *NavigationCache) Get(namespace string) ([]navigation.Navigation, int, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	tree, ok := c.trees[namespace]
+	return tree, c.generation, ok
+}
+
+// Set caches the navigation tree for a namespace if the cache hasn't been
+// invalidated since generation.
+func (c *NavigationCache) Set(namespace string, generation int, tree []navigation.Navigation) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation != c.generation {
+		return
+	}
+
+	c.trees[namespace] = tree
+}
+
+// Invalidate removes the cached trees and notifies subscribers.
+func (c *NavigationCache) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	c.trees = make(map[string][]navigation.Navigation)
+
+	for ch := range c.subscribers {
+		select {
+		case ch <- struct{}{}:
+		default:
+			// A notification is already pending.
+		}
+	}
+}
+
+// Subscribe returns a channel which receives a value when the cache is
+// invalidated, and a function which unsubscribes.
+func (c *NavigationCache) Subscribe() (<-chan struct{}, func()) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ch := make(chan struct{}, 1)
+	c.subscribers[ch] = true
+
+	return ch, func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		delete(c.subscribers, ch)
+	}
+}
@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package api_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubenext/lissio/internal/api"
+	"github.com/kubenext/lissio/internal/config"
+	configFake "github.com/kubenext/lissio/internal/config/fake"
+	"github.com/kubenext/lissio/internal/controllers"
+	"github.com/kubenext/lissio/internal/module"
+	moduleFake "github.com/kubenext/lissio/internal/module/fake"
+	"github.com/kubenext/lissio/internal/testutil"
+	"github.com/kubenext/lissio/pkg/navigation"
+)
+
+func TestNavigationCache(t *testing.T) {
+	cache := api.NewNavigationCache()
+
+	tree := []navigation.Navigation{{Title: "module"}}
+
+	_, generation, ok := cache.Get("default")
+	require.False(t, ok)
+
+	cache.Set("default", generation, tree)
+	got, _, ok := cache.Get("default")
+	require.True(t, ok)
+	assert.Equal(t, tree, got)
+
+	invalidated, unsubscribe := cache.Subscribe()
+	defer unsubscribe()
+
+	cache.Invalidate()
+	<-invalidated
+
+	_, _, ok = cache.Get("default")
+	require.False(t, ok)
+
+	// Trees generated before the cache was invalidated aren't cached.
+	cache.Set("default", generation, tree)
+	_, _, ok = cache.Get("default")
+	require.False(t, ok)
+}
+
+func TestNavigationCache_Watch(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	var watchConfigs []*config.CRDWatchConfig
+	crdWatcher := configFake.NewMockCRDWatcher(controller)
+	crdWatcher.EXPECT().
+		Watch(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, watchConfig *config.CRDWatchConfig) error {
+			watchConfigs = append(watchConfigs, watchConfig)
+			return nil
+		}).
+		Times(2)
+
+	var onChange module.ChangeFunc
+	moduleManager := moduleFake.NewMockManagerInterface(controller)
+	moduleManager.EXPECT().
+		OnChange(gomock.Any()).
+		DoAndReturn(func(fn module.ChangeFunc) controllers.UpdateCancelFunc {
+			onChange = fn
+			return func() {}
+		})
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().CRDWatcher().Return(crdWatcher).AnyTimes()
+	dashConfig.EXPECT().ModuleManager().Return(moduleManager).AnyTimes()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cache := api.NewNavigationCache()
+	require.NoError(t, cache.Watch(ctx, dashConfig))
+	require.Len(t, watchConfigs, 2)
+
+	invalidated, unsubscribe := cache.Subscribe()
+	defer unsubscribe()
+
+	crd := testutil.ToUnstructured(t, testutil.CreateCRD("crd"))
+	watchConfigs[0].Add(ctx, crd)
+	<-invalidated
+
+	watchConfigs[1].Delete(ctx, crd)
+	<-invalidated
+
+	onChange()
+	<-invalidated
+}
@@ -11,6 +11,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	"golang.org/x/sync/errgroup"
@@ -47,11 +48,28 @@ func WithNavigationGeneratorPoller(poller Poller) NavigationManagerOption {
 	}
 }
 
-// NavigationManager manages the navigation tree.
+// WithNavigationCache configures the cache navigation trees are kept in.
+func WithNavigationCache(cache *NavigationCache) NavigationManagerOption {
+	return func(n *NavigationManager) {
+		n.cache = cache
+	}
+}
+
+const (
+	// navigationDebounce is how long changes are collected before
+	// navigation is generated again.
+	navigationDebounce = 250 * time.Millisecond
+)
+
+// NavigationManager manages the navigation tree. The tree is generated
+// again when the namespace changes or the navigation cache is
+// invalidated. Trees with entries which are loading aren't cached, so
+// they are generated again until nothing is loading.
 type NavigationManager struct {
 	config                  NavigationManagerConfig
 	navigationGeneratorFunc NavigationGeneratorFunc
 	poller                  Poller
+	cache                   *NavigationCache
 }
 
 var _ StateManager = (*NavigationManager)(nil)
@@ -60,7 +78,7 @@ var _ StateManager = (*NavigationManager)(nil)
 func NewNavigationManager(config NavigationManagerConfig, options ...NavigationManagerOption) *NavigationManager {
 	n := &NavigationManager{
 		config:                  config,
-		poller:                  NewInterruptiblePoller("navigation"),
+		poller:                  NewTriggeredPoller("navigation", navigationDebounce),
 		navigationGeneratorFunc: NavigationGenerator,
 	}
 
@@ -68,6 +86,10 @@ func NewNavigationManager(config NavigationManagerConfig, options ...NavigationM
 		option(n)
 	}
 
+	if n.cache == nil {
+		n.cache = NewNavigationCache()
+	}
+
 	return n
 }
 
@@ -76,11 +98,35 @@ func (n NavigationManager) Handlers() []controllers.ClientRequestHandler {
 	return nil
 }
 
-// Start starts the manager. It periodically generates navigation updates.
+// Start starts the manager. Navigation is sent when the namespace changes
+// or the navigation cache is invalidated.
 func (n *NavigationManager) Start(ctx context.Context, state controllers.State, s LissioClient) {
 	ch := make(chan struct{}, 1)
-	defer func() {
-		close(ch)
+	trigger := func() {
+		select {
+		case ch <- struct{}{}:
+		default:
+			// An update is already pending.
+		}
+	}
+
+	namespaceCancel := state.OnNamespaceUpdate(func(string) {
+		trigger()
+	})
+	defer namespaceCancel()
+
+	invalidated, unsubscribe := n.cache.Subscribe()
+	defer unsubscribe()
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-invalidated:
+				trigger()
+			}
+		}
 	}()
 
 	n.poller.Run(ctx, ch, n.runUpdate(state, s), event.DefaultScheduleDelay)
@@ -92,7 +138,7 @@ func (n *NavigationManager) runUpdate(state controllers.State, client LissioClie
 	return func(ctx context.Context) bool {
 		logger := log.From(ctx)
 
-		entries, err := n.navigationGeneratorFunc(ctx, state, n.config)
+		entries, err := n.entries(ctx, state)
 		if err != nil {
 			logger.WithErr(err).Errorf("load namespaces")
 			return false
@@ -116,6 +162,39 @@ func (n *NavigationManager) runUpdate(state controllers.State, client LissioClie
 	}
 }
 
+// entries returns the cached navigation tree for the namespace, or
+// generates it.
+func (n *NavigationManager) entries(ctx context.Context, state controllers.State) ([]navigation.Navigation, error) {
+	namespace := state.GetNamespace()
+
+	entries, generation, ok := n.cache.Get(namespace)
+	if ok {
+		return entries, nil
+	}
+
+	entries, err := n.navigationGeneratorFunc(ctx, state, n.config)
+	if err != nil {
+		return nil, err
+	}
+
+	if ctx.Err() == nil && !isNavigationLoading(entries) {
+		n.cache.Set(namespace, generation, entries)
+	}
+
+	return entries, nil
+}
+
+// isNavigationLoading returns true if any entry in a navigation tree is loading.
+func isNavigationLoading(entries []navigation.Navigation) bool {
+	for i := range entries {
+		if entries[i].Loading || isNavigationLoading(entries[i].Children) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // NavigationGenerator generates a navigation tree given a set of modules and a namespace.
 func NavigationGenerator(ctx context.Context, state controllers.State, config NavigationManagerConfig) ([]navigation.Navigation, error) {
 	if state == nil {
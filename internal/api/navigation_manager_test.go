@@ -30,6 +30,8 @@ func TestNavigationManager_GenerateNavigation(t *testing.T) {
 
 	state := lissioFake.NewMockState(controller)
 	state.EXPECT().GetContentPath().Return("/path")
+	state.EXPECT().GetNamespace().Return("default")
+	state.EXPECT().OnNamespaceUpdate(gomock.Any()).Return(func() {})
 
 	lissioClient := fake.NewMockLissioClient(controller)
 
@@ -50,6 +52,51 @@ func TestNavigationManager_GenerateNavigation(t *testing.T) {
 	manager.Start(ctx, state, lissioClient)
 }
 
+func TestNavigationManager_cache(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashConfig := configFake.NewMockDash(controller)
+
+	state := lissioFake.NewMockState(controller)
+	state.EXPECT().GetContentPath().Return("/path").AnyTimes()
+	state.EXPECT().GetNamespace().Return("default").AnyTimes()
+	state.EXPECT().OnNamespaceUpdate(gomock.Any()).Return(func() {}).AnyTimes()
+
+	lissioClient := fake.NewMockLissioClient(controller)
+	lissioClient.EXPECT().Send(gomock.Any()).AnyTimes()
+
+	generated := 0
+	loading := true
+	generator := func(ctx context.Context, state controllers.State, config api.NavigationManagerConfig) ([]navigation.Navigation, error) {
+		generated++
+		return []navigation.Navigation{{Title: "module", Children: []navigation.Navigation{{Title: "Pods", Loading: loading}}}}, nil
+	}
+
+	cache := api.NewNavigationCache()
+	run := func() {
+		manager := api.NewNavigationManager(dashConfig,
+			api.WithNavigationGeneratorPoller(api.NewSingleRunPoller()),
+			api.WithNavigationGenerator(generator),
+			api.WithNavigationCache(cache),
+		)
+		manager.Start(context.Background(), state, lissioClient)
+	}
+
+	// Trees which are loading aren't cached.
+	run()
+	loading = false
+	run()
+	require.Equal(t, 2, generated)
+
+	run()
+	require.Equal(t, 2, generated)
+
+	cache.Invalidate()
+	run()
+	require.Equal(t, 3, generated)
+}
+
 func TestNavigationGenerator(t *testing.T) {
 	tests := []struct {
 		name     string
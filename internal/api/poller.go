@@ -19,7 +19,8 @@ const (
 	pollerWorkerCount = 2
 )
 
-// PollerFunc is a function run by the poller.
+// PollerFunc is a function run by the poller. It returns true if it should
+// be run again straight away.
 type PollerFunc func(context.Context) bool
 
 // Poller is a poller. It runs an action.
@@ -95,6 +96,152 @@ func (ip *InterruptiblePoller) Run(ctx context.Context, ch <-chan struct{}, acti
 	<-ctx.Done()
 }
 
+// TriggeredPoller is a poller which runs an action when it is triggered
+// instead of on a fixed interval. Triggers which arrive while the action is
+// running, or within the debounce window of the first trigger, are combined
+// into one run. The action is also run when it hasn't run for the reset
+// duration, and is run again straight away when it returns true.
+// Interrupt cancels a running action and runs it again without waiting.
+type TriggeredPoller struct {
+	name       string
+	debounce   time.Duration
+	interrupts chan struct{}
+}
+
+var _ Poller = (*TriggeredPoller)(nil)
+
+// NewTriggeredPoller creates an instance of TriggeredPoller.
+func NewTriggeredPoller(name string, debounce time.Duration) *TriggeredPoller {
+	return &TriggeredPoller{
+		name:       name,
+		debounce:   debounce,
+		interrupts: make(chan struct{}, 1),
+	}
+}
+
+// Interrupt cancels the running action and runs it again straight away,
+// e.g. when a user changes what the action generates. Interrupts which
+// arrive before the poller handles the last one are combined.
+func (tp *TriggeredPoller) Interrupt() {
+	select {
+	case tp.interrupts <- struct{}{}:
+	default:
+	}
+}
+
+// triggeredRun is the result of a run of a TriggeredPoller's action.
+type triggeredRun struct {
+	id    int
+	rerun bool
+}
+
+// Run runs the poller. The action is run once when the poller starts.
+func (tp *TriggeredPoller) Run(ctx context.Context, ch <-chan struct{}, action PollerFunc, resetDuration time.Duration) {
+	logger := log.From(ctx).With("poller-name", tp.name)
+	ctx = log.WithLoggerContext(ctx, logger)
+
+	done := make(chan triggeredRun)
+	running := false
+	pending := false
+
+	// Runs are numbered so the result of an interrupted run is ignored.
+	runID := 0
+	cancelRun := func() {}
+	defer func() {
+		cancelRun()
+	}()
+
+	run := func() {
+		runID++
+		id := runID
+		running = true
+		pending = false
+
+		runCtx, cancel := context.WithCancel(ctx)
+		cancelRun = cancel
+
+		go func() {
+			rerun := action(runCtx)
+			select {
+			case done <- triggeredRun{id: id, rerun: rerun}:
+			case <-ctx.Done():
+			}
+		}()
+	}
+
+	resync := time.NewTimer(resetDuration)
+	defer resync.Stop()
+
+	var debounceTimer *time.Timer
+	var debounced <-chan time.Time
+	startDebounce := func() {
+		if debounceTimer == nil {
+			debounceTimer = time.NewTimer(tp.debounce)
+			debounced = debounceTimer.C
+		}
+	}
+	stopDebounce := func() {
+		if debounceTimer != nil {
+			debounceTimer.Stop()
+			debounceTimer = nil
+			debounced = nil
+		}
+	}
+	defer stopDebounce()
+
+	run()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-tp.interrupts:
+			cancelRun()
+			stopDebounce()
+			run()
+		case _, ok := <-ch:
+			if !ok {
+				return
+			}
+			pending = true
+			startDebounce()
+		case <-debounced:
+			debounceTimer = nil
+			debounced = nil
+			if pending && !running {
+				run()
+			}
+		case result := <-done:
+			if result.id != runID {
+				// The run was interrupted and another run has started.
+				continue
+			}
+
+			cancelRun()
+			running = false
+			if !resync.Stop() {
+				<-resync.C
+			}
+			resync.Reset(resetDuration)
+
+			if result.rerun && ctx.Err() == nil {
+				run()
+				continue
+			}
+			if pending {
+				startDebounce()
+			}
+		case <-resync.C:
+			resync.Reset(resetDuration)
+			if running {
+				pending = true
+				continue
+			}
+			run()
+		}
+	}
+}
+
 type job struct {
 	id         uuid.UUID
 	ctx        context.Context
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestInterruptiblePoller_Run(t *testing.T) {
@@ -42,3 +43,131 @@ func TestInterruptiblePoller_Run(t *testing.T) {
 
 	assert.True(t, ran)
 }
+
+func TestTriggeredPoller_Run(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tp := NewTriggeredPoller("poller", 10*time.Millisecond)
+
+	ch := make(chan struct{}, 1)
+	runs := make(chan bool, 10)
+	action := func(ctx context.Context) bool {
+		runs <- true
+		return false
+	}
+
+	exited := make(chan bool, 1)
+	go func() {
+		tp.Run(ctx, ch, action, time.Hour)
+		exited <- true
+	}()
+
+	// The action runs when the poller starts.
+	<-runs
+
+	// Triggers within the debounce window are combined.
+	ch <- struct{}{}
+	ch <- struct{}{}
+	<-runs
+
+	select {
+	case <-runs:
+		t.Fatal("triggers were not combined")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	cancel()
+	<-exited
+}
+
+func TestTriggeredPoller_Run_rerun(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tp := NewTriggeredPoller("poller", time.Hour)
+
+	runs := make(chan bool, 10)
+	count := 0
+	action := func(ctx context.Context) bool {
+		count++
+		runs <- true
+		return count == 1
+	}
+
+	go tp.Run(ctx, make(chan struct{}), action, time.Hour)
+
+	// The action asks to be rerun the first time it runs.
+	<-runs
+	<-runs
+
+	select {
+	case <-runs:
+		t.Fatal("action was rerun more than once")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestTriggeredPoller_Run_reset(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tp := NewTriggeredPoller("poller", time.Hour)
+
+	runs := make(chan bool, 10)
+	action := func(ctx context.Context) bool {
+		runs <- true
+		return false
+	}
+
+	go tp.Run(ctx, make(chan struct{}), action, 10*time.Millisecond)
+
+	// The action runs when the poller starts and again after the reset duration.
+	<-runs
+	<-runs
+}
+
+func TestTriggeredPoller_Interrupt(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tp := NewTriggeredPoller("poller", time.Hour)
+
+	started := make(chan int, 10)
+	cancelled := make(chan int, 10)
+	count := 0
+	action := func(ctx context.Context) bool {
+		count++
+		run := count
+		started <- run
+
+		if run == 1 {
+			// The first run blocks until it is interrupted.
+			<-ctx.Done()
+			cancelled <- run
+		}
+		return false
+	}
+
+	go tp.Run(ctx, make(chan struct{}), action, time.Hour)
+
+	require.Equal(t, 1, <-started)
+
+	// An interrupt cancels the running action and runs it again without
+	// waiting for the debounce window.
+	tp.Interrupt()
+
+	select {
+	case run := <-started:
+		require.Equal(t, 2, run)
+	case <-time.After(time.Second):
+		t.Fatal("action was not run after an interrupt")
+	}
+
+	select {
+	case run := <-cancelled:
+		require.Equal(t, 1, run)
+	case <-time.After(time.Second):
+		t.Fatal("running action was not cancelled")
+	}
+}
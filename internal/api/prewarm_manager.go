@@ -0,0 +1,149 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package api
+
+import (
+	"context"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kubenext/lissio/internal/controllers"
+	"github.com/kubenext/lissio/internal/log"
+	"github.com/kubenext/lissio/internal/objectstore"
+	"github.com/kubenext/lissio/pkg/store"
+)
+
+// PrewarmManagerConfig is configuration for PrewarmManager.
+type PrewarmManagerConfig interface {
+	NavigationManagerConfig
+	ObjectStore() store.Store
+}
+
+// PrewarmManagerOption is an option for configuring PrewarmManager.
+type PrewarmManagerOption func(m *PrewarmManager)
+
+// WithPrewarmNavigationGenerator configures the function which generates
+// the navigation the kinds to prewarm are found in.
+func WithPrewarmNavigationGenerator(fn NavigationGeneratorFunc) PrewarmManagerOption {
+	return func(m *PrewarmManager) {
+		m.navigationGeneratorFunc = fn
+	}
+}
+
+// WithPrewarmer configures the prewarmer.
+func WithPrewarmer(prewarmer *objectstore.Prewarmer) PrewarmManagerOption {
+	return func(m *PrewarmManager) {
+		m.prewarmer = prewarmer
+	}
+}
+
+// PrewarmManager starts informers for the kinds navigation shows when the
+// namespace changes, so pages in the new namespace don't wait for them.
+// Kinds the current content was generated from are started first.
+// Progress is sent to the client as loading events.
+type PrewarmManager struct {
+	config                  PrewarmManagerConfig
+	navigationGeneratorFunc NavigationGeneratorFunc
+	prewarmer               *objectstore.Prewarmer
+
+	mu           sync.Mutex
+	contentKinds []schema.GroupVersionKind
+}
+
+var _ StateManager = (*PrewarmManager)(nil)
+
+// NewPrewarmManager creates an instance of PrewarmManager. The object
+// store is prewarmed if it is an objectstore.Warmer.
+func NewPrewarmManager(config PrewarmManagerConfig, options ...PrewarmManagerOption) *PrewarmManager {
+	m := &PrewarmManager{
+		config:                  config,
+		navigationGeneratorFunc: NavigationGenerator,
+	}
+
+	if warmer, ok := config.ObjectStore().(objectstore.Warmer); ok {
+		m.prewarmer = objectstore.NewPrewarmer(warmer, objectstore.DefaultPrewarmConcurrency)
+	}
+
+	for _, option := range options {
+		option(m)
+	}
+
+	return m
+}
+
+// Handlers returns nil.
+func (m *PrewarmManager) Handlers() []controllers.ClientRequestHandler {
+	return nil
+}
+
+// Start starts the manager. It prewarms each namespace the client
+// switches to until the context is done.
+func (m *PrewarmManager) Start(ctx context.Context, state controllers.State, s LissioClient) {
+	if m.prewarmer == nil {
+		return
+	}
+
+	updateCancel := state.OnNamespaceUpdate(func(namespace string) {
+		// Namespace updates are called while the namespace is set, so
+		// finding the kinds to prewarm can't block.
+		go m.prewarm(ctx, state, s, namespace)
+	})
+	defer updateCancel()
+
+	<-ctx.Done()
+	m.prewarmer.Stop()
+}
+
+// ObserveContentKeys records the kinds content was generated from. They
+// are prewarmed first.
+func (m *PrewarmManager) ObserveContentKeys(keys []store.Key) {
+	var kinds []schema.GroupVersionKind
+	for _, key := range keys {
+		kinds = append(kinds, key.GroupVersionKind())
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.contentKinds = kinds
+}
+
+func (m *PrewarmManager) prewarm(ctx context.Context, state controllers.State, s LissioClient, namespace string) {
+	logger := log.From(ctx).With("namespace", namespace)
+
+	recordCtx, recorder := store.WithKeyRecorder(ctx)
+	if _, err := m.navigationGeneratorFunc(recordCtx, state, m.config); err != nil {
+		logger.WithErr(err).Errorf("find kinds to prewarm")
+		return
+	}
+
+	if ctx.Err() != nil || state.GetNamespace() != namespace {
+		// The namespace changed again while navigation was generated.
+		return
+	}
+
+	var kinds []schema.GroupVersionKind
+	for _, key := range recorder.Keys() {
+		kinds = append(kinds, key.GroupVersionKind())
+	}
+
+	m.mu.Lock()
+	priority := m.contentKinds
+	m.mu.Unlock()
+
+	m.prewarmer.Prewarm(ctx, namespace, priority, kinds, func(progress objectstore.PrewarmProgress) {
+		s.Send(CreateLoadingEvent(progress))
+	})
+}
+
+// CreateLoadingEvent creates a loading event.
+func CreateLoadingEvent(progress objectstore.PrewarmProgress) controllers.Event {
+	return controllers.Event{
+		Type: controllers.EventTypeLoading,
+		Data: progress,
+	}
+}
@@ -0,0 +1,109 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package api_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubenext/lissio/internal/api"
+	"github.com/kubenext/lissio/internal/api/fake"
+	configFake "github.com/kubenext/lissio/internal/config/fake"
+	"github.com/kubenext/lissio/internal/controllers"
+	lissioFake "github.com/kubenext/lissio/internal/controllers/fake"
+	"github.com/kubenext/lissio/internal/objectstore"
+	"github.com/kubenext/lissio/pkg/navigation"
+	"github.com/kubenext/lissio/pkg/store"
+	storeFake "github.com/kubenext/lissio/pkg/store/fake"
+)
+
+type recordingWarmer struct {
+	mu   sync.Mutex
+	keys []store.Key
+}
+
+func (w *recordingWarmer) Warm(ctx context.Context, key store.Key) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.keys = append(w.keys, key)
+	return nil
+}
+
+func TestPrewarmManager(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ObjectStore().Return(storeFake.NewMockStore(controller))
+
+	var namespaceUpdate controllers.NamespaceUpdateFunc
+	registered := make(chan struct{})
+	state := lissioFake.NewMockState(controller)
+	state.EXPECT().
+		OnNamespaceUpdate(gomock.Any()).
+		DoAndReturn(func(fn controllers.NamespaceUpdateFunc) controllers.UpdateCancelFunc {
+			namespaceUpdate = fn
+			close(registered)
+			return func() {}
+		})
+	state.EXPECT().GetNamespace().Return("other").AnyTimes()
+
+	finished := make(chan objectstore.PrewarmProgress, 1)
+	lissioClient := fake.NewMockLissioClient(controller)
+	lissioClient.EXPECT().
+		Send(gomock.Any()).
+		DoAndReturn(func(event controllers.Event) {
+			require.Equal(t, controllers.EventTypeLoading, event.Type)
+			progress := event.Data.(objectstore.PrewarmProgress)
+			if progress.Finished() {
+				finished <- progress
+			}
+		}).
+		AnyTimes()
+
+	warmer := &recordingWarmer{}
+	manager := api.NewPrewarmManager(dashConfig,
+		api.WithPrewarmer(objectstore.NewPrewarmer(warmer, 1)),
+		api.WithPrewarmNavigationGenerator(func(ctx context.Context, state controllers.State, config api.NavigationManagerConfig) ([]navigation.Navigation, error) {
+			store.RecordKey(ctx, store.Key{Namespace: "other", APIVersion: "v1", Kind: "Pod"})
+			store.RecordKey(ctx, store.Key{Namespace: "other", APIVersion: "apps/v1", Kind: "Deployment"})
+			return nil, nil
+		}),
+	)
+
+	manager.ObserveContentKeys([]store.Key{{Namespace: "default", APIVersion: "apps/v1", Kind: "Deployment"}})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go manager.Start(ctx, state, lissioClient)
+	<-registered
+
+	namespaceUpdate("other")
+
+	select {
+	case progress := <-finished:
+		assert.Equal(t, objectstore.PrewarmProgress{Namespace: "other", Total: 2, Done: 2}, progress)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "prewarm didn't finish")
+	}
+
+	warmer.mu.Lock()
+	defer warmer.mu.Unlock()
+
+	expected := []store.Key{
+		{Namespace: "other", APIVersion: "apps/v1", Kind: "Deployment"},
+		{Namespace: "other", APIVersion: "v1", Kind: "Pod"},
+	}
+	assert.Equal(t, expected, warmer.keys)
+}
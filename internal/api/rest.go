@@ -0,0 +1,254 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+
+	"github.com/kubenext/lissio/internal/config"
+	"github.com/kubenext/lissio/internal/event"
+	"github.com/kubenext/lissio/internal/log"
+	"github.com/kubenext/lissio/internal/module"
+	"github.com/kubenext/lissio/pkg/action"
+	"github.com/kubenext/lissio/pkg/view/component"
+)
+
+// maxActionPayloadSize is the largest action payload accepted.
+const maxActionPayloadSize = 1 << 20
+
+type restContentResponse struct {
+	Content     component.ContentResponse `json:"content"`
+	ContentPath string                    `json:"contentPath"`
+}
+
+type restNamespacesResponse struct {
+	Namespaces []string `json:"namespaces"`
+}
+
+type restActionResponse struct {
+	Alerts      []action.Alert `json:"alerts"`
+	Result      action.Payload `json:"result,omitempty"`
+	ContentPath string         `json:"contentPath,omitempty"`
+}
+
+// registerRESTRoutes registers the REST endpoints. They serve the same
+// content and actions as the stream for clients which can't use a websocket.
+func registerRESTRoutes(ctx context.Context, router *mux.Router, dashConfig config.Dash, actionDispatcher ActionDispatcher) {
+	router.HandleFunc("/content/{contentPath:.*}", contentHandler(ctx, dashConfig)).Methods(http.MethodGet)
+	router.HandleFunc("/namespaces", namespacesHandler(ctx, dashConfig)).Methods(http.MethodGet)
+	router.HandleFunc("/contexts", contextsHandler(ctx, dashConfig)).Methods(http.MethodGet)
+	router.HandleFunc("/actions", actionHandler(ctx, actionDispatcher)).Methods(http.MethodPost)
+}
+
+// contentHandler serves the content for a content path. Filters are set
+// with repeated `filter=key:value` query parameters and table queries with
+// repeated URL encoded `table` query parameters.
+func contentHandler(ctx context.Context, dashConfig config.Dash) http.HandlerFunc {
+	logger := log.From(ctx)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		contentPath := strings.Trim(mux.Vars(r)["contentPath"], "/")
+		if contentPath == "" {
+			RespondWithError(w, http.StatusBadRequest, "content path is required", logger)
+			return
+		}
+
+		options, err := restContentOptions(r)
+		if err != nil {
+			RespondWithError(w, http.StatusBadRequest, err.Error(), logger)
+			return
+		}
+
+		m, ok := dashConfig.ModuleManager().ModuleForContentPath(contentPath)
+		if !ok {
+			RespondWithError(w, http.StatusNotFound, NewNotFoundError(contentPath).Error(), logger)
+			return
+		}
+
+		modulePath := strings.TrimPrefix(contentPath, m.Name())
+		contentResponse, err := m.Content(log.WithLoggerContext(r.Context(), logger), modulePath, options)
+		if err != nil {
+			if nfe, ok := err.(notFound); ok && nfe.NotFound() {
+				RespondWithError(w, http.StatusNotFound, err.Error(), logger)
+				return
+			}
+
+			RespondWithError(w, http.StatusInternalServerError, errors.Wrap(err, "generate content").Error(), logger)
+			return
+		}
+
+		serveAsJSON(w, restContentResponse{
+			Content:     contentResponse,
+			ContentPath: contentPath,
+		}, logger)
+	}
+}
+
+func restContentOptions(r *http.Request) (module.ContentOptions, error) {
+	query := r.URL.Query()
+
+	options := module.ContentOptions{
+		TableQueries: map[string]component.TableQuery{},
+	}
+
+	if raw := query["filter"]; len(raw) > 0 {
+		var filters []interface{}
+		for _, s := range raw {
+			filters = append(filters, s)
+		}
+
+		list, err := FiltersFromQueryParams(filters)
+		if err != nil {
+			return module.ContentOptions{}, errors.Wrap(err, "extract filters from query params")
+		}
+		options.LabelSet = FiltersToLabelSet(list)
+	}
+
+	for _, s := range query["table"] {
+		name, tableQuery, err := ParseTableQueryParam(s)
+		if err != nil {
+			return module.ContentOptions{}, err
+		}
+		options.TableQueries[name] = tableQuery
+	}
+
+	return options, nil
+}
+
+// namespacesHandler serves the namespaces in the current context.
+func namespacesHandler(ctx context.Context, dashConfig config.Dash) http.HandlerFunc {
+	logger := log.From(ctx)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		namespaces, err := NamespacesGenerator(r.Context(), dashConfig)
+		if err != nil {
+			RespondWithError(w, http.StatusInternalServerError, err.Error(), logger)
+			return
+		}
+
+		if namespaces == nil {
+			namespaces = []string{}
+		}
+
+		serveAsJSON(w, restNamespacesResponse{Namespaces: namespaces}, logger)
+	}
+}
+
+// contextsHandler serves the contexts in the kube config and the current context.
+func contextsHandler(ctx context.Context, dashConfig config.Dash) http.HandlerFunc {
+	logger := log.From(ctx)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		ev, err := event.NewContextsGenerator(dashConfig).Event(r.Context())
+		if err != nil {
+			RespondWithError(w, http.StatusInternalServerError, err.Error(), logger)
+			return
+		}
+
+		serveAsJSON(w, ev.Data, logger)
+	}
+}
+
+// actionHandler dispatches an action. The body is the action's payload as
+// JSON, with the action name in `action` like the stream's performAction
+// request. Only JSON bodies are accepted so browsers can't post actions
+// from other sites without a preflight request.
+func actionHandler(ctx context.Context, actionDispatcher ActionDispatcher) http.HandlerFunc {
+	logger := log.From(ctx)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if err != nil || mediaType != "application/json" {
+			RespondWithError(w, http.StatusUnsupportedMediaType, "action payload must be application/json", logger)
+			return
+		}
+
+		payload := action.Payload{}
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxActionPayloadSize)).Decode(&payload); err != nil {
+			RespondWithError(w, http.StatusBadRequest, errors.Wrap(err, "decode action payload").Error(), logger)
+			return
+		}
+
+		actionName, err := payload.String("action")
+		if err != nil || actionName == "" {
+			RespondWithError(w, http.StatusBadRequest, "action is required", logger)
+			return
+		}
+
+		responder := &restResponder{}
+		if err := actionDispatcher.Dispatch(log.WithLoggerContext(r.Context(), logger), responder, actionName, payload); err != nil {
+			if _, ok := err.(*action.NotFoundError); ok {
+				RespondWithError(w, http.StatusNotFound, err.Error(), logger)
+				return
+			}
+
+			RespondWithError(w, http.StatusInternalServerError, err.Error(), logger)
+			return
+		}
+
+		serveAsJSON(w, responder.response(), logger)
+	}
+}
+
+// restResponder collects what an action sends to the client that
+// performed it so it can be returned in the response.
+type restResponder struct {
+	alerts      []action.Alert
+	result      action.Payload
+	contentPath string
+
+	mu sync.Mutex
+}
+
+var _ action.Responder = (*restResponder)(nil)
+
+// SendAlert records an alert.
+func (r *restResponder) SendAlert(alert action.Alert) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.alerts = append(r.alerts, alert)
+}
+
+// SetContentPath records the content path the action redirects to.
+func (r *restResponder) SetContentPath(contentPath string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.contentPath = contentPath
+}
+
+// SendActionResult records the action's result.
+func (r *restResponder) SendActionResult(actionName string, result action.Payload) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.result = result
+}
+
+func (r *restResponder) response() restActionResponse {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	resp := restActionResponse{
+		Alerts:      r.alerts,
+		Result:      r.result,
+		ContentPath: r.contentPath,
+	}
+	if resp.Alerts == nil {
+		resp.Alerts = []action.Alert{}
+	}
+
+	return resp
+}
@@ -0,0 +1,296 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/labels"
+
+	clusterFake "github.com/kubenext/lissio/internal/cluster/fake"
+	configFake "github.com/kubenext/lissio/internal/config/fake"
+	"github.com/kubenext/lissio/internal/module"
+	moduleFake "github.com/kubenext/lissio/internal/module/fake"
+	"github.com/kubenext/lissio/pkg/action"
+	"github.com/kubenext/lissio/pkg/view/component"
+)
+
+type restMocks struct {
+	dashConfig       *configFake.MockDash
+	moduleManager    *moduleFake.MockManagerInterface
+	module           *moduleFake.MockModule
+	clusterClient    *clusterFake.MockClientInterface
+	actionDispatcher *actionDispatcherStub
+}
+
+// actionDispatcherStub dispatches actions with fn. The generated mock can't
+// be used from this package without an import cycle.
+type actionDispatcherStub struct {
+	fn func(ctx context.Context, alerter action.Alerter, actionName string, payload action.Payload) error
+}
+
+func (s *actionDispatcherStub) Dispatch(ctx context.Context, alerter action.Alerter, actionName string, payload action.Payload) error {
+	return s.fn(ctx, alerter, actionName, payload)
+}
+
+func newRESTRouter(t *testing.T, controller *gomock.Controller) (*mux.Router, *restMocks) {
+	mocks := &restMocks{
+		dashConfig:       configFake.NewMockDash(controller),
+		moduleManager:    moduleFake.NewMockManagerInterface(controller),
+		module:           moduleFake.NewMockModule(controller),
+		clusterClient:    clusterFake.NewMockClientInterface(controller),
+		actionDispatcher: &actionDispatcherStub{},
+	}
+
+	mocks.dashConfig.EXPECT().ModuleManager().Return(mocks.moduleManager).AnyTimes()
+	mocks.dashConfig.EXPECT().ClusterClient().Return(mocks.clusterClient).AnyTimes()
+	mocks.module.EXPECT().Name().Return("overview").AnyTimes()
+
+	router := mux.NewRouter()
+	router.Use(rebindHandler(context.Background(), []string{"localhost"}))
+	registerRESTRoutes(context.Background(), router.PathPrefix(PathPrefix).Subrouter(), mocks.dashConfig, mocks.actionDispatcher)
+
+	return router, mocks
+}
+
+func serveREST(router *mux.Router, r *http.Request) *httptest.ResponseRecorder {
+	r.Host = "localhost:7777"
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, r)
+	return w
+}
+
+func Test_contentHandler(t *testing.T) {
+	t.Run("content", func(t *testing.T) {
+		controller := gomock.NewController(t)
+		defer controller.Finish()
+
+		router, mocks := newRESTRouter(t, controller)
+
+		mocks.moduleManager.EXPECT().
+			ModuleForContentPath("overview/namespace/default").
+			Return(mocks.module, true)
+
+		expectedOptions := module.ContentOptions{
+			LabelSet: &labels.Set{"app": "nginx"},
+			TableQueries: map[string]component.TableQuery{
+				"Pods": {Page: 2, PageSize: 10},
+			},
+		}
+		mocks.module.EXPECT().
+			Content(gomock.Any(), "/namespace/default", expectedOptions).
+			Return(component.ContentResponse{
+				Title: component.Title(component.NewText("Overview")),
+			}, nil)
+
+		u := "/api/v1/content/overview/namespace/default?filter=app:nginx&table=" +
+			"table%3DPods%26page%3D2%26pageSize%3D10"
+		w := serveREST(router, httptest.NewRequest(http.MethodGet, u, nil))
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		var got struct {
+			Content struct {
+				Title []component.TypedObject `json:"title"`
+			} `json:"content"`
+			ContentPath string `json:"contentPath"`
+		}
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+		assert.Equal(t, "overview/namespace/default", got.ContentPath)
+		assert.Len(t, got.Content.Title, 1)
+	})
+
+	t.Run("unknown module", func(t *testing.T) {
+		controller := gomock.NewController(t)
+		defer controller.Finish()
+
+		router, mocks := newRESTRouter(t, controller)
+		mocks.moduleManager.EXPECT().ModuleForContentPath("missing").Return(nil, false)
+
+		w := serveREST(router, httptest.NewRequest(http.MethodGet, "/api/v1/content/missing", nil))
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("content not found", func(t *testing.T) {
+		controller := gomock.NewController(t)
+		defer controller.Finish()
+
+		router, mocks := newRESTRouter(t, controller)
+		mocks.moduleManager.EXPECT().ModuleForContentPath("overview/missing").Return(mocks.module, true)
+		mocks.module.EXPECT().
+			Content(gomock.Any(), "/missing", gomock.Any()).
+			Return(component.EmptyContentResponse, NewNotFoundError("overview/missing"))
+
+		w := serveREST(router, httptest.NewRequest(http.MethodGet, "/api/v1/content/overview/missing", nil))
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("content error", func(t *testing.T) {
+		controller := gomock.NewController(t)
+		defer controller.Finish()
+
+		router, mocks := newRESTRouter(t, controller)
+		mocks.moduleManager.EXPECT().ModuleForContentPath("overview").Return(mocks.module, true)
+		mocks.module.EXPECT().
+			Content(gomock.Any(), "", gomock.Any()).
+			Return(component.EmptyContentResponse, errors.New("failed"))
+
+		w := serveREST(router, httptest.NewRequest(http.MethodGet, "/api/v1/content/overview", nil))
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("invalid filter", func(t *testing.T) {
+		controller := gomock.NewController(t)
+		defer controller.Finish()
+
+		router, _ := newRESTRouter(t, controller)
+
+		w := serveREST(router, httptest.NewRequest(http.MethodGet, "/api/v1/content/overview?filter=invalid", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("host not accepted", func(t *testing.T) {
+		controller := gomock.NewController(t)
+		defer controller.Finish()
+
+		router, _ := newRESTRouter(t, controller)
+
+		r := httptest.NewRequest(http.MethodGet, "/api/v1/content/overview", nil)
+		r.Host = "example.com"
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
+func Test_namespacesHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	router, mocks := newRESTRouter(t, controller)
+
+	namespaceClient := clusterFake.NewMockNamespaceInterface(controller)
+	namespaceClient.EXPECT().Names().Return([]string{"default", "kube-system"}, nil)
+	mocks.clusterClient.EXPECT().NamespaceClient().Return(namespaceClient, nil)
+
+	w := serveREST(router, httptest.NewRequest(http.MethodGet, "/api/v1/namespaces", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"namespaces":["default","kube-system"]}`, w.Body.String())
+}
+
+func Test_contextsHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	router, mocks := newRESTRouter(t, controller)
+	mocks.dashConfig.EXPECT().KubeConfigPath().Return("")
+	mocks.dashConfig.EXPECT().ContextName().Return("snapshot")
+
+	w := serveREST(router, httptest.NewRequest(http.MethodGet, "/api/v1/contexts", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"contexts":[{"name":"snapshot"}],"currentContext":"snapshot"}`, w.Body.String())
+}
+
+func Test_actionHandler(t *testing.T) {
+	newRequest := func(body string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/api/v1/actions", strings.NewReader(body))
+		r.Header.Set("Content-Type", "application/json")
+		return r
+	}
+
+	t.Run("dispatch", func(t *testing.T) {
+		controller := gomock.NewController(t)
+		defer controller.Finish()
+
+		router, mocks := newRESTRouter(t, controller)
+		mocks.actionDispatcher.fn = func(ctx context.Context, alerter action.Alerter, actionName string, payload action.Payload) error {
+			assert.Equal(t, "lissio/test", actionName)
+			assert.Equal(t, action.Payload{"action": "lissio/test", "key": "value"}, payload)
+
+			responder, ok := alerter.(action.Responder)
+			require.True(t, ok)
+			responder.SendAlert(action.CreateAlert(action.AlertTypeInfo, "done", 0))
+			responder.SendActionResult(actionName, action.Payload{"count": 1})
+			responder.SetContentPath("overview/namespace/default")
+			return nil
+		}
+
+		w := serveREST(router, newRequest(`{"action":"lissio/test","key":"value"}`))
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		assert.JSONEq(t, `{
+			"alerts": [{"type":"INFO","message":"done"}],
+			"result": {"count": 1},
+			"contentPath": "overview/namespace/default"
+		}`, w.Body.String())
+	})
+
+	t.Run("action not found", func(t *testing.T) {
+		controller := gomock.NewController(t)
+		defer controller.Finish()
+
+		router, mocks := newRESTRouter(t, controller)
+		mocks.actionDispatcher.fn = func(ctx context.Context, alerter action.Alerter, actionName string, payload action.Payload) error {
+			return &action.NotFoundError{Path: actionName}
+		}
+
+		w := serveREST(router, newRequest(`{"action":"missing"}`))
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("action error", func(t *testing.T) {
+		controller := gomock.NewController(t)
+		defer controller.Finish()
+
+		router, mocks := newRESTRouter(t, controller)
+		mocks.actionDispatcher.fn = func(ctx context.Context, alerter action.Alerter, actionName string, payload action.Payload) error {
+			return errors.New("failed")
+		}
+
+		w := serveREST(router, newRequest(`{"action":"lissio/test"}`))
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("missing action", func(t *testing.T) {
+		controller := gomock.NewController(t)
+		defer controller.Finish()
+
+		router, _ := newRESTRouter(t, controller)
+
+		w := serveREST(router, newRequest(`{"key":"value"}`))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("invalid payload", func(t *testing.T) {
+		controller := gomock.NewController(t)
+		defer controller.Finish()
+
+		router, _ := newRESTRouter(t, controller)
+
+		w := serveREST(router, newRequest(`[`))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("not json", func(t *testing.T) {
+		controller := gomock.NewController(t)
+		defer controller.Finish()
+
+		router, _ := newRESTRouter(t, controller)
+
+		r := newRequest(`{"action":"lissio/test"}`)
+		r.Header.Set("Content-Type", "text/plain")
+		w := serveREST(router, r)
+		assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+	})
+}
@@ -0,0 +1,162 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package api
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/kubenext/lissio/internal/controllers"
+	"github.com/kubenext/lissio/internal/link"
+	"github.com/kubenext/lissio/internal/search"
+	"github.com/kubenext/lissio/pkg/action"
+	"github.com/kubenext/lissio/pkg/view/component"
+)
+
+const (
+	RequestSearch = "search"
+)
+
+// SearchManagerConfig is configuration for SearchManager.
+type SearchManagerConfig interface {
+	link.Config
+	SearchIndex() *search.Index
+}
+
+// SearchResult is a search result sent to the frontend.
+type SearchResult struct {
+	Name       string          `json:"name"`
+	Namespace  string          `json:"namespace,omitempty"`
+	APIVersion string          `json:"apiVersion"`
+	Kind       string          `json:"kind"`
+	Match      string          `json:"match,omitempty"`
+	Score      int             `json:"score"`
+	Link       *component.Link `json:"link,omitempty"`
+}
+
+// SearchResults are the results for a search query.
+type SearchResults struct {
+	Query   string         `json:"query"`
+	Results []SearchResult `json:"results"`
+}
+
+// SearchManager manages searches across all objects in the object store.
+type SearchManager struct {
+	config SearchManagerConfig
+
+	client LissioClient
+	mu     sync.Mutex
+}
+
+var _ StateManager = (*SearchManager)(nil)
+
+// NewSearchManager creates an instance of SearchManager.
+func NewSearchManager(config SearchManagerConfig) *SearchManager {
+	return &SearchManager{
+		config: config,
+	}
+}
+
+// Start starts the manager. Search results are sent to the client.
+func (sm *SearchManager) Start(ctx context.Context, state controllers.State, s LissioClient) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	sm.client = s
+}
+
+// Handlers returns a slice of handlers.
+func (sm *SearchManager) Handlers() []controllers.ClientRequestHandler {
+	return []controllers.ClientRequestHandler{
+		{
+			RequestType: RequestSearch,
+			Handler:     sm.Search,
+		},
+	}
+}
+
+// Search searches for objects matching a query. An optional limit caps
+// the number of results.
+func (sm *SearchManager) Search(state controllers.State, payload action.Payload) error {
+	query, err := payload.String("query")
+	if err != nil {
+		return errors.Wrap(err, "extract query from payload")
+	}
+
+	limit := 0
+	if _, ok := payload["limit"]; ok {
+		f, err := payload.Float64("limit")
+		if err != nil {
+			return errors.Wrap(err, "extract limit from payload")
+		}
+		limit = int(f)
+	}
+
+	results, err := sm.search(query, limit)
+	if err != nil {
+		return err
+	}
+
+	sm.mu.Lock()
+	client := sm.client
+	sm.mu.Unlock()
+
+	if client == nil {
+		return errors.New("search manager has not been started")
+	}
+
+	client.Send(CreateSearchResultsEvent(results))
+	return nil
+}
+
+func (sm *SearchManager) search(query string, limit int) (SearchResults, error) {
+	searchResults := SearchResults{
+		Query:   query,
+		Results: []SearchResult{},
+	}
+
+	index := sm.config.SearchIndex()
+	if index == nil {
+		return searchResults, nil
+	}
+
+	linkGenerator, err := link.NewFromDashConfig(sm.config)
+	if err != nil {
+		return SearchResults{}, err
+	}
+
+	for _, result := range index.Search(query, limit) {
+		key := result.Document.Key
+
+		searchResult := SearchResult{
+			Name:       key.Name,
+			Namespace:  key.Namespace,
+			APIVersion: key.APIVersion,
+			Kind:       key.Kind,
+			Match:      result.Match,
+			Score:      result.Score,
+		}
+
+		// Objects which aren't shown by any module don't have a link.
+		if l, err := linkGenerator.ForGVK(key.Namespace, key.APIVersion, key.Kind, key.Name, key.Name); err == nil {
+			searchResult.Link = l
+		}
+
+		searchResults.Results = append(searchResults.Results, searchResult)
+	}
+
+	return searchResults, nil
+}
+
+// CreateSearchResultsEvent creates a search results event.
+func CreateSearchResultsEvent(results SearchResults) controllers.Event {
+	return controllers.Event{
+		Type: controllers.EventTypeSearchResults,
+		Data: results,
+	}
+}
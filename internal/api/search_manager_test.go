@@ -0,0 +1,111 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package api_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubenext/lissio/internal/api"
+	"github.com/kubenext/lissio/internal/api/fake"
+	configFake "github.com/kubenext/lissio/internal/config/fake"
+	"github.com/kubenext/lissio/internal/controllers"
+	lissioFake "github.com/kubenext/lissio/internal/controllers/fake"
+	"github.com/kubenext/lissio/internal/search"
+	"github.com/kubenext/lissio/internal/testutil"
+	"github.com/kubenext/lissio/pkg/action"
+	"github.com/kubenext/lissio/pkg/view/component"
+)
+
+func TestSearchManager_Handlers(t *testing.T) {
+	manager := api.NewSearchManager(nil)
+	AssertHandlers(t, manager, []string{api.RequestSearch})
+}
+
+func TestSearchManager_Search(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	pod := testutil.CreatePod("nginx")
+	configMap := testutil.CreateConfigMap("nginx-config")
+
+	index := search.NewIndex()
+	index.Add(testutil.ToUnstructured(t, pod))
+	index.Add(testutil.ToUnstructured(t, configMap))
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().SearchIndex().Return(index)
+	dashConfig.EXPECT().
+		ObjectPath(pod.Namespace, "v1", "Pod", "nginx").
+		Return("/pod", nil)
+	dashConfig.EXPECT().
+		ObjectPath(configMap.Namespace, "v1", "ConfigMap", "nginx-config").
+		Return("", errors.New("unsupported"))
+
+	state := lissioFake.NewMockState(controller)
+	client := fake.NewMockLissioClient(controller)
+
+	expected := api.SearchResults{
+		Query: "nginx",
+		Results: []api.SearchResult{
+			{
+				Name:       "nginx",
+				Namespace:  pod.Namespace,
+				APIVersion: "v1",
+				Kind:       "Pod",
+				Match:      "name",
+				Score:      401,
+				Link:       component.NewLink("", "nginx", "/pod"),
+			},
+			{
+				Name:       "nginx-config",
+				Namespace:  configMap.Namespace,
+				APIVersion: "v1",
+				Kind:       "ConfigMap",
+				Match:      "name",
+				Score:      321,
+			},
+		},
+	}
+	client.EXPECT().Send(controllers.Event{
+		Type: controllers.EventTypeSearchResults,
+		Data: expected,
+	})
+
+	manager := api.NewSearchManager(dashConfig)
+	manager.Start(context.Background(), state, client)
+
+	payload := action.Payload{"query": "nginx", "limit": float64(10)}
+	require.NoError(t, manager.Search(state, payload))
+}
+
+func TestSearchManager_Search_not_started(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().SearchIndex().Return(search.NewIndex())
+
+	state := lissioFake.NewMockState(controller)
+
+	manager := api.NewSearchManager(dashConfig)
+	assert.Error(t, manager.Search(state, action.Payload{"query": "nginx"}))
+}
+
+func TestSearchManager_Search_invalid_payload(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	state := lissioFake.NewMockState(controller)
+
+	manager := api.NewSearchManager(nil)
+	assert.Error(t, manager.Search(state, action.Payload{}))
+}
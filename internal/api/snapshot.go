@@ -0,0 +1,105 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/kubenext/lissio/internal/cluster"
+	"github.com/kubenext/lissio/internal/config"
+	"github.com/kubenext/lissio/internal/log"
+	"github.com/kubenext/lissio/internal/objectstore"
+	"github.com/kubenext/lissio/internal/snapshot"
+)
+
+// resourceAccessFactory creates a ResourceAccess for a cluster client.
+type resourceAccessFactory func(client cluster.ClientInterface) objectstore.ResourceAccess
+
+// snapshotHandler serves a snapshot of the objects the object store has
+// cached. The namespace and kind query parameters select objects and
+// can be repeated. Secret values are redacted unless revealSecrets is true
+// and the user can get the secrets. Exports with secret values are logged.
+func snapshotHandler(ctx context.Context, dashConfig config.Dash, newAccess resourceAccessFactory) http.HandlerFunc {
+	logger := log.From(ctx)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		lister, ok := dashConfig.ObjectStore().(objectstore.CachedObjectLister)
+		if !ok {
+			RespondWithError(w, http.StatusNotImplemented, "object store can't be exported", logger)
+			return
+		}
+
+		options, err := snapshotExportOptions(r)
+		if err != nil {
+			RespondWithError(w, http.StatusBadRequest, err.Error(), logger)
+			return
+		}
+
+		auditLogger := logger.With(
+			"namespaces", options.Namespaces,
+			"remoteAddr", r.RemoteAddr,
+		)
+
+		if options.RevealSecrets {
+			access := newAccess(dashConfig.ClusterClient())
+			if err := snapshot.CheckSecretAccess(r.Context(), access, options); err != nil {
+				auditLogger.WithErr(err).Warnf("denied snapshot export with secret values")
+				RespondWithError(w, http.StatusForbidden, fmt.Sprintf("unable to export secret values: %s", err), logger)
+				return
+			}
+		}
+
+		var buf bytes.Buffer
+		manifest, err := snapshot.Export(&buf, lister, dashConfig.ClusterClient(), options)
+		if err != nil {
+			RespondWithError(w, http.StatusInternalServerError, err.Error(), logger)
+			return
+		}
+
+		auditLogger.With(
+			"objects", manifest.Objects,
+			"secretsRedacted", manifest.SecretsRedacted,
+		).Infof("exported snapshot")
+
+		w.Header().Set("Content-Type", "application/gzip")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", snapshot.FileName(manifest)))
+		if _, err := buf.WriteTo(w); err != nil {
+			logger.Errorf("writing snapshot: %v", err)
+		}
+	}
+}
+
+func snapshotExportOptions(r *http.Request) (snapshot.ExportOptions, error) {
+	query := r.URL.Query()
+
+	options := snapshot.ExportOptions{
+		Namespaces: query["namespace"],
+	}
+
+	for _, kind := range query["kind"] {
+		groupVersionKind, err := snapshot.ParseKind(kind)
+		if err != nil {
+			return snapshot.ExportOptions{}, err
+		}
+		options.GroupVersionKinds = append(options.GroupVersionKinds, groupVersionKind)
+	}
+
+	if s := query.Get("revealSecrets"); s != "" {
+		reveal, err := strconv.ParseBool(s)
+		if err != nil {
+			return snapshot.ExportOptions{}, errors.Errorf("revealSecrets %q is not a boolean", s)
+		}
+		options.RevealSecrets = reveal
+	}
+
+	return options, nil
+}
@@ -0,0 +1,123 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kubenext/lissio/internal/cluster"
+	configFake "github.com/kubenext/lissio/internal/config/fake"
+	"github.com/kubenext/lissio/internal/objectstore"
+	"github.com/kubenext/lissio/internal/snapshot"
+	"github.com/kubenext/lissio/internal/testutil"
+	"github.com/kubenext/lissio/pkg/store"
+)
+
+func Test_snapshotHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objects := []*unstructured.Unstructured{
+		testutil.ToUnstructured(t, testutil.CreatePod("pod")),
+		testutil.ToUnstructured(t, testutil.CreateSecret("secret")),
+	}
+
+	objectStore, err := snapshot.NewStore(objects)
+	require.NoError(t, err)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ObjectStore().Return(objectStore).AnyTimes()
+	dashConfig.EXPECT().ClusterClient().Return(snapshot.NewClient("cluster", objects)).AnyTimes()
+
+	access := &fakeSecretAccess{}
+	newAccess := func(cluster.ClientInterface) objectstore.ResourceAccess {
+		return access
+	}
+
+	handler := snapshotHandler(context.Background(), dashConfig, newAccess)
+
+	t.Run("export", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/snapshot?kind=v1/Pod", nil)
+		handler(w, r)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/gzip", w.Header().Get("Content-Type"))
+		assert.Contains(t, w.Header().Get("Content-Disposition"), "snapshot-cluster-")
+
+		dir, err := ioutil.TempDir("", "snapshot")
+		require.NoError(t, err)
+		defer os.RemoveAll(dir)
+
+		path := filepath.Join(dir, "snapshot.tar.gz")
+		require.NoError(t, ioutil.WriteFile(path, w.Body.Bytes(), 0600))
+
+		snap, err := snapshot.Open(path)
+		require.NoError(t, err)
+		require.Len(t, snap.Objects, 1)
+		assert.Equal(t, "pod", snap.Objects[0].GetName())
+	})
+
+	t.Run("invalid kind", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/snapshot?kind=Pod", nil)
+		handler(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("reveal secrets", func(t *testing.T) {
+		access.err = nil
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/snapshot?kind=v1/Secret&revealSecrets=true", nil)
+		handler(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("reveal secrets denied", func(t *testing.T) {
+		access.err = errors.New("access denied")
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/snapshot?kind=v1/Secret&revealSecrets=true", nil)
+		handler(w, r)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("invalid revealSecrets", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/snapshot?revealSecrets=maybe", nil)
+		handler(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+type fakeSecretAccess struct {
+	objectstore.ResourceAccess
+	err error
+}
+
+func (f *fakeSecretAccess) HasAccess(ctx context.Context, key store.Key, verb string) error {
+	if key.Kind != "Secret" || verb != "get" {
+		return errors.Errorf("unexpected access check for %s %s", verb, key)
+	}
+	return f.err
+}
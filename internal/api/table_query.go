@@ -0,0 +1,170 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package api
+
+import (
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/kubenext/lissio/pkg/action"
+	"github.com/kubenext/lissio/pkg/view/component"
+)
+
+const (
+	RequestSetTableQuery = "setTableQuery"
+)
+
+// TableQueryFromPayload converts a payload to a table name and query.
+func TableQueryFromPayload(payload action.Payload) (string, component.TableQuery, error) {
+	name, err := payload.String("table")
+	if err != nil {
+		return "", component.TableQuery{}, errors.Wrap(err, "extract table name from payload")
+	}
+
+	page, err := payload.OptionalUint16("page")
+	if err != nil {
+		return "", component.TableQuery{}, errors.Wrap(err, "extract page from payload")
+	}
+
+	pageSize, err := payload.OptionalUint16("pageSize")
+	if err != nil {
+		return "", component.TableQuery{}, errors.Wrap(err, "extract page size from payload")
+	}
+
+	sortBy, err := payload.OptionalString("sortBy")
+	if err != nil {
+		return "", component.TableQuery{}, errors.Wrap(err, "extract sort column from payload")
+	}
+
+	reverse, err := payload.OptionalBool("reverse")
+	if err != nil {
+		return "", component.TableQuery{}, errors.Wrap(err, "extract sort direction from payload")
+	}
+
+	filter, err := payload.OptionalString("filter")
+	if err != nil {
+		return "", component.TableQuery{}, errors.Wrap(err, "extract filter from payload")
+	}
+
+	query := component.TableQuery{
+		Page:     int(page),
+		PageSize: int(pageSize),
+		SortBy:   sortBy,
+		Reverse:  reverse,
+		Filter:   filter,
+	}
+
+	return name, query, nil
+}
+
+// TableQueriesFromQueryParams converts query params to table queries. Can
+// handle one or multiple query params.
+func TableQueriesFromQueryParams(in interface{}) (map[string]component.TableQuery, error) {
+	var raw []string
+
+	switch t := in.(type) {
+	case []interface{}:
+		for i := range t {
+			if s, ok := t[i].(string); ok {
+				raw = append(raw, s)
+			}
+		}
+	case string:
+		raw = append(raw, t)
+	default:
+		return nil, errors.Errorf("not sure what to do with table query of type %T", in)
+	}
+
+	queries := make(map[string]component.TableQuery)
+	for _, s := range raw {
+		name, query, err := ParseTableQueryParam(s)
+		if err != nil {
+			return nil, err
+		}
+		queries[name] = query
+	}
+
+	return queries, nil
+}
+
+// ParseTableQueryParam parses a table query from a query param. The param
+// is URL encoded, e.g. `table=Pods&page=2&pageSize=50&sortBy=Age&reverse=true`.
+func ParseTableQueryParam(in string) (string, component.TableQuery, error) {
+	values, err := url.ParseQuery(in)
+	if err != nil {
+		return "", component.TableQuery{}, errors.Wrapf(err, "invalid table query parameter %s", in)
+	}
+
+	name := values.Get("table")
+	if name == "" {
+		return "", component.TableQuery{}, errors.Errorf("table query parameter %s does not have a table", in)
+	}
+
+	query := component.TableQuery{
+		SortBy: values.Get("sortBy"),
+		Filter: values.Get("filter"),
+	}
+
+	if s := values.Get("page"); s != "" {
+		if query.Page, err = strconv.Atoi(s); err != nil {
+			return "", component.TableQuery{}, errors.Wrapf(err, "invalid page in table query parameter %s", in)
+		}
+	}
+
+	if s := values.Get("pageSize"); s != "" {
+		if query.PageSize, err = strconv.Atoi(s); err != nil {
+			return "", component.TableQuery{}, errors.Wrapf(err, "invalid page size in table query parameter %s", in)
+		}
+	}
+
+	if s := values.Get("reverse"); s != "" {
+		if query.Reverse, err = strconv.ParseBool(s); err != nil {
+			return "", component.TableQuery{}, errors.Wrapf(err, "invalid sort direction in table query parameter %s", in)
+		}
+	}
+
+	return name, query, nil
+}
+
+// TableQueriesToQueryParams converts table queries to query params. The
+// params are sorted by table name.
+func TableQueriesToQueryParams(queries map[string]component.TableQuery) []string {
+	var names []string
+	for name := range queries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var params []string
+	for _, name := range names {
+		query := queries[name]
+
+		values := url.Values{}
+		values.Set("table", name)
+		if query.Page > 0 {
+			values.Set("page", strconv.Itoa(query.Page))
+		}
+		if query.PageSize > 0 {
+			values.Set("pageSize", strconv.Itoa(query.PageSize))
+		}
+		if query.SortBy != "" {
+			values.Set("sortBy", query.SortBy)
+		}
+		if query.Reverse {
+			values.Set("reverse", "true")
+		}
+		if query.Filter != "" {
+			values.Set("filter", query.Filter)
+		}
+
+		params = append(params, values.Encode())
+	}
+
+	return params
+}
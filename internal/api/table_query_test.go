@@ -0,0 +1,56 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package api_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubenext/lissio/internal/api"
+	"github.com/kubenext/lissio/pkg/view/component"
+)
+
+func TestTableQueriesToQueryParams(t *testing.T) {
+	queries := map[string]component.TableQuery{
+		"Replica Sets": {Filter: "a&b"},
+		"Pods":         {Page: 2, PageSize: 50, SortBy: "Age", Reverse: true},
+	}
+
+	params := api.TableQueriesToQueryParams(queries)
+	expected := []string{
+		"page=2&pageSize=50&reverse=true&sortBy=Age&table=Pods",
+		"filter=a%26b&table=Replica+Sets",
+	}
+	assert.Equal(t, expected, params)
+
+	var in []interface{}
+	for _, param := range params {
+		in = append(in, param)
+	}
+
+	got, err := api.TableQueriesFromQueryParams(in)
+	require.NoError(t, err)
+	assert.Equal(t, queries, got)
+}
+
+func TestParseTableQueryParam_invalid(t *testing.T) {
+	tests := []string{
+		"page=2",
+		"table=Pods&page=two",
+		"table=Pods&pageSize=-",
+		"table=Pods&reverse=maybe",
+		"table=%zz",
+	}
+
+	for _, test := range tests {
+		t.Run(test, func(t *testing.T) {
+			_, _, err := api.ParseTableQueryParam(test)
+			require.Error(t, err)
+		})
+	}
+}
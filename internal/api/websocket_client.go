@@ -49,12 +49,13 @@ type WebsocketClient struct {
 	state    controllers.State
 	handlers map[string][]controllers.ClientRequestHandler
 	id       uuid.UUID
+	differ   contentDiffer
 }
 
 var _ LissioClient = (*WebsocketClient)(nil)
 
 // NewWebsocketClient creates an instance of WebsocketClient.
-func NewWebsocketClient(ctx context.Context, conn *websocket.Conn, dashConfig config.Dash, actionDispatcher ActionDispatcher, id uuid.UUID) *WebsocketClient {
+func NewWebsocketClient(ctx context.Context, conn *websocket.Conn, dashConfig config.Dash, actionDispatcher ActionDispatcher, id uuid.UUID, stateOptions ...WebsocketStateOption) *WebsocketClient {
 	logger := dashConfig.Logger().With("component", "websocket-client", "client-id", id.String())
 
 	ctx, cancel := context.WithCancel(ctx)
@@ -70,7 +71,7 @@ func NewWebsocketClient(ctx context.Context, conn *websocket.Conn, dashConfig co
 		handlers:   make(map[string][]controllers.ClientRequestHandler),
 	}
 
-	state := NewWebsocketState(dashConfig, actionDispatcher, client)
+	state := NewWebsocketState(dashConfig, actionDispatcher, client, stateOptions...)
 	go state.Start(ctx)
 
 	client.state = state
@@ -101,6 +102,8 @@ func (c *WebsocketClient) readPump() {
 			c.logger.WithErr(err).Errorf("Close websocket connection")
 		}
 
+		// Values revealed to this client shouldn't outlive it.
+		c.dashConfig.SecretReveals().Forget(c.ID())
 	}()
 
 	c.isOpen = true
@@ -196,6 +199,8 @@ func (c *WebsocketClient) writePump() {
 				return
 			}
 
+			response = c.differ.event(response)
+
 			w, err := c.conn.NextWriter(websocket.TextMessage)
 			if err != nil {
 				return
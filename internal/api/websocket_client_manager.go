@@ -39,18 +39,21 @@ type WebsocketClientManager struct {
 	unregister       chan *WebsocketClient
 	ctx              context.Context
 	actionDispatcher ActionDispatcher
+	stateOptions     []WebsocketStateOption
 }
 
 var _ ClientManager = (*WebsocketClientManager)(nil)
 
 // NewWebsocketClientManager creates an instance of WebsocketClientManager.
-func NewWebsocketClientManager(ctx context.Context, dispatcher ActionDispatcher) *WebsocketClientManager {
+// The state options configure each client's state.
+func NewWebsocketClientManager(ctx context.Context, dispatcher ActionDispatcher, stateOptions ...WebsocketStateOption) *WebsocketClientManager {
 	return &WebsocketClientManager{
 		ctx:              ctx,
 		clients:          make(map[*WebsocketClient]context.CancelFunc),
 		register:         make(chan *clientMeta),
 		unregister:       make(chan *WebsocketClient),
 		actionDispatcher: dispatcher,
+		stateOptions:     stateOptions,
 	}
 }
 
@@ -84,8 +87,18 @@ func (m *WebsocketClientManager) ClientFromRequest(dashConfig config.Dash, w htt
 		return nil, err
 	}
 
+	stateOptions := append([]WebsocketStateOption{}, m.stateOptions...)
+	if raw := r.URL.Query().Get(DeepLinkParam); raw != "" {
+		link, err := ParseDeepLink(raw)
+		if err != nil {
+			dashConfig.Logger().WithErr(err).Debugf("ignoring invalid link")
+		} else {
+			stateOptions = append(stateOptions, WebsocketStateLink(link))
+		}
+	}
+
 	ctx, cancel := context.WithCancel(m.ctx)
-	client := NewWebsocketClient(ctx, conn, dashConfig, m.actionDispatcher, clientID)
+	client := NewWebsocketClient(ctx, conn, dashConfig, m.actionDispatcher, clientID, stateOptions...)
 	m.register <- &clientMeta{
 		cancelFunc: func() {
 			cancel()
@@ -9,6 +9,7 @@ import (
 	"context"
 	"fmt"
 	"path"
+	"reflect"
 	"regexp"
 	"sort"
 	"strings"
@@ -19,6 +20,7 @@ import (
 	"github.com/kubenext/lissio/internal/config"
 	"github.com/kubenext/lissio/internal/controllers"
 	"github.com/kubenext/lissio/pkg/action"
+	"github.com/kubenext/lissio/pkg/view/component"
 )
 
 //go:generate mockgen -destination=./fake/mock_state_manager.go -package=fake github.com/kubenext/lissio/internal/api StateManager
@@ -34,16 +36,22 @@ type StateManager interface {
 	Start(ctx context.Context, state controllers.State, s LissioClient)
 }
 
-func defaultStateManagers(clientID string, dashConfig config.Dash) []StateManager {
+func defaultStateManagers(clientID string, dashConfig config.Dash, navigationCache *NavigationCache) []StateManager {
 	logger := dashConfig.Logger().With("client-id", clientID)
+	prewarmManager := NewPrewarmManager(dashConfig)
 
 	return []StateManager{
-		NewContentManager(dashConfig.ModuleManager(), logger),
+		NewContentManager(dashConfig.ModuleManager(), logger,
+			WithContentWatcher(dashConfig.ObjectWatcher()),
+			WithContentKeysObserver(prewarmManager.ObserveContentKeys)),
 		NewFilterManager(),
-		NewNavigationManager(dashConfig),
+		NewNavigationManager(dashConfig, WithNavigationCache(navigationCache)),
 		NewNamespacesManager(dashConfig),
 		NewContextManager(dashConfig),
 		NewActionRequestManager(),
+		NewSearchManager(dashConfig),
+		NewLinkManager(dashConfig),
+		prewarmManager,
 	}
 }
 
@@ -89,6 +97,22 @@ func WebsocketStateManagers(managers []StateManager) WebsocketStateOption {
 	}
 }
 
+// WebsocketStateNavigationCache configures the navigation cache shared by
+// websocket clients.
+func WebsocketStateNavigationCache(cache *NavigationCache) WebsocketStateOption {
+	return func(w *WebsocketState) {
+		w.navigationCache = cache
+	}
+}
+
+// WebsocketStateLink configures the link the client was opened with. The
+// state shows the link's view when it starts.
+func WebsocketStateLink(link DeepLink) WebsocketStateOption {
+	return func(w *WebsocketState) {
+		w.link = &link
+	}
+}
+
 // WebsocketState manages state for a websocket client.
 type WebsocketState struct {
 	dashConfig         config.Dash
@@ -96,18 +120,22 @@ type WebsocketState struct {
 	contentPath        *atomicString
 	namespace          *atomicString
 	filters            []controllers.Filter
+	tableQueries       map[string]component.TableQuery
 	contentPathUpdates map[string]controllers.ContentPathUpdateFunc
 	namespaceUpdates   map[string]controllers.NamespaceUpdateFunc
 
 	mu               sync.RWMutex
 	managers         []StateManager
+	navigationCache  *NavigationCache
 	actionDispatcher ActionDispatcher
+	link             *DeepLink
 
 	startCtx           context.Context
 	managersCancelFunc context.CancelFunc
 }
 
 var _ controllers.State = (*WebsocketState)(nil)
+var _ action.Responder = (*WebsocketState)(nil)
 
 // NewWebsocketState creates an instance of WebsocketState.
 func NewWebsocketState(dashConfig config.Dash, actionDispatcher ActionDispatcher, wsClient LissioClient, options ...WebsocketStateOption) *WebsocketState {
@@ -121,6 +149,7 @@ func NewWebsocketState(dashConfig config.Dash, actionDispatcher ActionDispatcher
 		namespace:          newStringValue(defaultNamespace),
 		contentPath:        newStringValue(""),
 		filters:            make([]controllers.Filter, 0),
+		tableQueries:       make(map[string]component.TableQuery),
 		actionDispatcher:   actionDispatcher,
 	}
 
@@ -129,19 +158,53 @@ func NewWebsocketState(dashConfig config.Dash, actionDispatcher ActionDispatcher
 	}
 
 	if len(w.managers) < 1 {
-		w.managers = defaultStateManagers(wsClient.ID(), dashConfig)
+		w.managers = defaultStateManagers(wsClient.ID(), dashConfig, w.navigationCache)
 	}
 
 	return w
 }
 
 // Start starts WebsocketState by starting all associated StateManagers.
+// Content the managers generate is for this client only.
 func (c *WebsocketState) Start(ctx context.Context) {
+	ctx = controllers.WithClientID(ctx, c.wsClient.ID())
+
+	if c.link != nil {
+		c.applyLink(*c.link)
+	}
+
 	for i := range c.managers {
 		go c.managers[i].Start(ctx, c, c.wsClient)
 	}
 }
 
+// applyLink shows the view a link describes. It runs before the state
+// managers start so the first content sent is for the link's view. The
+// context is shared by every client, so a link for another context isn't
+// opened; the client is alerted so the user can switch context instead.
+func (c *WebsocketState) applyLink(link DeepLink) {
+	if current := c.dashConfig.ContextName(); link.Context != "" && link.Context != current {
+		c.SendAlert(action.CreateAlert(
+			action.AlertTypeWarning,
+			fmt.Sprintf("This link is for context %s, but the dashboard is using %s. Switch to %s and open the link again.",
+				link.Context, current, link.Context),
+			action.DefaultAlertExpiration,
+		))
+		return
+	}
+
+	if link.Namespace != "" {
+		c.SetNamespace(link.Namespace)
+	}
+
+	c.SetFilters(link.Filters)
+	c.SetTableQueries(link.TableQueries)
+
+	if link.ContentPath != "" {
+		c.SetContentPath(link.ContentPath)
+	}
+}
+
 // Handlers returns all the handlers for WebsocketState.
 func (c *WebsocketState) Handlers() []controllers.ClientRequestHandler {
 	var handlers []controllers.ClientRequestHandler
@@ -155,6 +218,7 @@ func (c *WebsocketState) Handlers() []controllers.ClientRequestHandler {
 
 // Dispatch dispatches a message.
 func (c *WebsocketState) Dispatch(ctx context.Context, actionName string, payload action.Payload) error {
+	ctx = controllers.WithClientID(ctx, c.wsClient.ID())
 	return c.actionDispatcher.Dispatch(ctx, c, actionName, payload)
 }
 
@@ -269,21 +333,23 @@ func (c *WebsocketState) OnNamespaceUpdate(fn controllers.NamespaceUpdateFunc) c
 // AddFilter adds a content filter.
 func (c *WebsocketState) AddFilter(filter controllers.Filter) {
 	c.mu.Lock()
-	defer c.mu.Unlock()
 
 	for i := range c.filters {
 		if c.filters[i].IsEqual(filter) {
+			c.mu.Unlock()
 			return
 		}
 	}
 
 	c.filters = append(c.filters, filter)
+	c.mu.Unlock()
+
+	c.refreshContent()
 }
 
 // RemoveFilter removes a content filter.
 func (c *WebsocketState) RemoveFilter(filter controllers.Filter) {
 	c.mu.Lock()
-	defer c.mu.Unlock()
 
 	var newFilters []controllers.Filter
 
@@ -294,7 +360,13 @@ func (c *WebsocketState) RemoveFilter(filter controllers.Filter) {
 		newFilters = append(newFilters, c.filters[i])
 	}
 
+	changed := len(newFilters) != len(c.filters)
 	c.filters = newFilters
+	c.mu.Unlock()
+
+	if changed {
+		c.refreshContent()
+	}
 }
 
 // GetFilters returns all filters.
@@ -311,9 +383,72 @@ func (c *WebsocketState) GetFilters() []controllers.Filter {
 
 func (c *WebsocketState) SetFilters(filters []controllers.Filter) {
 	c.mu.Lock()
-	defer c.mu.Unlock()
-
+	changed := !filtersEqual(c.filters, filters)
 	c.filters = filters
+	c.mu.Unlock()
+
+	if changed {
+		c.refreshContent()
+	}
+}
+
+// GetTableQueries returns the queries for paginated tables.
+func (c *WebsocketState) GetTableQueries() map[string]component.TableQuery {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	queries := make(map[string]component.TableQuery)
+	for name, query := range c.tableQueries {
+		queries[name] = query
+	}
+
+	return queries
+}
+
+// SetTableQueries replaces the queries for paginated tables.
+func (c *WebsocketState) SetTableQueries(queries map[string]component.TableQuery) {
+	c.mu.Lock()
+	changed := !reflect.DeepEqual(c.tableQueries, queries)
+	c.tableQueries = make(map[string]component.TableQuery)
+	for name, query := range queries {
+		c.tableQueries[name] = query
+	}
+	c.mu.Unlock()
+
+	if changed {
+		c.refreshContent()
+	}
+}
+
+// refreshContent calls the content path update functions with the current
+// content path, so content is generated again after a change which isn't
+// to the content path.
+func (c *WebsocketState) refreshContent() {
+	c.mu.RLock()
+	var fns []controllers.ContentPathUpdateFunc
+	for _, fn := range c.contentPathUpdates {
+		fns = append(fns, fn)
+	}
+	c.mu.RUnlock()
+
+	contentPath := c.GetContentPath()
+	for _, fn := range fns {
+		fn(contentPath)
+	}
+}
+
+func filtersEqual(a, b []controllers.Filter) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if !a[i].IsEqual(b[i]) {
+			return false
+		}
+	}
+
+	return true
 }
 
 // SetContext sets the Kubernetes context.
@@ -350,6 +485,10 @@ func (c *WebsocketState) GetQueryParams() map[string][]string {
 		queryParams["filters"] = filterList
 	}
 
+	if tableList := TableQueriesToQueryParams(c.GetTableQueries()); len(tableList) > 0 {
+		queryParams["tables"] = tableList
+	}
+
 	return queryParams
 }
 
@@ -358,6 +497,11 @@ func (c *WebsocketState) SendAlert(alert action.Alert) {
 	c.wsClient.Send(CreateAlertUpdate(alert))
 }
 
+// SendActionResult sends the result of an action to the websocket client.
+func (c *WebsocketState) SendActionResult(actionName string, result action.Payload) {
+	c.wsClient.Send(CreateActionResultUpdate(actionName, result))
+}
+
 func updateContentPathNamespace(in, namespace string) string {
 	parts := strings.Split(in, "/")
 	if in == "" {
@@ -389,3 +533,11 @@ func CreateAlertUpdate(alert action.Alert) controllers.Event {
 		"expiration": alert.Expiration,
 	})
 }
+
+// CreateActionResultUpdate creates an action result event.
+func CreateActionResultUpdate(actionName string, result action.Payload) controllers.Event {
+	return CreateEvent(controllers.EventTypeActionResult, action.Payload{
+		"action": actionName,
+		"result": result,
+	})
+}
@@ -21,6 +21,8 @@ import (
 	"github.com/kubenext/lissio/internal/controllers"
 	"github.com/kubenext/lissio/internal/log"
 	moduleFake "github.com/kubenext/lissio/internal/module/fake"
+	"github.com/kubenext/lissio/pkg/action"
+	"github.com/kubenext/lissio/pkg/view/component"
 )
 
 func TestWebsocketState_Start(t *testing.T) {
@@ -30,6 +32,7 @@ func TestWebsocketState_Start(t *testing.T) {
 	started := make(chan bool, 1)
 	mocks.stateManager.EXPECT().Start(gomock.Any(), gomock.Any(), gomock.Any()).
 		DoAndReturn(func(ctx context.Context, state controllers.State, wsClient api.LissioClient) {
+			assert.Equal(t, "client", controllers.ClientIDFrom(ctx))
 			started <- true
 		})
 	s := mocks.factory()
@@ -42,6 +45,95 @@ func TestWebsocketState_Start(t *testing.T) {
 	cancel()
 }
 
+func TestWebsocketState_Dispatch(t *testing.T) {
+	mocks := newWebsocketStateMocks(t, "default")
+	defer mocks.finish()
+
+	payload := action.Payload{"key": "value"}
+
+	mocks.actionDispatcher.EXPECT().
+		Dispatch(gomock.Any(), gomock.Any(), "action", payload).
+		DoAndReturn(func(ctx context.Context, alerter action.Alerter, actionName string, payload action.Payload) error {
+			assert.Equal(t, "client", controllers.ClientIDFrom(ctx))
+			return nil
+		})
+
+	s := mocks.factory()
+	require.NoError(t, s.Dispatch(context.Background(), "action", payload))
+}
+
+func TestWebsocketState_Start_link(t *testing.T) {
+	mocks := newWebsocketStateMocks(t, "default")
+	defer mocks.finish()
+
+	contentPath := "overview/namespace/kube-system/workloads"
+	filters := []controllers.Filter{{Key: "app", Value: "nginx"}}
+	tableQueries := map[string]component.TableQuery{"Pods": {Page: 2}}
+
+	mocks.dashConfig.EXPECT().ContextName().Return("dev")
+	mocks.moduleManager.EXPECT().ModuleForContentPath(contentPath).Return(mocks.module, true)
+
+	started := make(chan bool, 1)
+	mocks.stateManager.EXPECT().Start(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, state controllers.State, wsClient api.LissioClient) {
+			started <- true
+		})
+
+	options := append(mocks.options(), api.WebsocketStateLink(api.DeepLink{
+		Context:      "dev",
+		Namespace:    "kube-system",
+		ContentPath:  contentPath,
+		Filters:      filters,
+		TableQueries: tableQueries,
+	}))
+	s := api.NewWebsocketState(mocks.dashConfig, mocks.actionDispatcher, mocks.wsClient, options...)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s.Start(ctx)
+
+	<-started
+
+	assert.Equal(t, "kube-system", s.GetNamespace())
+	assert.Equal(t, contentPath, s.GetContentPath())
+	assert.Equal(t, filters, s.GetFilters())
+	assert.Equal(t, tableQueries, s.GetTableQueries())
+}
+
+func TestWebsocketState_Start_link_other_context(t *testing.T) {
+	mocks := newWebsocketStateMocks(t, "default")
+	defer mocks.finish()
+
+	mocks.dashConfig.EXPECT().ContextName().Return("prod")
+	mocks.wsClient.EXPECT().Send(gomock.Any()).
+		Do(func(ev controllers.Event) {
+			assert.Equal(t, controllers.EventTypeAlert, ev.Type)
+		})
+
+	started := make(chan bool, 1)
+	mocks.stateManager.EXPECT().Start(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, state controllers.State, wsClient api.LissioClient) {
+			started <- true
+		})
+
+	options := append(mocks.options(), api.WebsocketStateLink(api.DeepLink{
+		Context:     "dev",
+		Namespace:   "kube-system",
+		ContentPath: "overview/namespace/kube-system/workloads",
+		Filters:     []controllers.Filter{{Key: "app", Value: "nginx"}},
+	}))
+	s := api.NewWebsocketState(mocks.dashConfig, mocks.actionDispatcher, mocks.wsClient, options...)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s.Start(ctx)
+
+	<-started
+
+	assert.Equal(t, "default", s.GetNamespace())
+	assert.Empty(t, s.GetFilters())
+}
+
 func TestWebsocketState_SetContentPath(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -252,6 +344,71 @@ func TestWebsocketState_AddFilter(t *testing.T) {
 	assert.Equal(t, expected, got)
 }
 
+func TestWebsocketState_filtersRefreshContent(t *testing.T) {
+	mocks := newWebsocketStateMocks(t, "default")
+	defer mocks.finish()
+	s := mocks.factory()
+
+	var updates []string
+	cancelUpdate := s.OnContentPathUpdate(func(contentPath string) {
+		updates = append(updates, contentPath)
+	})
+	defer cancelUpdate()
+
+	filter := controllers.Filter{Key: "key", Value: "value"}
+
+	s.AddFilter(filter)
+	s.AddFilter(filter)
+	s.SetFilters([]controllers.Filter{filter})
+	s.RemoveFilter(filter)
+	s.RemoveFilter(filter)
+
+	assert.Len(t, updates, 2)
+}
+
+func TestWebsocketState_SetTableQueries(t *testing.T) {
+	mocks := newWebsocketStateMocks(t, "default")
+	defer mocks.finish()
+	s := mocks.factory()
+
+	var updates []string
+	cancelUpdate := s.OnContentPathUpdate(func(contentPath string) {
+		updates = append(updates, contentPath)
+	})
+	defer cancelUpdate()
+
+	queries := map[string]component.TableQuery{
+		"Pods": {Page: 2},
+	}
+
+	s.SetTableQueries(queries)
+	s.SetTableQueries(queries)
+
+	got := s.GetTableQueries()
+	assert.Equal(t, queries, got)
+	assert.Len(t, updates, 1)
+
+	got["Pods"] = component.TableQuery{Page: 3}
+	assert.Equal(t, queries, s.GetTableQueries())
+
+	mocks.wsClient.EXPECT().Send(gomock.Any())
+	expected := map[string][]string{
+		"tables": {"page=2&table=Pods"},
+	}
+	assert.Equal(t, expected, s.GetQueryParams())
+}
+
+func TestWebsocketState_SendActionResult(t *testing.T) {
+	mocks := newWebsocketStateMocks(t, "default")
+	defer mocks.finish()
+
+	result := action.Payload{"id": "1"}
+	mocks.wsClient.EXPECT().Send(api.CreateActionResultUpdate("action.lissio.dev/test", result))
+
+	s := mocks.factory()
+	s.SendActionResult("action.lissio.dev/test", result)
+}
+
 type websocketStateMocks struct {
 	controller       *gomock.Controller
 	module           *moduleFake.MockModule
@@ -272,6 +429,7 @@ func newWebsocketStateMocks(t *testing.T, namespace string) *websocketStateMocks
 	dashConfig.EXPECT().ModuleManager().Return(moduleManager).AnyTimes()
 	dashConfig.EXPECT().Logger().Return(log.NopLogger()).AnyTimes()
 	lissioClient := fake.NewMockLissioClient(controller)
+	lissioClient.EXPECT().ID().Return("client").AnyTimes()
 	stateManager := fake.NewMockStateManager(controller)
 	actionDispatcher := fake.NewMockActionDispatcher(controller)
 
@@ -12,6 +12,7 @@ import (
 	golog "log"
 	"os"
 	"os/signal"
+	"time"
 
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
@@ -33,6 +34,10 @@ func newLissioCmd() *cobra.Command {
 	var klogVerbosity int
 	var clientQPS float32
 	var clientBurst int
+	var pluginURLs map[string]string
+	var informerIdleTimeout time.Duration
+	var disableObjectTrimming bool
+	var snapshotDir string
 
 	lissioCmd := &cobra.Command{
 		Use:   "lissio",
@@ -72,6 +77,11 @@ func newLissioCmd() *cobra.Command {
 					Context:          initialContext,
 					ClientQPS:        clientQPS,
 					ClientBurst:      clientBurst,
+					PluginURLs:       pluginURLs,
+
+					InformerIdleTimeout:   informerIdleTimeout,
+					DisableObjectTrimming: disableObjectTrimming,
+					Snapshot:              snapshotDir,
 				}
 
 				if klogVerbosity > 0 {
@@ -112,6 +122,10 @@ func newLissioCmd() *cobra.Command {
 	lissioCmd.Flags().IntVarP(&klogVerbosity, "klog-verbosity", "", 0, "klog verbosity level")
 	lissioCmd.Flags().Float32VarP(&clientQPS, "client-qps", "", 200, "maximum QPS for client")
 	lissioCmd.Flags().IntVarP(&clientBurst, "client-burst", "", 400, "maximum burst for client throttle")
+	lissioCmd.Flags().StringToStringVarP(&pluginURLs, "plugin-url", "", nil, "plugin reached over HTTP as name=url (can be repeated)")
+	lissioCmd.Flags().DurationVarP(&informerIdleTimeout, "informer-idle-timeout", "", 10*time.Minute, "stop informers for resources which haven't been viewed for this long (0 keeps them)")
+	lissioCmd.Flags().StringVarP(&snapshotDir, "snapshot", "", "", "browse a directory of YAML or JSON files or an exported snapshot read-only instead of a cluster")
+	lissioCmd.Flags().BoolVarP(&disableObjectTrimming, "disable-object-trimming", "", false, "cache objects with managed fields and last applied configuration")
 
 	kubeConfig = os.Getenv("KUBECONFIG")
 	if kubeConfig == "" {
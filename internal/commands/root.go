@@ -30,6 +30,7 @@ func Execute(version string, gitCommit string, buildTime string) {
 func newRoot(version string, gitCommit string, buildTime string) *cobra.Command {
 	rootCmd := newLissioCmd()
 	rootCmd.AddCommand(newVersionCmd(version, gitCommit, buildTime))
+	rootCmd.AddCommand(newSnapshotCmd())
 
 	return rootCmd
 }
@@ -0,0 +1,126 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package commands
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/kubenext/lissio/internal/api"
+	"github.com/kubenext/lissio/internal/snapshot"
+)
+
+func newSnapshotCmd() *cobra.Command {
+	snapshotCmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Work with snapshots",
+		Long:  "Export snapshots of the objects a running dashboard has cached. Snapshots can be browsed with --snapshot.",
+	}
+
+	snapshotCmd.AddCommand(newSnapshotExportCmd())
+
+	return snapshotCmd
+}
+
+func newSnapshotExportCmd() *cobra.Command {
+	var output string
+	var address string
+	var namespaces []string
+	var kinds []string
+	var revealSecrets bool
+
+	exportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export a snapshot",
+		Long:  "Export the objects a running dashboard has cached to a tarball. Secret values are redacted unless --reveal-secrets is set.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			for _, kind := range kinds {
+				if _, err := snapshot.ParseKind(kind); err != nil {
+					return err
+				}
+			}
+
+			query := url.Values{}
+			query["namespace"] = namespaces
+			query["kind"] = kinds
+			if revealSecrets {
+				query.Set("revealSecrets", strconv.FormatBool(revealSecrets))
+			}
+
+			u := url.URL{
+				Scheme:   "http",
+				Host:     address,
+				Path:     api.PathPrefix + "/snapshot",
+				RawQuery: query.Encode(),
+			}
+
+			path, err := downloadSnapshot(u.String(), output)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), "Exported snapshot to", path)
+			return nil
+		},
+	}
+
+	exportCmd.Flags().StringVarP(&output, "output", "o", "", "file to write the snapshot to (defaults to a name based on the context and time)")
+	exportCmd.Flags().StringVar(&address, "address", api.ListenerAddr(), "address of the running dashboard")
+	exportCmd.Flags().StringArrayVarP(&namespaces, "namespace", "n", nil, "export namespaced objects in this namespace (can be repeated)")
+	exportCmd.Flags().StringArrayVar(&kinds, "kind", nil, "export objects of this api version and kind, e.g. apps/v1/Deployment (can be repeated)")
+	exportCmd.Flags().BoolVar(&revealSecrets, "reveal-secrets", false, "export secret values")
+
+	return exportCmd
+}
+
+// downloadSnapshot downloads a snapshot to output. If output is blank,
+// the file name suggested by the dashboard is used.
+func downloadSnapshot(snapshotURL, output string) (string, error) {
+	resp, err := http.Get(snapshotURL)
+	if err != nil {
+		return "", errors.Wrap(err, "is the dashboard running?")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 4096))
+		return "", errors.Errorf("export snapshot: %s: %s", resp.Status, body)
+	}
+
+	if output == "" {
+		output = "snapshot.tar.gz"
+		if _, params, err := mime.ParseMediaType(resp.Header.Get("Content-Disposition")); err == nil && params["filename"] != "" {
+			output = filepath.Base(params["filename"])
+		}
+	}
+
+	f, err := os.OpenFile(output, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return "", errors.Wrap(err, "create snapshot file")
+	}
+
+	if _, err := io.Copy(f, resp.Body); err != nil {
+		f.Close()
+		return "", errors.Wrap(err, "write snapshot")
+	}
+
+	if err := f.Close(); err != nil {
+		return "", errors.Wrap(err, "write snapshot")
+	}
+
+	return output, nil
+}
@@ -17,11 +17,15 @@ import (
 	"github.com/kubenext/lissio/internal/cluster"
 	"github.com/kubenext/lissio/internal/log"
 	"github.com/kubenext/lissio/internal/module"
+	"github.com/kubenext/lissio/internal/objectstore"
 	"github.com/kubenext/lissio/internal/portforward"
+	"github.com/kubenext/lissio/internal/search"
+	"github.com/kubenext/lissio/internal/secrets"
 	"github.com/kubenext/lissio/pkg/plugin"
 )
 
 //go:generate mockgen -destination=./fake/mock_dash.go -package=fake github.com/kubenext/lissio/internal/config Dash
+//go:generate mockgen -destination=./fake/mock_crd_watcher.go -package=fake github.com/kubenext/lissio/internal/config CRDWatcher
 
 // CRDWatcher watches for CRDs.
 type CRDWatcher interface {
@@ -77,12 +81,18 @@ type Dash interface {
 
 	ObjectStore() store.Store
 
+	ObjectWatcher() *objectstore.Watcher
+
 	Logger() log.Logger
 
 	PluginManager() plugin.ManagerInterface
 
 	PortForwarder() portforward.PortForwarder
 
+	SecretReveals() *secrets.Reveals
+
+	SearchIndex() *search.Index
+
 	KubeConfigPath() string
 
 	UseContext(ctx context.Context, contextName string) error
@@ -103,8 +113,11 @@ type Live struct {
 	logger             log.Logger
 	moduleManager      module.ManagerInterface
 	objectStore        store.Store
+	objectWatcher      *objectstore.Watcher
 	pluginManager      plugin.ManagerInterface
 	portForwarder      portforward.PortForwarder
+	secretReveals      *secrets.Reveals
+	searchIndex        *search.Index
 	kubeConfigPath     string
 	currentContextName string
 	restConfigOptions  cluster.RESTConfigOptions
@@ -122,6 +135,7 @@ func NewLiveConfig(
 	objectStore store.Store,
 	pluginManager plugin.ManagerInterface,
 	portForwarder portforward.PortForwarder,
+	searchIndex *search.Index,
 	currentContextName string,
 	restConfigOptions cluster.RESTConfigOptions,
 ) *Live {
@@ -132,8 +146,11 @@ func NewLiveConfig(
 		logger:             logger,
 		moduleManager:      moduleManager,
 		objectStore:        objectStore,
+		objectWatcher:      objectstore.NewWatcher(objectStore, logger),
 		pluginManager:      pluginManager,
 		portForwarder:      portForwarder,
+		secretReveals:      secrets.NewReveals(secrets.DefaultRevealDuration),
+		searchIndex:        searchIndex,
 		currentContextName: currentContextName,
 		restConfigOptions:  restConfigOptions,
 	}
@@ -164,6 +181,11 @@ func (l *Live) ObjectStore() store.Store {
 	return l.objectStore
 }
 
+// ObjectWatcher returns a watcher for changes to objects in the object store.
+func (l *Live) ObjectWatcher() *objectstore.Watcher {
+	return l.objectWatcher
+}
+
 // KubeConfigPath returns the kube config path.
 func (l *Live) KubeConfigPath() string {
 	return l.kubeConfigPath
@@ -184,8 +206,24 @@ func (l *Live) PortForwarder() portforward.PortForwarder {
 	return l.portForwarder
 }
 
+// SecretReveals returns the secret values which have been revealed.
+func (l *Live) SecretReveals() *secrets.Reveals {
+	return l.secretReveals
+}
+
+// SearchIndex returns the search index for objects in the object store.
+func (l *Live) SearchIndex() *search.Index {
+	return l.searchIndex
+}
+
 // UseContext switches context name. This process should have synchronously.
+// Contexts can't be switched without a kube config, e.g. when browsing a
+// snapshot.
 func (l *Live) UseContext(ctx context.Context, contextName string) error {
+	if l.kubeConfigPath == "" {
+		return errors.New("unable to switch context without a kube config")
+	}
+
 	client, err := cluster.FromKubeConfig(ctx, l.kubeConfigPath, contextName, l.restConfigOptions)
 	if err != nil {
 		return err
@@ -19,6 +19,7 @@ import (
 	"github.com/kubenext/lissio/internal/log"
 	moduleFake "github.com/kubenext/lissio/internal/module/fake"
 	portForwardFake "github.com/kubenext/lissio/internal/portforward/fake"
+	"github.com/kubenext/lissio/internal/search"
 	"github.com/kubenext/lissio/internal/testutil"
 	pluginFake "github.com/kubenext/lissio/pkg/plugin/fake"
 	objectStoreFake "github.com/kubenext/lissio/pkg/store/fake"
@@ -85,12 +86,15 @@ func TestLiveConfig(t *testing.T) {
 	kubeConfigPath := "/path"
 
 	objectStore.EXPECT().
-		RegisterOnUpdate(gomock.Any())
+		RegisterOnUpdate(gomock.Any()).
+		Times(2)
 
 	contextName := "context-name"
 	restConfigOptions := cluster.RESTConfigOptions{}
 
-	config := NewLiveConfig(clusterClient, crdWatcher, kubeConfigPath, logger, moduleManager, objectStore, pluginManager, portForwarder, contextName, restConfigOptions)
+	searchIndex := search.NewIndex()
+
+	config := NewLiveConfig(clusterClient, crdWatcher, kubeConfigPath, logger, moduleManager, objectStore, pluginManager, portForwarder, searchIndex, contextName, restConfigOptions)
 
 	assert.NoError(t, config.Validate())
 	assert.Equal(t, clusterClient, config.ClusterClient())
@@ -99,6 +103,9 @@ func TestLiveConfig(t *testing.T) {
 	assert.Equal(t, objectStore, config.ObjectStore())
 	assert.Equal(t, pluginManager, config.PluginManager())
 	assert.Equal(t, portForwarder, config.PortForwarder())
+	assert.NotNil(t, config.ObjectWatcher())
+	assert.NotNil(t, config.SecretReveals())
+	assert.Equal(t, searchIndex, config.SearchIndex())
 
 	objectPath, err := config.ObjectPath("", "", "", "")
 	require.NoError(t, err)
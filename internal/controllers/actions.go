@@ -1,5 +1,13 @@
 package controllers
 
 const (
-	ActionDeleteObject = "lissio/deleteObject"
+	ActionDeleteObject    = "lissio/deleteObject"
+	ActionStopPortForward = "lissio/stopPortForward"
+	ActionRevealSecret    = "lissio/revealSecret"
+	ActionEnablePlugin    = "lissio/enablePlugin"
+	ActionDisablePlugin   = "lissio/disablePlugin"
+	ActionRestartPlugin   = "lissio/restartPlugin"
+	ActionExportSnapshot  = "lissio/exportSnapshot"
+
+	ActionUpdatePluginSettings = "lissio/updatePluginSettings"
 )
@@ -0,0 +1,27 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package controllers
+
+import "context"
+
+type clientIDKey struct{}
+
+// WithClientID returns a context for work done for a dashboard client,
+// e.g. generating its content or running its actions.
+func WithClientID(ctx context.Context, clientID string) context.Context {
+	return context.WithValue(ctx, clientIDKey{}, clientID)
+}
+
+// ClientIDFrom returns the ID of the client work is done for. It returns
+// an empty string if the work isn't for a dashboard client.
+func ClientIDFrom(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+
+	clientID, _ := ctx.Value(clientIDKey{}).(string)
+	return clientID
+}
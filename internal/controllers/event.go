@@ -11,6 +11,9 @@ const (
 	// EventTypeContent is a content event.
 	EventTypeContent EventType = "content"
 
+	// EventTypeContentPatch is a JSON patch for the last content event.
+	EventTypeContentPatch EventType = "contentPatch"
+
 	// EventTypeNamespaces is a namespaces event.
 	EventTypeNamespaces EventType = "namespaces"
 
@@ -43,6 +46,19 @@ const (
 
 	// EventTypeAlert is an alert event.
 	EventTypeAlert EventType = "alert"
+
+	// EventTypeActionResult is an action result event.
+	EventTypeActionResult EventType = "actionResult"
+
+	// EventTypeSearchResults is a search results event.
+	EventTypeSearchResults EventType = "searchResults"
+
+	// EventTypeLoading is an event describing the progress of caching
+	// objects for a namespace.
+	EventTypeLoading EventType = "loading"
+
+	// EventTypeLink is an event with a link to the view a client is showing.
+	EventTypeLink EventType = "link"
 )
 
 // Event is an event for the dash frontend.
@@ -0,0 +1,128 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kubenext/lissio/internal/log"
+	"github.com/kubenext/lissio/internal/objectstore"
+	"github.com/kubenext/lissio/internal/secrets"
+	"github.com/kubenext/lissio/pkg/action"
+	"github.com/kubenext/lissio/pkg/store"
+)
+
+// SecretRevealer reveals and hides values in secrets. Values are only
+// revealed to the dashboard client which asked for them, only if the user
+// can get the secret, and every reveal is logged.
+type SecretRevealer struct {
+	logger  log.Logger
+	store   store.Store
+	access  objectstore.ResourceAccess
+	reveals *secrets.Reveals
+}
+
+var _ action.Dispatcher = (*SecretRevealer)(nil)
+
+// NewSecretRevealer creates an instance of SecretRevealer.
+func NewSecretRevealer(logger log.Logger, objectStore store.Store, access objectstore.ResourceAccess, reveals *secrets.Reveals) *SecretRevealer {
+	return &SecretRevealer{
+		logger:  logger.With("action", ActionRevealSecret),
+		store:   objectStore,
+		access:  access,
+		reveals: reveals,
+	}
+}
+
+// ActionName returns the name of this action.
+func (s *SecretRevealer) ActionName() string {
+	return ActionRevealSecret
+}
+
+// Handle reveals a secret value. If the payload sets hide, the value is hidden instead.
+func (s *SecretRevealer) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	namespace, err := payload.String("namespace")
+	if err != nil {
+		return err
+	}
+
+	name, err := payload.String("name")
+	if err != nil {
+		return err
+	}
+
+	dataKey, err := payload.String("key")
+	if err != nil {
+		return err
+	}
+
+	hide, err := payload.OptionalBool("hide")
+	if err != nil {
+		return err
+	}
+
+	clientID := ClientIDFrom(ctx)
+	logger := s.logger.With("namespace", namespace, "name", name, "key", dataKey, "client", clientID)
+
+	if hide {
+		s.reveals.Hide(clientID, namespace, name, dataKey)
+		logger.Debugf("hid secret value")
+		return nil
+	}
+
+	if clientID == "" {
+		sendAlert(alerter, action.AlertTypeWarning, fmt.Sprintf("Unable to reveal %q in Secret %q: values can only be revealed in the dashboard", dataKey, name))
+		return nil
+	}
+
+	key := store.Key{
+		Namespace:  namespace,
+		APIVersion: "v1",
+		Kind:       "Secret",
+		Name:       name,
+	}
+
+	if err := s.access.HasAccess(ctx, key, "get"); err != nil {
+		logger.WithErr(err).Warnf("denied secret value reveal")
+		sendAlert(alerter, action.AlertTypeWarning, fmt.Sprintf("Unable to reveal %q in Secret %q: %s", dataKey, name, err))
+		return nil
+	}
+
+	object, found, err := s.store.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return errors.Errorf("secret %s/%s was not found", namespace, name)
+	}
+
+	data, found, err := unstructured.NestedMap(object.Object, "data")
+	if err != nil {
+		return errors.Wrapf(err, "get data from secret %s/%s", namespace, name)
+	}
+
+	if _, ok := data[dataKey]; !found || !ok {
+		return errors.Errorf("key %q was not found in secret %s/%s", dataKey, namespace, name)
+	}
+
+	s.reveals.Reveal(clientID, namespace, name, dataKey)
+	logger.Infof("revealed secret value")
+
+	sendAlert(alerter, action.AlertTypeInfo,
+		fmt.Sprintf("Revealed %q in Secret %q for %s", dataKey, name, s.reveals.Duration()))
+
+	return nil
+}
+
+func sendAlert(alerter action.Alerter, alertType action.AlertType, message string) {
+	alert := action.CreateAlert(alertType, message, action.DefaultAlertExpiration)
+	alerter.SendAlert(alert)
+}
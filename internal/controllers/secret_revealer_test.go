@@ -0,0 +1,193 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubenext/lissio/internal/log"
+	"github.com/kubenext/lissio/internal/objectstore"
+	"github.com/kubenext/lissio/internal/secrets"
+	"github.com/kubenext/lissio/internal/testutil"
+	"github.com/kubenext/lissio/pkg/action"
+	actionFake "github.com/kubenext/lissio/pkg/action/fake"
+	"github.com/kubenext/lissio/pkg/store"
+	storeFake "github.com/kubenext/lissio/pkg/store/fake"
+)
+
+func TestSecretRevealer(t *testing.T) {
+	secret := testutil.CreateSecret("secret")
+	secret.Data = map[string][]byte{"password": []byte("hunter2")}
+
+	key := store.Key{
+		Namespace:  secret.Namespace,
+		APIVersion: "v1",
+		Kind:       "Secret",
+		Name:       secret.Name,
+	}
+
+	withoutData := testutil.CreateSecret("secret")
+
+	tests := []struct {
+		name         string
+		clientID     string
+		payload      action.Payload
+		setup        func(objectStore *storeFake.MockStore, access *fakeResourceAccess)
+		alertType    action.AlertType
+		alertMessage string
+		revealed     bool
+		isErr        bool
+	}{
+		{
+			name:     "reveal",
+			clientID: "client",
+			payload: action.Payload{
+				"namespace": secret.Namespace,
+				"name":      secret.Name,
+				"key":       "password",
+			},
+			setup: func(objectStore *storeFake.MockStore, access *fakeResourceAccess) {
+				objectStore.EXPECT().Get(gomock.Any(), key).Return(testutil.ToUnstructured(t, secret), true, nil)
+			},
+			alertType:    action.AlertTypeInfo,
+			alertMessage: `Revealed "password" in Secret "secret" for 2m0s`,
+			revealed:     true,
+		},
+		{
+			name:     "access denied",
+			clientID: "client",
+			payload: action.Payload{
+				"namespace": secret.Namespace,
+				"name":      secret.Name,
+				"key":       "password",
+			},
+			setup: func(objectStore *storeFake.MockStore, access *fakeResourceAccess) {
+				access.err = &objectstore.AccessError{Key: objectstore.AccessKey{Namespace: "namespace", Resource: "secrets", Verb: "get"}}
+			},
+			alertType:    action.AlertTypeWarning,
+			alertMessage: `Unable to reveal "password" in Secret "secret": access denied: no get access in namespace to /secrets`,
+		},
+		{
+			name:     "missing key",
+			clientID: "client",
+			payload: action.Payload{
+				"namespace": secret.Namespace,
+				"name":      secret.Name,
+				"key":       "missing",
+			},
+			setup: func(objectStore *storeFake.MockStore, access *fakeResourceAccess) {
+				objectStore.EXPECT().Get(gomock.Any(), key).Return(testutil.ToUnstructured(t, secret), true, nil)
+			},
+			isErr: true,
+		},
+		{
+			name:     "secret without data",
+			clientID: "client",
+			payload: action.Payload{
+				"namespace": secret.Namespace,
+				"name":      secret.Name,
+				"key":       "password",
+			},
+			setup: func(objectStore *storeFake.MockStore, access *fakeResourceAccess) {
+				objectStore.EXPECT().Get(gomock.Any(), key).Return(testutil.ToUnstructured(t, withoutData), true, nil)
+			},
+			isErr: true,
+		},
+		{
+			name: "no client",
+			payload: action.Payload{
+				"namespace": secret.Namespace,
+				"name":      secret.Name,
+				"key":       "password",
+			},
+			setup:        func(objectStore *storeFake.MockStore, access *fakeResourceAccess) {},
+			alertType:    action.AlertTypeWarning,
+			alertMessage: `Unable to reveal "password" in Secret "secret": values can only be revealed in the dashboard`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			objectStore := storeFake.NewMockStore(controller)
+			access := &fakeResourceAccess{}
+			alerter := actionFake.NewMockAlerter(controller)
+
+			test.setup(objectStore, access)
+
+			if !test.isErr {
+				alerter.EXPECT().
+					SendAlert(gomock.Any()).
+					DoAndReturn(func(alert action.Alert) {
+						assert.Equal(t, test.alertType, alert.Type)
+						assert.Equal(t, test.alertMessage, alert.Message)
+					})
+			}
+
+			reveals := secrets.NewReveals(secrets.DefaultRevealDuration)
+			revealer := NewSecretRevealer(log.NopLogger(), objectStore, access, reveals)
+			assert.Equal(t, ActionRevealSecret, revealer.ActionName())
+
+			ctx := WithClientID(context.Background(), test.clientID)
+			err := revealer.Handle(ctx, alerter, test.payload)
+			if test.isErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			key, err := test.payload.String("key")
+			require.NoError(t, err)
+			assert.Equal(t, test.revealed, reveals.IsRevealed(test.clientID, secret.Namespace, secret.Name, key))
+			assert.False(t, reveals.IsRevealed("other-client", secret.Namespace, secret.Name, key))
+		})
+	}
+}
+
+func TestSecretRevealer_hide(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := storeFake.NewMockStore(controller)
+	access := &fakeResourceAccess{}
+	alerter := actionFake.NewMockAlerter(controller)
+
+	reveals := secrets.NewReveals(secrets.DefaultRevealDuration)
+	reveals.Reveal("client", "namespace", "secret", "password")
+
+	revealer := NewSecretRevealer(log.NopLogger(), objectStore, access, reveals)
+
+	payload := action.Payload{
+		"namespace": "namespace",
+		"name":      "secret",
+		"key":       "password",
+		"hide":      true,
+	}
+
+	ctx := WithClientID(context.Background(), "client")
+	require.NoError(t, revealer.Handle(ctx, alerter, payload))
+	assert.False(t, reveals.IsRevealed("client", "namespace", "secret", "password"))
+}
+
+type fakeResourceAccess struct {
+	objectstore.ResourceAccess
+	err error
+}
+
+func (f *fakeResourceAccess) HasAccess(ctx context.Context, key store.Key, verb string) error {
+	if key.Kind != "Secret" || verb != "get" {
+		return errors.Errorf("unexpected access check for %s %s", verb, key)
+	}
+	return f.err
+}
@@ -9,6 +9,7 @@ import (
 	"context"
 
 	"github.com/kubenext/lissio/pkg/action"
+	"github.com/kubenext/lissio/pkg/view/component"
 )
 
 //go:generate mockgen -destination=./fake/mock_state.go -package=fake github.com/kubenext/lissio/internal/controllers State
@@ -43,12 +44,19 @@ type State interface {
 	// SetFilters replaces the current filters with a slice of filters.
 	// The slice can be empty.
 	SetFilters(filters []Filter)
+	// GetTableQueries returns the queries for paginated tables, keyed by
+	// table name.
+	GetTableQueries() map[string]component.TableQuery
+	// SetTableQueries replaces the queries for paginated tables.
+	SetTableQueries(queries map[string]component.TableQuery)
 	// SetContext sets the current context.
 	SetContext(requestedContext string)
 	// Dispatch dispatches a payload for an action.
 	Dispatch(ctx context.Context, actionName string, payload action.Payload) error
 	// SendAlert sends an alert.
 	SendAlert(alert action.Alert)
+	// SendActionResult sends the result of an action.
+	SendActionResult(actionName string, result action.Payload)
 }
 
 // ContentPathUpdateFunc is a function that is called when content path is updated.
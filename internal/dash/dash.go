@@ -37,6 +37,8 @@ import (
 	"github.com/kubenext/lissio/internal/modules/overview"
 	"github.com/kubenext/lissio/internal/objectstore"
 	"github.com/kubenext/lissio/internal/portforward"
+	"github.com/kubenext/lissio/internal/search"
+	"github.com/kubenext/lissio/internal/snapshot"
 	"github.com/kubenext/lissio/pkg/action"
 	"github.com/kubenext/lissio/pkg/plugin"
 	pluginAPI "github.com/kubenext/lissio/pkg/plugin/api"
@@ -52,6 +54,16 @@ type Options struct {
 	Context          string
 	ClientQPS        float32
 	ClientBurst      int
+	// PluginURLs are plugins reached over HTTP keyed by plugin name.
+	PluginURLs map[string]string
+	// InformerIdleTimeout is how long informers are kept after their
+	// resources were last viewed. Zero keeps informers forever.
+	InformerIdleTimeout time.Duration
+	// DisableObjectTrimming caches objects without removing fields.
+	DisableObjectTrimming bool
+	// Snapshot is a directory of YAML or JSON files to browse instead of
+	// a live cluster.
+	Snapshot string
 }
 
 // Run runs the dashboard.
@@ -62,14 +74,32 @@ func Run(ctx context.Context, logger log.Logger, shutdownCh chan bool, options O
 		logger.With("initial-context", options.Context).Infof("Setting initial context from user flags")
 	}
 
-	logger.Debugf("Loading configuration: %v", options.KubeConfig)
 	restConfigOptions := cluster.RESTConfigOptions{
 		QPS:   options.ClientQPS,
 		Burst: options.ClientBurst,
 	}
-	clusterClient, err := cluster.FromKubeConfig(ctx, options.KubeConfig, options.Context, restConfigOptions)
-	if err != nil {
-		return errors.Wrap(err, "failed to init cluster client")
+
+	var snap *snapshot.Snapshot
+	var clusterClient cluster.ClientInterface
+	var err error
+	if options.Snapshot != "" {
+		logger.Debugf("Loading snapshot: %v", options.Snapshot)
+		snap, err = snapshot.Open(options.Snapshot)
+		if err != nil {
+			return errors.Wrap(err, "failed to load snapshot")
+		}
+
+		// A snapshot doesn't have a kube config, so there are no other
+		// contexts to switch to.
+		clusterClient = snap.Client
+		options.KubeConfig = ""
+		options.Context = snap.Name
+	} else {
+		logger.Debugf("Loading configuration: %v", options.KubeConfig)
+		clusterClient, err = cluster.FromKubeConfig(ctx, options.KubeConfig, options.Context, restConfigOptions)
+		if err != nil {
+			return errors.Wrap(err, "failed to init cluster client")
+		}
 	}
 
 	if options.EnableOpenCensus {
@@ -91,9 +121,26 @@ func Run(ctx context.Context, logger log.Logger, shutdownCh chan bool, options O
 
 	logger.Debugf("initial namespace for dashboard is %s", options.Namespace)
 
-	appObjectStore, err := initObjectStore(ctx, clusterClient)
-	if err != nil {
-		return errors.Wrap(err, "initializing store")
+	searchIndex := search.NewIndex()
+
+	var appObjectStore store.Store
+	var portForwarder portforward.PortForwarder
+	if snap != nil {
+		appObjectStore = snap.Store
+		portForwarder = snap.PortForwarder
+		for _, object := range snap.Objects {
+			searchIndex.Add(object)
+		}
+	} else {
+		appObjectStore, err = initObjectStore(ctx, clusterClient, searchIndex, options)
+		if err != nil {
+			return errors.Wrap(err, "initializing store")
+		}
+
+		portForwarder, err = initPortForwarder(ctx, clusterClient, appObjectStore)
+		if err != nil {
+			return errors.Wrap(err, "initializing port forwarder")
+		}
 	}
 
 	crdWatcher, err := describer.NewDefaultCRDWatcher(ctx, appObjectStore)
@@ -101,11 +148,6 @@ func Run(ctx context.Context, logger log.Logger, shutdownCh chan bool, options O
 		return errors.Wrap(err, "initializing CRD watcher")
 	}
 
-	portForwarder, err := initPortForwarder(ctx, clusterClient, appObjectStore)
-	if err != nil {
-		return errors.Wrap(err, "initializing port forwarder")
-	}
-
 	actionManger := action.NewManager(logger)
 
 	mo := &moduleOptions{
@@ -127,7 +169,7 @@ func Run(ctx context.Context, logger log.Logger, shutdownCh chan bool, options O
 		FrontendProxy: frontendProxy,
 	}
 
-	pluginManager, err := initPlugin(moduleManager, actionManger, pluginDashboardService)
+	pluginManager, err := initPlugin(moduleManager, actionManger, pluginDashboardService, options.PluginURLs)
 	if err != nil {
 		return errors.Wrap(err, "initializing plugin manager")
 	}
@@ -141,6 +183,7 @@ func Run(ctx context.Context, logger log.Logger, shutdownCh chan bool, options O
 		appObjectStore,
 		pluginManager,
 		portForwarder,
+		searchIndex,
 		options.Context,
 		restConfigOptions)
 
@@ -197,13 +240,22 @@ func Run(ctx context.Context, logger log.Logger, shutdownCh chan bool, options O
 }
 
 // initObjectStore initializes the cluster object store interface
-func initObjectStore(ctx context.Context, client cluster.ClientInterface) (store.Store, error) {
+func initObjectStore(ctx context.Context, client cluster.ClientInterface, searchIndex *search.Index, options Options) (store.Store, error) {
 	if client == nil {
 		return nil, errors.New("nil cluster client")
 	}
 
 	resourceAccess := objectstore.NewResourceAccess(client)
-	appObjectStore, err := objectstore.NewDynamicCache(ctx, client, objectstore.Access(resourceAccess))
+	storeOptions := []objectstore.DynamicCacheOpt{
+		objectstore.Access(resourceAccess),
+		objectstore.Index(searchIndex),
+		objectstore.EvictIdleInformers(options.InformerIdleTimeout),
+	}
+	if options.DisableObjectTrimming {
+		storeOptions = append(storeOptions, objectstore.Transform(nil))
+	}
+
+	appObjectStore, err := objectstore.NewDynamicCache(ctx, client, storeOptions...)
 
 	if err != nil {
 		return nil, errors.Wrapf(err, "creating object store for app")
@@ -217,7 +269,7 @@ func initPortForwarder(ctx context.Context, client cluster.ClientInterface, appO
 }
 
 type moduleOptions struct {
-	clusterClient  *cluster.Cluster
+	clusterClient  cluster.ClientInterface
 	crdWatcher     config.CRDWatcher
 	namespace      string
 	logger         log.Logger
@@ -14,13 +14,15 @@ import (
 	"github.com/kubenext/lissio/pkg/plugin/api"
 )
 
-func initPlugin(moduleManager module.ManagerInterface, actionManager *action.Manager, service api.Service) (*plugin.Manager, error) {
+func initPlugin(moduleManager module.ManagerInterface, actionManager *action.Manager, service api.Service, pluginURLs map[string]string) (*plugin.Manager, error) {
 	apiService, err := api.New(service)
 	if err != nil {
 		return nil, errors.Wrap(err, "create dashboard api")
 	}
 
-	m := plugin.NewManager(apiService, moduleManager, actionManager)
+	m := plugin.NewManager(apiService, moduleManager, actionManager,
+		plugin.WatchPluginDirs(plugin.DefaultConfig),
+		plugin.WithSettingsStore(plugin.NewFileSettingsStore(plugin.DefaultConfig)))
 
 	pluginList, err := plugin.AvailablePlugins(plugin.DefaultConfig)
 	if err != nil {
@@ -34,5 +36,11 @@ func initPlugin(moduleManager module.ManagerInterface, actionManager *action.Man
 
 	}
 
+	for name, pluginURL := range pluginURLs {
+		if err := m.LoadURL(name, pluginURL); err != nil {
+			return nil, errors.Wrapf(err, "initialize plugin %q", name)
+		}
+	}
+
 	return m, nil
 }
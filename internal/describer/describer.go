@@ -100,6 +100,8 @@ type Options struct {
 	Printer  printer.Printer
 	LabelSet *kLabels.Set
 	Link     link.Interface
+	// TableQueries are queries for paginated tables, keyed by table name.
+	TableQueries map[string]component.TableQuery
 
 	LoadObjects func(ctx context.Context, namespace string, fields map[string]string, objectStoreKeys []store.Key) (*unstructured.UnstructuredList, error)
 	LoadObject  func(ctx context.Context, namespace string, fields map[string]string, objectStoreKey store.Key) (*unstructured.Unstructured, error)
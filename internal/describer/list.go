@@ -8,6 +8,8 @@ package describer
 import (
 	"context"
 	"reflect"
+	"sort"
+	"strings"
 
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -15,6 +17,7 @@ import (
 	"k8s.io/client-go/kubernetes/scheme"
 
 	"github.com/kubenext/lissio/internal/log"
+	"github.com/kubenext/lissio/pkg/plugin"
 	"github.com/kubenext/lissio/pkg/store"
 	"github.com/kubenext/lissio/pkg/view/component"
 )
@@ -80,6 +83,20 @@ func (d *List) Describe(ctx context.Context, namespace string, options Options)
 		objectList = &unstructured.UnstructuredList{}
 	}
 
+	query := options.TableQueries[d.title]
+	objectList.Items = filterObjects(objectList.Items, query.Filter)
+	total := len(objectList.Items)
+	query = query.Normalize(total)
+
+	// Rows are only built for the page of objects when the objects can be
+	// sorted by the query's column. Otherwise every row is built, and the
+	// rows are sorted and paginated.
+	pagedObjects := sortObjects(objectList.Items, query.SortBy, query.Reverse)
+	if pagedObjects {
+		start, end := query.Bounds(total)
+		objectList.Items = objectList.Items[start:end]
+	}
+
 	list := component.NewList(d.title, nil)
 	list.SetIcon(d.iconName, d.iconSource)
 
@@ -109,13 +126,23 @@ func (d *List) Describe(ctx context.Context, namespace string, options Options)
 			listType)
 	}
 
-	viewComponent, err := options.Printer.Print(ctx, listObject, options.PluginManager())
+	pluginManager := options.PluginManager()
+
+	viewComponent, err := options.Printer.Print(ctx, listObject, pluginManager)
 	if err != nil {
 		return component.EmptyContentResponse, err
 	}
 
 	if viewComponent != nil {
 		if table, ok := viewComponent.(*component.Table); ok {
+			rows := newTableObjects(table, objectList)
+			if pagedObjects {
+				rows.sort(query.SortBy, query.Reverse)
+				table.SetPagination(component.TablePagination{Name: d.title, Query: query, Total: total})
+			} else {
+				rows.paginate(d.title, query)
+			}
+			addPluginColumns(ctx, pluginManager, table, rows.list(objectList))
 			list.Add(table)
 		} else {
 			list.Add(viewComponent)
@@ -127,6 +154,225 @@ func (d *List) Describe(ctx context.Context, namespace string, options Options)
 	}, nil
 }
 
+// addPluginColumns adds the columns plugins contribute for a list of objects
+// to the list's table. The objects are in the same order as the table's
+// rows. Plugin failures are logged so the list is still shown.
+func addPluginColumns(ctx context.Context, pluginManager plugin.ManagerInterface, table *component.Table, objectList *unstructured.UnstructuredList) {
+	if pluginManager == nil || objectList == nil || len(objectList.Items) == 0 {
+		return
+	}
+
+	columns, rows, err := pluginManager.ListColumns(ctx, objectList)
+	if err != nil {
+		log.From(ctx).WithErr(err).Warnf("list columns from plugins")
+		return
+	}
+
+	if len(columns) == 0 {
+		return
+	}
+
+	existing := make(map[string]bool)
+	for _, col := range table.Columns() {
+		existing[col.Name] = true
+	}
+
+	var added []string
+	for _, column := range columns {
+		if existing[column] {
+			continue
+		}
+		table.AddColumn(column)
+		added = append(added, column)
+	}
+
+	for i, row := range table.Rows() {
+		var cells component.TableRow
+		if i < len(rows) {
+			cells = rows[i]
+		}
+
+		for _, column := range added {
+			cell, ok := cells[column]
+			if !ok {
+				cell = component.NewText("")
+			}
+			row[column] = cell
+		}
+	}
+}
+
+// tableObjects is a table and the objects its rows were printed for.
+// Printers add a row for each object in order, so the objects are kept in
+// the same order as the rows while the rows are sorted and paginated.
+type tableObjects struct {
+	table *component.Table
+	// objects is nil if the table doesn't have a row for each object.
+	objects []*unstructured.Unstructured
+}
+
+func newTableObjects(table *component.Table, objectList *unstructured.UnstructuredList) *tableObjects {
+	t := &tableObjects{table: table}
+
+	if len(table.Rows()) != len(objectList.Items) {
+		return t
+	}
+
+	t.objects = make([]*unstructured.Unstructured, len(objectList.Items))
+	for i := range objectList.Items {
+		t.objects[i] = &objectList.Items[i]
+	}
+
+	return t
+}
+
+// sort sorts the table's rows by a column like Table.SortRows. Objects
+// move with their rows.
+func (t *tableObjects) sort(name string, reverse bool) {
+	if t.objects == nil {
+		t.table.SortRows(name, reverse)
+		return
+	}
+
+	if name == "" || !tableHasColumn(t.table, name) {
+		return
+	}
+
+	sort.Sort(&tableObjectsByColumn{tableObjects: t, name: name, reverse: reverse})
+}
+
+// paginate sorts the table's rows and replaces them with the page of rows
+// selected by query like Table.Paginate. Objects move with their rows.
+func (t *tableObjects) paginate(name string, query component.TableQuery) {
+	if t.objects == nil {
+		t.table.Paginate(name, query)
+		return
+	}
+
+	t.sort(query.SortBy, query.Reverse)
+
+	total := len(t.objects)
+	start, end := query.Bounds(total)
+	t.table.Config.Rows = t.table.Config.Rows[start:end]
+	t.objects = t.objects[start:end]
+
+	t.table.SetPagination(component.TablePagination{
+		Name:  name,
+		Query: query.Normalize(total),
+		Total: total,
+	})
+}
+
+// list returns the objects for the table's rows in row order. It returns
+// nil if the table doesn't have a row for each object.
+func (t *tableObjects) list(objectList *unstructured.UnstructuredList) *unstructured.UnstructuredList {
+	if t.objects == nil {
+		return nil
+	}
+
+	list := &unstructured.UnstructuredList{Object: objectList.Object}
+	for _, object := range t.objects {
+		list.Items = append(list.Items, *object)
+	}
+
+	return list
+}
+
+// tableObjectsByColumn sorts a table's rows and objects by a column.
+type tableObjectsByColumn struct {
+	*tableObjects
+	name    string
+	reverse bool
+}
+
+func (s *tableObjectsByColumn) Len() int {
+	return len(s.objects)
+}
+
+func (s *tableObjectsByColumn) Swap(i, j int) {
+	rows := s.table.Config.Rows
+	rows[i], rows[j] = rows[j], rows[i]
+	s.objects[i], s.objects[j] = s.objects[j], s.objects[i]
+}
+
+func (s *tableObjectsByColumn) Less(i, j int) bool {
+	rows := s.table.Config.Rows
+
+	a, ok := rows[i][s.name]
+	if !ok {
+		return false
+	}
+
+	b, ok := rows[j][s.name]
+	if !ok {
+		return false
+	}
+
+	if s.reverse {
+		return !a.LessThan(b)
+	}
+
+	return a.LessThan(b)
+}
+
+func tableHasColumn(table *component.Table, name string) bool {
+	for _, col := range table.Columns() {
+		if col.Accessor == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// filterObjects returns the objects with names containing filter. Case
+// is ignored.
+func filterObjects(objects []unstructured.Unstructured, filter string) []unstructured.Unstructured {
+	filter = strings.ToLower(strings.TrimSpace(filter))
+	if filter == "" {
+		return objects
+	}
+
+	var filtered []unstructured.Unstructured
+	for i := range objects {
+		if strings.Contains(strings.ToLower(objects[i].GetName()), filter) {
+			filtered = append(filtered, objects[i])
+		}
+	}
+
+	return filtered
+}
+
+// sortObjects sorts objects by a table column if the column's value comes
+// from the objects' metadata. It returns false if the objects can't be
+// sorted by the column.
+func sortObjects(objects []unstructured.Unstructured, column string, reverse bool) bool {
+	var less func(a, b *unstructured.Unstructured) bool
+
+	switch column {
+	case "Name":
+		less = func(a, b *unstructured.Unstructured) bool {
+			return a.GetName() < b.GetName()
+		}
+	case "Age":
+		less = func(a, b *unstructured.Unstructured) bool {
+			aTime, bTime := a.GetCreationTimestamp(), b.GetCreationTimestamp()
+			return aTime.Before(&bTime)
+		}
+	default:
+		return false
+	}
+
+	sort.SliceStable(objects, func(i, j int) bool {
+		if reverse {
+			return less(&objects[j], &objects[i])
+		}
+		return less(&objects[i], &objects[j])
+	})
+
+	return true
+}
+
 // PathFilters returns path filters for this Describer.
 func (d *List) PathFilters() []PathFilter {
 	return []PathFilter{
@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	configFake "github.com/kubenext/lissio/internal/config/fake"
 	printerFake "github.com/kubenext/lissio/internal/printer/fake"
@@ -83,3 +84,199 @@ func TestListDescriber(t *testing.T) {
 
 	assert.Equal(t, expected, cResponse)
 }
+
+func TestListDescriber_tableQuery(t *testing.T) {
+	pods := []*corev1.Pod{
+		testutil.CreatePod("web-b"),
+		testutil.CreatePod("web-a"),
+		testutil.CreatePod("db"),
+		testutil.CreatePod("web-c"),
+	}
+	for i := range pods {
+		pods[i].CreationTimestamp = *testutil.CreateTimestamp()
+	}
+
+	tests := []struct {
+		name        string
+		query       component.TableQuery
+		printed     []*corev1.Pod
+		expected    []*corev1.Pod
+		expectQuery component.TableQuery
+		expectTotal int
+	}{
+		{
+			name:        "sorted by name",
+			query:       component.TableQuery{Page: 2, PageSize: 2, SortBy: "Name", Reverse: true},
+			printed:     []*corev1.Pod{pods[1], pods[2]},
+			expected:    []*corev1.Pod{pods[1], pods[2]},
+			expectQuery: component.TableQuery{Page: 2, PageSize: 2, SortBy: "Name", Reverse: true},
+			expectTotal: 4,
+		},
+		{
+			name:        "filtered",
+			query:       component.TableQuery{Page: 3, PageSize: 2, SortBy: "Name", Filter: "WEB"},
+			printed:     []*corev1.Pod{pods[3]},
+			expected:    []*corev1.Pod{pods[3]},
+			expectQuery: component.TableQuery{Page: 2, PageSize: 2, SortBy: "Name", Filter: "WEB"},
+			expectTotal: 3,
+		},
+		{
+			name:        "sorted by printed column",
+			query:       component.TableQuery{Page: 1, PageSize: 3},
+			printed:     pods,
+			expected:    pods[:3],
+			expectQuery: component.TableQuery{Page: 1, PageSize: 3},
+			expectTotal: 4,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			dashConfig := configFake.NewMockDash(controller)
+			dashConfig.EXPECT().PluginManager().Return(nil)
+
+			var printed []corev1.Pod
+			for _, pod := range test.printed {
+				printed = append(printed, *pod)
+			}
+
+			objectPrinter := printerFake.NewMockPrinter(controller)
+			objectPrinter.EXPECT().
+				Print(gomock.Any(), &corev1.PodList{Items: printed}, nil).
+				Return(createPodTable(printed...), nil)
+
+			options := Options{
+				Dash:    dashConfig,
+				Printer: objectPrinter,
+				LoadObjects: func(ctx context.Context, namespace string, fields map[string]string, objectStoreKeys []store.Key) (*unstructured.UnstructuredList, error) {
+					var objects []runtime.Object
+					for _, pod := range pods {
+						objects = append(objects, pod)
+					}
+					return testutil.ToUnstructuredList(t, objects...), nil
+				},
+				TableQueries: map[string]component.TableQuery{"Pods": test.query},
+			}
+
+			d := NewList(ListConfig{
+				Path:       "/",
+				Title:      "Pods",
+				StoreKey:   store.Key{APIVersion: "v1", Kind: "Pod"},
+				ListType:   podListType,
+				ObjectType: podObjectType,
+			})
+
+			cResponse, err := d.Describe(context.Background(), "default", options)
+			require.NoError(t, err)
+
+			require.Len(t, cResponse.Components, 1)
+			list, ok := cResponse.Components[0].(*component.List)
+			require.True(t, ok)
+			require.Len(t, list.Config.Items, 1)
+			table, ok := list.Config.Items[0].(*component.Table)
+			require.True(t, ok)
+
+			var expected []corev1.Pod
+			for _, pod := range test.expected {
+				expected = append(expected, *pod)
+			}
+			assert.Equal(t, createPodTable(expected...).Rows(), table.Rows())
+
+			assert.Equal(t, &component.TablePagination{
+				Name:  "Pods",
+				Query: test.expectQuery,
+				Total: test.expectTotal,
+			}, table.Config.Pagination)
+		})
+	}
+}
+
+func Test_addPluginColumns(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	// Pods in different namespaces can have the same name, so rows are
+	// matched to objects by the index they were printed at.
+	pod1 := testutil.CreatePod("pod")
+	pod1.Namespace = "a"
+	pod2 := testutil.CreatePod("pod")
+	pod2.Namespace = "b"
+	objectList := testutil.ToUnstructuredList(t, pod1, pod2)
+
+	table := component.NewTableWithRows("pods", "", component.NewTableCols("Name", "Namespace"), []component.TableRow{
+		{"Name": component.NewText("pod"), "Namespace": component.NewText("a")},
+		{"Name": component.NewText("pod"), "Namespace": component.NewText("b")},
+	})
+
+	rows := newTableObjects(table, objectList)
+	rows.sort("Namespace", true)
+
+	rowList := rows.list(objectList)
+	require.NotNil(t, rowList)
+	require.Len(t, rowList.Items, 2)
+	assert.Equal(t, "b", rowList.Items[0].GetNamespace())
+	assert.Equal(t, "a", rowList.Items[1].GetNamespace())
+
+	pluginManager := pluginFake.NewMockManagerInterface(controller)
+	pluginManager.EXPECT().
+		ListColumns(gomock.Any(), rowList).
+		Return([]string{"Name", "Cost"}, []component.TableRow{
+			{"Cost": component.NewText("$2")},
+			{},
+		}, nil)
+
+	addPluginColumns(context.Background(), pluginManager, table, rowList)
+
+	expected := component.NewTableWithRows("pods", "", component.NewTableCols("Name", "Namespace", "Cost"), []component.TableRow{
+		{"Name": component.NewText("pod"), "Namespace": component.NewText("b"), "Cost": component.NewText("$2")},
+		{"Name": component.NewText("pod"), "Namespace": component.NewText("a"), "Cost": component.NewText("")},
+	})
+
+	assert.Equal(t, expected, table)
+}
+
+func Test_tableObjects_paginate(t *testing.T) {
+	objectList := testutil.ToUnstructuredList(t,
+		testutil.CreatePod("pod-b"),
+		testutil.CreatePod("pod-c"),
+		testutil.CreatePod("pod-a"),
+	)
+
+	table := component.NewTableWithRows("pods", "", component.NewTableCols("Name"), []component.TableRow{
+		{"Name": component.NewText("pod-b")},
+		{"Name": component.NewText("pod-c")},
+		{"Name": component.NewText("pod-a")},
+	})
+
+	rows := newTableObjects(table, objectList)
+	rows.paginate("Pods", component.TableQuery{Page: 1, PageSize: 2, SortBy: "Name"})
+
+	expected := []component.TableRow{
+		{"Name": component.NewText("pod-a")},
+		{"Name": component.NewText("pod-b")},
+	}
+	assert.Equal(t, expected, table.Rows())
+
+	rowList := rows.list(objectList)
+	require.NotNil(t, rowList)
+	require.Len(t, rowList.Items, 2)
+	assert.Equal(t, "pod-a", rowList.Items[0].GetName())
+	assert.Equal(t, "pod-b", rowList.Items[1].GetName())
+}
+
+func Test_tableObjects_row_count_mismatch(t *testing.T) {
+	objectList := testutil.ToUnstructuredList(t, testutil.CreatePod("pod1"), testutil.CreatePod("pod2"))
+
+	table := component.NewTableWithRows("pods", "", component.NewTableCols("Name"), []component.TableRow{
+		{"Name": component.NewText("pod1")},
+	})
+
+	rows := newTableObjects(table, objectList)
+	rows.paginate("Pods", component.TableQuery{Page: 1, PageSize: 10})
+
+	assert.Nil(t, rows.list(objectList))
+	assert.Equal(t, []component.TableRow{{"Name": component.NewText("pod1")}}, table.Rows())
+}
@@ -48,6 +48,20 @@ func NewContextsGenerator(dashConfig config.Dash, options ...ContextGeneratorOpt
 
 func (g *ContextsGenerator) Event(ctx context.Context) (controllers.Event, error) {
 	configPath := g.DashConfig.KubeConfigPath()
+	if configPath == "" {
+		// Without a kube config, e.g. when browsing a snapshot, the
+		// current context is the only one.
+		currentContext := g.DashConfig.ContextName()
+		resp := kubeContextsResponse{
+			CurrentContext: currentContext,
+			Contexts:       []kubeconfig.Context{{Name: currentContext}},
+		}
+
+		return controllers.Event{
+			Type: controllers.EventTypeKubeConfig,
+			Data: resp,
+		}, nil
+	}
 
 	kubeConfig, err := g.ConfigLoader.Load(configPath)
 	if err != nil {
@@ -56,3 +56,26 @@ func Test_kubeContextGenerator(t *testing.T) {
 
 	assert.Equal(t, resp, e.Data)
 }
+
+func Test_kubeContextGenerator_noKubeConfig(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashConfig := dashConfigFake.NewMockDash(controller)
+	dashConfig.EXPECT().KubeConfigPath().Return("")
+	dashConfig.EXPECT().ContextName().Return("snapshot: dump")
+
+	kgc := NewContextsGenerator(dashConfig)
+
+	ctx := context.Background()
+	e, err := kgc.Event(ctx)
+	require.NoError(t, err)
+
+	resp := kubeContextsResponse{
+		CurrentContext: "snapshot: dump",
+		Contexts:       []kubeconfig.Context{{Name: "snapshot: dump"}},
+	}
+
+	assert.Equal(t, controllers.EventTypeKubeConfig, e.Type)
+	assert.Equal(t, resp, e.Data)
+}
@@ -37,7 +37,8 @@ var _ Interface = (*Generator)(nil)
 
 // Options are additional options to pass a Generator
 type Options struct {
-	LabelSet *kLabels.Set
+	LabelSet     *kLabels.Set
+	TableQueries map[string]component.TableQuery
 }
 
 // NewGenerator creates a Generator.
@@ -93,12 +94,13 @@ func (g *Generator) Generate(ctx context.Context, contentPath string, opts Optio
 	}
 
 	options := describer.Options{
-		Queryer:  q,
-		Fields:   fields,
-		Printer:  g.printer,
-		LabelSet: opts.LabelSet,
-		Dash:     g.dashConfig,
-		Link:     linkGenerator,
+		Queryer:      q,
+		Fields:       fields,
+		Printer:      g.printer,
+		LabelSet:     opts.LabelSet,
+		TableQueries: opts.TableQueries,
+		Dash:         g.dashConfig,
+		Link:         linkGenerator,
 
 		LoadObjects: loaderFactory.LoadObjects,
 		LoadObject:  loaderFactory.LoadObject,
@@ -7,7 +7,9 @@ import (
 )
 
 // IsObjectLoading returns true if objects described by a key are loading.
+// The key is recorded, so the kinds navigation shows can be found out.
 func IsObjectLoading(ctx context.Context, namespace string, key store.Key, objectStore store.Store) bool {
 	key.Namespace = namespace
+	store.RecordKey(ctx, key)
 	return objectStore.IsLoading(ctx, key)
 }
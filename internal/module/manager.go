@@ -8,7 +8,9 @@ package module
 import (
 	"context"
 	"strings"
+	"sync"
 
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
@@ -27,12 +29,14 @@ type ActionReceiver interface {
 
 type ActionRegistrar interface {
 	Register(actionPath string, actionFunc action.DispatcherFunc) error
+	Unregister(actionPath string)
 }
 
 // ManagerInterface is an interface for managing module lifecycle.
 type ManagerInterface interface {
 	Modules() []Module
 	Register(mod Module) error
+	Unregister(mod Module)
 	SetNamespace(namespace string)
 	GetNamespace() string
 	UpdateContext(ctx context.Context, contextName string) error
@@ -42,8 +46,15 @@ type ManagerInterface interface {
 	ClientRequestHandlers() []controllers.ClientRequestHandler
 
 	ObjectPath(namespace, apiVersion, kind, name string) (string, error)
+
+	// OnChange registers a function which is called when modules are
+	// registered or unregistered, or the context changes.
+	OnChange(fn ChangeFunc) controllers.UpdateCancelFunc
 }
 
+// ChangeFunc is called when modules change.
+type ChangeFunc func()
+
 // Manager manages module lifecycle.
 type Manager struct {
 	clusterClient   cluster.ClientInterface
@@ -54,6 +65,11 @@ type Manager struct {
 	registeredModules []Module
 
 	loadedModules []Module
+
+	changeFuncs map[string]ChangeFunc
+
+	mu       sync.RWMutex
+	changeMu sync.Mutex
 }
 
 var _ ManagerInterface = (*Manager)(nil)
@@ -65,6 +81,7 @@ func NewManager(clusterClient cluster.ClientInterface, namespace string, actionR
 		namespace:       namespace,
 		actionRegistrar: actionRegistrar,
 		logger:          logger.With("component", "module-manager"),
+		changeFuncs:     make(map[string]ChangeFunc),
 	}
 
 	return manager, nil
@@ -72,6 +89,11 @@ func NewManager(clusterClient cluster.ClientInterface, namespace string, actionR
 
 // Register register a module with the manager.
 func (m *Manager) Register(mod Module) error {
+	defer m.notifyChange()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	m.registeredModules = append(m.registeredModules, mod)
 
 	if receiver, ok := mod.(ActionReceiver); ok {
@@ -92,14 +114,51 @@ func (m *Manager) Register(mod Module) error {
 	return nil
 }
 
+// Unregister stops a module and removes it and its actions from the manager.
+func (m *Manager) Unregister(mod Module) {
+	defer m.notifyChange()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.registeredModules = removeModule(m.registeredModules, mod)
+
+	loadedCount := len(m.loadedModules)
+	m.loadedModules = removeModule(m.loadedModules, mod)
+	if len(m.loadedModules) < loadedCount {
+		mod.Stop()
+	}
+
+	if receiver, ok := mod.(ActionReceiver); ok {
+		for actionPath := range receiver.ActionPaths() {
+			m.logger.With("actionPath", actionPath, "module-name", mod.Name()).Infof("unregistering action")
+			m.actionRegistrar.Unregister(actionPath)
+		}
+	}
+}
+
+func removeModule(list []Module, mod Module) []Module {
+	var out []Module
+	for _, item := range list {
+		if item != mod {
+			out = append(out, item)
+		}
+	}
+
+	return out
+}
+
 // Modules returns a list of modules.
 func (m *Manager) Modules() []Module {
-	return m.loadedModules
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return append([]Module(nil), m.loadedModules...)
 }
 
 // Unload unloads modules.
 func (m *Manager) Unload() {
-	for _, module := range m.loadedModules {
+	for _, module := range m.Modules() {
 		module.Stop()
 	}
 }
@@ -107,7 +166,7 @@ func (m *Manager) Unload() {
 // SetNamespace sets the current namespace.
 func (m *Manager) SetNamespace(namespace string) {
 	m.namespace = namespace
-	for _, module := range m.loadedModules {
+	for _, module := range m.Modules() {
 		if err := module.SetNamespace(namespace); err != nil {
 			m.logger.Errorf("setting namespace for module %q: %v",
 				module.Name(), err)
@@ -121,7 +180,9 @@ func (m *Manager) GetNamespace() string {
 }
 
 func (m *Manager) UpdateContext(ctx context.Context, contextName string) error {
-	for _, module := range m.loadedModules {
+	defer m.notifyChange()
+
+	for _, module := range m.Modules() {
 		if err := module.SetContext(ctx, contextName); err != nil {
 			return err
 		}
@@ -130,6 +191,36 @@ func (m *Manager) UpdateContext(ctx context.Context, contextName string) error {
 	return nil
 }
 
+// OnChange registers a function which is called when modules are
+// registered or unregistered, or the context changes.
+func (m *Manager) OnChange(fn ChangeFunc) controllers.UpdateCancelFunc {
+	m.changeMu.Lock()
+	defer m.changeMu.Unlock()
+
+	id := uuid.New().String()
+	m.changeFuncs[id] = fn
+
+	return func() {
+		m.changeMu.Lock()
+		defer m.changeMu.Unlock()
+
+		delete(m.changeFuncs, id)
+	}
+}
+
+func (m *Manager) notifyChange() {
+	m.changeMu.Lock()
+	var fns []ChangeFunc
+	for _, fn := range m.changeFuncs {
+		fns = append(fns, fn)
+	}
+	m.changeMu.Unlock()
+
+	for _, fn := range fns {
+		fn()
+	}
+}
+
 func (m *Manager) ObjectPath(namespace, apiVersion, kind, name string) (string, error) {
 	gv, err := schema.ParseGroupVersion(apiVersion)
 	if err != nil {
@@ -143,11 +234,13 @@ func (m *Manager) ObjectPath(namespace, apiVersion, kind, name string) (string,
 	}
 
 	objectPaths := make(map[schema.GroupVersionKind]Module)
+	m.mu.RLock()
 	for _, registered := range m.registeredModules {
 		for _, supported := range registered.SupportedGroupVersionKind() {
 			objectPaths[supported] = registered
 		}
 	}
+	m.mu.RUnlock()
 
 	owner, ok := objectPaths[gvk]
 	if !ok {
@@ -171,7 +264,7 @@ func (m *Manager) ModuleForContentPath(contentPath string) (Module, bool) {
 func (m *Manager) ClientRequestHandlers() []controllers.ClientRequestHandler {
 	var list []controllers.ClientRequestHandler
 
-	for _, m := range m.loadedModules {
+	for _, m := range m.Modules() {
 		list = append(list, m.ClientRequestHandlers()...)
 	}
 
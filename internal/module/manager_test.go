@@ -6,6 +6,7 @@ SPDX-License-Identifier: Apache-2.0
 package module_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -48,6 +49,67 @@ func TestManager(t *testing.T) {
 	manager.Unload()
 }
 
+func TestManager_Unregister(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	clusterClient := clusterfake.NewMockClientInterface(controller)
+
+	actionRegistrar := fake.NewMockActionRegistrar(controller)
+
+	manager, err := module.NewManager(clusterClient, "default", actionRegistrar, log.NopLogger())
+	require.NoError(t, err)
+
+	m := fake.NewMockModule(controller)
+	m.EXPECT().Name().Return("module").AnyTimes()
+	m.EXPECT().Start().Return(nil)
+	m.EXPECT().Stop()
+	m.EXPECT().SupportedGroupVersionKind().Return([]schema.GroupVersionKind{{Version: "v1", Kind: "Pod"}}).AnyTimes()
+	m.EXPECT().GroupVersionKindPath("default", "v1", "Pod", "pod").Return("/pod", nil)
+
+	require.NoError(t, manager.Register(m))
+
+	got, err := manager.ObjectPath("default", "v1", "Pod", "pod")
+	require.NoError(t, err)
+	assert.Equal(t, "/pod", got)
+
+	manager.Unregister(m)
+	require.Len(t, manager.Modules(), 0)
+
+	got, err = manager.ObjectPath("default", "v1", "Pod", "pod")
+	require.NoError(t, err)
+	assert.Equal(t, "", got)
+}
+
+func TestManager_OnChange(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	clusterClient := clusterfake.NewMockClientInterface(controller)
+
+	actionRegistrar := fake.NewMockActionRegistrar(controller)
+
+	manager, err := module.NewManager(clusterClient, "default", actionRegistrar, log.NopLogger())
+	require.NoError(t, err)
+
+	changes := 0
+	cancel := manager.OnChange(func() {
+		changes++
+	})
+
+	m := fake.NewMockModule(controller)
+	m.EXPECT().Start().Return(nil)
+	m.EXPECT().Stop()
+	m.EXPECT().SetContext(gomock.Any(), "other").Return(nil)
+
+	require.NoError(t, manager.Register(m))
+	require.NoError(t, manager.UpdateContext(context.Background(), "other"))
+	manager.Unregister(m)
+	assert.Equal(t, 3, changes)
+
+	cancel()
+	require.NoError(t, manager.UpdateContext(context.Background(), "other"))
+	assert.Equal(t, 3, changes)
+}
+
 func TestManager_ObjectPath(t *testing.T) {
 	cases := []struct {
 		name       string
@@ -22,6 +22,16 @@ import (
 // ContentOptions are additional options for content generation
 type ContentOptions struct {
 	LabelSet *labels.Set
+	// TableQueries are queries for paginated tables, keyed by table name.
+	TableQueries map[string]component.TableQuery
+}
+
+// ContentUpdateNotifier is implemented by modules which know when the content
+// for a path has changed. It allows content to be sent as soon as it changes.
+type ContentUpdateNotifier interface {
+	// OnContentUpdate calls fn when content for contentPath changes. The
+	// returned function cancels the registration.
+	OnContentUpdate(contentPath string, fn func()) (cancel func())
 }
 
 // Module is an lissio plugin.
@@ -0,0 +1,167 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package clusteroverview
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubenext/lissio/internal/describer"
+	"github.com/kubenext/lissio/internal/objectstatus"
+	"github.com/kubenext/lissio/internal/secrets"
+	"github.com/kubenext/lissio/pkg/store"
+	"github.com/kubenext/lissio/pkg/view/component"
+)
+
+// CertificateListDescriber describes the certificates in TLS secrets
+// across the cluster.
+type CertificateListDescriber struct {
+}
+
+func NewCertificateListDescriber() *CertificateListDescriber {
+	return &CertificateListDescriber{}
+}
+
+var _ describer.Describer = (*CertificateListDescriber)(nil)
+
+type tlsCertificate struct {
+	secret      *corev1.Secret
+	certificate *secrets.Certificate
+	err         error
+}
+
+// Describe describes TLS certificates as content. Certificates expiring
+// soonest are listed first.
+func (d *CertificateListDescriber) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
+	objectStore := options.ObjectStore()
+
+	key := store.Key{
+		APIVersion: "v1",
+		Kind:       "Secret",
+	}
+	secretList, _, err := objectStore.List(ctx, key)
+	if err != nil {
+		return component.EmptyContentResponse, errors.Wrap(err, "list secrets")
+	}
+
+	var certificates []tlsCertificate
+	for i := range secretList.Items {
+		secret := &corev1.Secret{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(secretList.Items[i].Object, secret); err != nil {
+			return component.EmptyContentResponse, errors.Wrap(err, "convert object to secret")
+		}
+
+		if secret.Type != corev1.SecretTypeTLS {
+			continue
+		}
+
+		c := tlsCertificate{secret: secret}
+
+		// the first certificate is the leaf; any others are the chain
+		parsed, err := secrets.ParseCertificates(secret.Data[corev1.TLSCertKey])
+		switch {
+		case err != nil:
+			c.err = err
+		case len(parsed) == 0:
+			c.err = errors.New("no certificate found")
+		default:
+			c.certificate = &parsed[0]
+		}
+
+		certificates = append(certificates, c)
+	}
+
+	sort.SliceStable(certificates, func(i, j int) bool {
+		a, b := certificates[i], certificates[j]
+		if a.certificate == nil || b.certificate == nil {
+			return a.certificate == nil && b.certificate != nil
+		}
+		return a.certificate.NotAfter.Before(b.certificate.NotAfter)
+	})
+
+	list := component.NewList("Certificates", nil)
+
+	tblCols := component.NewTableCols("Name", "Namespace", "Subject", "Issuer", "SANs", "Expires", "Days Left", "Status", "Ingresses")
+	tbl := component.NewTable("Certificates", "There are no TLS certificates!", tblCols)
+	list.Add(tbl)
+
+	now := time.Now()
+
+	for _, c := range certificates {
+		nameLink, err := options.Link.ForObject(c.secret, c.secret.Name)
+		if err != nil {
+			return component.EmptyContentResponse, err
+		}
+
+		status, err := objectstatus.Status(ctx, c.secret, objectStore)
+		if err != nil {
+			return component.EmptyContentResponse, errors.Wrapf(err, "status for secret %q", c.secret.Name)
+		}
+
+		ingresses, err := d.describeIngresses(ctx, c.secret, options)
+		if err != nil {
+			return component.EmptyContentResponse, err
+		}
+
+		row := component.TableRow{
+			"Name":      nameLink,
+			"Namespace": component.NewText(c.secret.Namespace),
+			"Status":    component.NewList("", status.Details),
+			"Ingresses": ingresses,
+		}
+
+		if c.certificate != nil {
+			row["Subject"] = component.NewText(c.certificate.Subject)
+			row["Issuer"] = component.NewText(c.certificate.Issuer)
+			row["SANs"] = component.NewText(strings.Join(c.certificate.SANs(), ", "))
+			row["Expires"] = component.NewText(c.certificate.NotAfter.UTC().Format(time.RFC3339))
+			row["Days Left"] = component.NewText(fmt.Sprintf("%d", c.certificate.DaysUntilExpiry(now)))
+		} else {
+			row["Subject"] = component.NewText(fmt.Sprintf("Unable to parse certificate: %s", c.err))
+		}
+
+		tbl.Add(row)
+	}
+
+	return component.ContentResponse{
+		Components: []component.Component{list},
+	}, nil
+}
+
+// describeIngresses links to the ingresses which use a secret.
+func (d *CertificateListDescriber) describeIngresses(ctx context.Context, secret *corev1.Secret, options describer.Options) (component.Component, error) {
+	ingresses, err := options.Queryer.IngressesForSecret(ctx, secret)
+	if err != nil {
+		return nil, errors.Wrapf(err, "find ingresses for secret %q", secret.Name)
+	}
+
+	var items []component.Component
+	for _, ingress := range ingresses {
+		ingressLink, err := options.Link.ForObject(ingress, ingress.Name)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, ingressLink)
+	}
+
+	return component.NewList("", items), nil
+}
+
+func (d *CertificateListDescriber) PathFilters() []describer.PathFilter {
+	filter := describer.NewPathFilter("/certificates", d)
+	return []describer.PathFilter{*filter}
+}
+
+func (d *CertificateListDescriber) Reset(ctx context.Context) error {
+	return nil
+}
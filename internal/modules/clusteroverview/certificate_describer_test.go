@@ -0,0 +1,111 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package clusteroverview
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	extv1beta1 "k8s.io/api/extensions/v1beta1"
+
+	configFake "github.com/kubenext/lissio/internal/config/fake"
+	"github.com/kubenext/lissio/internal/describer"
+	linkFake "github.com/kubenext/lissio/internal/link/fake"
+	queryerFake "github.com/kubenext/lissio/internal/queryer/fake"
+	"github.com/kubenext/lissio/internal/testutil"
+	"github.com/kubenext/lissio/pkg/store"
+	storeFake "github.com/kubenext/lissio/pkg/store/fake"
+	"github.com/kubenext/lissio/pkg/view/component"
+)
+
+func TestCertificateListDescriber_Describe(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	day := 24 * time.Hour
+	expiringAt := time.Now().Add(7*day + time.Hour).Truncate(time.Second)
+	validAt := time.Now().Add(90*day + time.Hour).Truncate(time.Second)
+
+	expiring := testutil.CreateTLSSecret(t, "expiring", "expiring.example.com", expiringAt)
+	valid := testutil.CreateTLSSecret(t, "valid", "valid.example.com", validAt)
+	opaque := testutil.CreateSecret("opaque")
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{APIVersion: "v1", Kind: "Secret"}).
+		Return(testutil.ToUnstructuredList(t, valid, opaque, expiring), false, nil)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ObjectStore().Return(objectStore).AnyTimes()
+
+	ingress := testutil.CreateIngress("ingress")
+
+	q := queryerFake.NewMockQueryer(controller)
+	q.EXPECT().IngressesForSecret(gomock.Any(), gomock.Any()).Return(nil, nil)
+	q.EXPECT().IngressesForSecret(gomock.Any(), gomock.Any()).Return([]*extv1beta1.Ingress{ingress}, nil)
+
+	secretLink := component.NewLink("", "secret", "/secret")
+	ingressLink := component.NewLink("", "ingress", "/ingress")
+
+	l := linkFake.NewMockInterface(controller)
+	l.EXPECT().ForObject(gomock.Any(), "expiring").Return(secretLink, nil)
+	l.EXPECT().ForObject(gomock.Any(), "valid").Return(secretLink, nil)
+	l.EXPECT().ForObject(gomock.Any(), "ingress").Return(ingressLink, nil)
+
+	options := describer.Options{
+		Dash:    dashConfig,
+		Queryer: q,
+		Link:    l,
+	}
+
+	d := NewCertificateListDescriber()
+
+	got, err := d.Describe(context.Background(), "", options)
+	require.NoError(t, err)
+
+	expectedTable := component.NewTable("Certificates", "There are no TLS certificates!",
+		component.NewTableCols("Name", "Namespace", "Subject", "Issuer", "SANs", "Expires", "Days Left", "Status", "Ingresses"))
+	expectedTable.Add(
+		component.TableRow{
+			"Name":      secretLink,
+			"Namespace": component.NewText("namespace"),
+			"Subject":   component.NewText("CN=expiring.example.com"),
+			"Issuer":    component.NewText("CN=expiring.example.com"),
+			"SANs":      component.NewText("expiring.example.com"),
+			"Expires":   component.NewText(expiringAt.UTC().Format(time.RFC3339)),
+			"Days Left": component.NewText("7"),
+			"Status": component.NewList("", []component.Component{
+				component.NewText(`Certificate "CN=expiring.example.com" in Secret "expiring" expires in 7 days`),
+			}),
+			"Ingresses": component.NewList("", nil),
+		},
+		component.TableRow{
+			"Name":      secretLink,
+			"Namespace": component.NewText("namespace"),
+			"Subject":   component.NewText("CN=valid.example.com"),
+			"Issuer":    component.NewText("CN=valid.example.com"),
+			"SANs":      component.NewText("valid.example.com"),
+			"Expires":   component.NewText(validAt.UTC().Format(time.RFC3339)),
+			"Days Left": component.NewText("90"),
+			"Status": component.NewList("", []component.Component{
+				component.NewText("Secret is OK"),
+			}),
+			"Ingresses": component.NewList("", []component.Component{ingressLink}),
+		},
+	)
+
+	expectedList := component.NewList("Certificates", nil)
+	expectedList.Add(expectedTable)
+
+	expected := component.ContentResponse{
+		Components: []component.Component{expectedList},
+	}
+
+	require.Equal(t, expected, got)
+}
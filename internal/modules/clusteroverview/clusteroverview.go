@@ -8,6 +8,8 @@ package clusteroverview
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"sync"
 
 	"github.com/pkg/errors"
@@ -21,8 +23,10 @@ import (
 	"github.com/kubenext/lissio/internal/link"
 	"github.com/kubenext/lissio/internal/loading"
 	"github.com/kubenext/lissio/internal/module"
+	"github.com/kubenext/lissio/internal/portforward"
 	"github.com/kubenext/lissio/internal/printer"
 	"github.com/kubenext/lissio/internal/queryer"
+	"github.com/kubenext/lissio/internal/util/configdir"
 	"github.com/kubenext/lissio/pkg/action"
 	"github.com/kubenext/lissio/pkg/icon"
 	"github.com/kubenext/lissio/pkg/navigation"
@@ -40,13 +44,15 @@ type ClusterOverview struct {
 	*controllers.ObjectPath
 	Options
 
-	pathMatcher *describer.PathMatcher
-	watchedCRDs []*unstructured.Unstructured
+	pathMatcher   *describer.PathMatcher
+	watchedCRDs   []*unstructured.Unstructured
+	eventTimeline *EventTimeline
 
 	mu sync.Mutex
 }
 
 var _ module.Module = (*ClusterOverview)(nil)
+var _ module.ContentUpdateNotifier = (*ClusterOverview)(nil)
 
 func New(ctx context.Context, options Options) (*ClusterOverview, error) {
 	pathMatcher := describer.NewPathMatcher("cluster-overview")
@@ -65,10 +71,16 @@ func New(ctx context.Context, options Options) (*ClusterOverview, error) {
 		return nil, errors.Wrap(err, "create module object path generator")
 	}
 
+	eventTimeline := NewEventTimeline(options.DashConfig.Logger())
+	for _, pf := range NewEventTimelineDescriber(eventTimeline).PathFilters() {
+		pathMatcher.Register(ctx, pf)
+	}
+
 	co := &ClusterOverview{
-		ObjectPath:  objectPath,
-		pathMatcher: pathMatcher,
-		Options:     options,
+		ObjectPath:    objectPath,
+		pathMatcher:   pathMatcher,
+		eventTimeline: eventTimeline,
+		Options:       options,
 	}
 
 	crdWatcher := options.DashConfig.CRDWatcher()
@@ -125,12 +137,12 @@ func (co *ClusterOverview) ClientRequestHandlers() []controllers.ClientRequestHa
 		{
 			RequestType: "startPortForward",
 			Handler: func(state controllers.State, payload action.Payload) error {
-				req, err := portForwardRequestFromPayload(payload)
+				req, err := portforward.PortRequestFromPayload(payload)
 				if err != nil {
 					return errors.Wrap(err, "convert payload to port forward request")
 				}
 
-				_, err = co.DashConfig.PortForwarder().Create(context.TODO(), req.gvk(), req.Name, req.Namespace, req.Port)
+				_, err = co.DashConfig.PortForwarder().CreateFromRequest(context.TODO(), req.ToCreateRequest())
 				return err
 			},
 		},
@@ -181,12 +193,13 @@ func (co *ClusterOverview) Content(ctx context.Context, contentPath string, opts
 	loaderFactory := describer.NewObjectLoaderFactory(co.DashConfig)
 
 	options := describer.Options{
-		Queryer:  q,
-		Fields:   pf.Fields(contentPath),
-		Printer:  p,
-		LabelSet: opts.LabelSet,
-		Dash:     co.DashConfig,
-		Link:     linkGenerator,
+		Queryer:      q,
+		Fields:       pf.Fields(contentPath),
+		Printer:      p,
+		LabelSet:     opts.LabelSet,
+		TableQueries: opts.TableQueries,
+		Dash:         co.DashConfig,
+		Link:         linkGenerator,
 
 		LoadObjects: loaderFactory.LoadObjects,
 		LoadObject:  loaderFactory.LoadObject,
@@ -211,18 +224,24 @@ func (co *ClusterOverview) Navigation(ctx context.Context, namespace string, roo
 			"RBAC":             "rbac",
 			"Nodes":            "nodes",
 			"Port Forwards":    "port-forward",
+			"Certificates":     "certificates",
+			"Events":           "events",
 		},
 		EntriesFuncs: map[string]controllers.EntriesFunc{
 			"Custom Resources": navigation.CRDEntries,
 			"RBAC":             rbacEntries,
 			"Nodes":            nil,
 			"Port Forwards":    nil,
+			"Certificates":     nil,
+			"Events":           nil,
 		},
 		Order: []string{
 			"Custom Resources",
 			"RBAC",
 			"Nodes",
 			"Port Forwards",
+			"Certificates",
+			"Events",
 		},
 	}
 
@@ -256,6 +275,26 @@ func (co *ClusterOverview) Generators() []controllers.Generator {
 	return []controllers.Generator{}
 }
 
+// ActionPaths contain the actions this module is responsible for.
+func (co *ClusterOverview) ActionPaths() map[string]action.DispatcherFunc {
+	dispatchers := action.Dispatchers{
+		NewPortForwardStopper(co.DashConfig.PortForwarder()),
+		NewSnapshotExporter(co.DashConfig, snapshotDir()),
+	}
+
+	return dispatchers.ToActionPaths()
+}
+
+// snapshotDir returns the directory exported snapshots are written to.
+func snapshotDir() string {
+	dir, err := configdir.Default()
+	if err != nil {
+		return filepath.Join(os.TempDir(), "lissio-snapshots")
+	}
+
+	return filepath.Join(dir, "snapshots")
+}
+
 func rbacEntries(ctx context.Context, prefix, namespace string, objectStore store.Store, _ bool) ([]navigation.Navigation, bool, error) {
 	neh := navigation.EntriesHelper{}
 	neh.Add("Cluster Roles", "cluster-roles", icon.ClusterOverviewClusterRole,
@@ -280,5 +319,16 @@ func (co *ClusterOverview) SetContext(ctx context.Context, contextName string) e
 	}
 
 	co.watchedCRDs = []*unstructured.Unstructured{}
+	co.eventTimeline.Reset()
 	return nil
 }
+
+// OnContentUpdate calls fn when content for a content path changes. Only
+// the event timeline reports updates.
+func (co *ClusterOverview) OnContentUpdate(contentPath string, fn func()) func() {
+	if contentPath != "/events" {
+		return func() {}
+	}
+
+	return co.eventTimeline.OnUpdate(fn)
+}
@@ -0,0 +1,329 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package clusteroverview
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	kcache "k8s.io/client-go/tools/cache"
+
+	"github.com/kubenext/lissio/internal/log"
+	"github.com/kubenext/lissio/pkg/store"
+)
+
+// defaultTimelineNotifyDelay is how long event updates are collected
+// before listeners are notified.
+const defaultTimelineNotifyDelay = time.Second
+
+var eventKey = store.Key{APIVersion: "v1", Kind: "Event"}
+
+// EventGroup is a group of events with the same involved object and reason.
+type EventGroup struct {
+	InvolvedObject corev1.ObjectReference
+	Reason         string
+	// Type is Warning if any event in the group is a warning.
+	Type string
+	// Message is the message of the most recent event.
+	Message   string
+	Count     int32
+	FirstSeen time.Time
+	LastSeen  time.Time
+}
+
+// EventFilter filters events in the timeline. Empty fields match all events.
+type EventFilter struct {
+	Type      string
+	Reason    string
+	Kind      string
+	Namespace string
+	// Since only includes events seen within this duration.
+	Since time.Duration
+}
+
+func (f EventFilter) matches(event *corev1.Event, cutoff time.Time) bool {
+	switch {
+	case f.Type != "" && f.Type != event.Type,
+		f.Reason != "" && f.Reason != event.Reason,
+		f.Kind != "" && f.Kind != event.InvolvedObject.Kind,
+		f.Namespace != "" && f.Namespace != event.Namespace:
+		return false
+	case f.Since > 0 && eventLastSeen(event).Before(cutoff):
+		return false
+	default:
+		return true
+	}
+}
+
+type eventGroupKey struct {
+	namespace  string
+	apiVersion string
+	kind       string
+	name       string
+	reason     string
+}
+
+// EventTimeline is an index of events across all namespaces. It is kept
+// up to date by watching events in the object store.
+type EventTimeline struct {
+	logger      log.Logger
+	notifyDelay time.Duration
+
+	objectStore   store.Store
+	generation    int
+	events        map[types.UID]*corev1.Event
+	listeners     map[int]func()
+	nextListener  int
+	notifyPending bool
+
+	mu sync.Mutex
+}
+
+// NewEventTimeline creates an instance of EventTimeline.
+func NewEventTimeline(logger log.Logger) *EventTimeline {
+	return &EventTimeline{
+		logger:      logger.With("component", "event-timeline"),
+		notifyDelay: defaultTimelineNotifyDelay,
+		events:      make(map[types.UID]*corev1.Event),
+		listeners:   make(map[int]func()),
+	}
+}
+
+// Start watches events in an object store. It is a no-op if the timeline is
+// already watching the store.
+func (t *EventTimeline) Start(ctx context.Context, objectStore store.Store) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.objectStore == objectStore {
+		return nil
+	}
+
+	t.generation++
+	t.events = make(map[types.UID]*corev1.Event)
+
+	generation := t.generation
+	handler := &kcache.ResourceEventHandlerFuncs{
+		AddFunc: func(object interface{}) {
+			t.update(generation, object)
+		},
+		UpdateFunc: func(_, object interface{}) {
+			t.update(generation, object)
+		},
+		DeleteFunc: func(object interface{}) {
+			t.delete(generation, object)
+		},
+	}
+
+	if err := objectStore.Watch(ctx, eventKey, handler); err != nil {
+		return errors.Wrap(err, "watch events")
+	}
+
+	t.objectStore = objectStore
+
+	return nil
+}
+
+// Reset stops using the current watch. The next call to Start watches again.
+func (t *EventTimeline) Reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.generation++
+	t.objectStore = nil
+	t.events = make(map[types.UID]*corev1.Event)
+}
+
+// OnUpdate calls fn when events change. The returned function cancels the registration.
+func (t *EventTimeline) OnUpdate(fn func()) func() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	id := t.nextListener
+	t.nextListener++
+	t.listeners[id] = fn
+
+	return func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+
+		delete(t.listeners, id)
+	}
+}
+
+// Groups returns event groups matching a filter ordered by when they were
+// last seen, most recent first.
+func (t *EventTimeline) Groups(filter EventFilter, now time.Time) []EventGroup {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	cutoff := now.Add(-filter.Since)
+
+	groups := make(map[eventGroupKey]*EventGroup)
+	for _, event := range t.events {
+		if !filter.matches(event, cutoff) {
+			continue
+		}
+
+		ref := event.InvolvedObject
+		key := eventGroupKey{
+			namespace:  ref.Namespace,
+			apiVersion: ref.APIVersion,
+			kind:       ref.Kind,
+			name:       ref.Name,
+			reason:     event.Reason,
+		}
+
+		count := event.Count
+		if count < 1 {
+			count = 1
+		}
+
+		firstSeen := event.FirstTimestamp.Time
+		lastSeen := eventLastSeen(event)
+		if firstSeen.IsZero() {
+			firstSeen = lastSeen
+		}
+
+		group, ok := groups[key]
+		if !ok {
+			groups[key] = &EventGroup{
+				InvolvedObject: ref,
+				Reason:         event.Reason,
+				Type:           event.Type,
+				Message:        event.Message,
+				Count:          count,
+				FirstSeen:      firstSeen,
+				LastSeen:       lastSeen,
+			}
+			continue
+		}
+
+		group.Count += count
+		if event.Type == corev1.EventTypeWarning {
+			group.Type = corev1.EventTypeWarning
+		}
+		if firstSeen.Before(group.FirstSeen) {
+			group.FirstSeen = firstSeen
+		}
+		if lastSeen.After(group.LastSeen) {
+			group.LastSeen = lastSeen
+			group.Message = event.Message
+		}
+	}
+
+	var list []EventGroup
+	for _, group := range groups {
+		list = append(list, *group)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].LastSeen.Equal(list[j].LastSeen) {
+			return list[i].InvolvedObject.Name < list[j].InvolvedObject.Name
+		}
+		return list[i].LastSeen.After(list[j].LastSeen)
+	})
+
+	return list
+}
+
+func (t *EventTimeline) update(generation int, object interface{}) {
+	event, err := toEvent(object)
+	if err != nil {
+		t.logger.WithErr(err).Warnf("unable to add event to timeline")
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if generation != t.generation {
+		return
+	}
+
+	t.events[event.UID] = event
+	t.notify()
+}
+
+func (t *EventTimeline) delete(generation int, object interface{}) {
+	if tombstone, ok := object.(kcache.DeletedFinalStateUnknown); ok {
+		object = tombstone.Obj
+	}
+
+	event, err := toEvent(object)
+	if err != nil {
+		t.logger.WithErr(err).Warnf("unable to remove event from timeline")
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if generation != t.generation {
+		return
+	}
+
+	delete(t.events, event.UID)
+	t.notify()
+}
+
+// notify notifies listeners after the notify delay. Changes made while a
+// notification is pending are sent with it. It must be called with the
+// lock held.
+func (t *EventTimeline) notify() {
+	if t.notifyPending {
+		return
+	}
+	t.notifyPending = true
+
+	time.AfterFunc(t.notifyDelay, func() {
+		t.mu.Lock()
+		t.notifyPending = false
+		var listeners []func()
+		for _, fn := range t.listeners {
+			listeners = append(listeners, fn)
+		}
+		t.mu.Unlock()
+
+		for _, fn := range listeners {
+			fn()
+		}
+	})
+}
+
+func toEvent(object interface{}) (*corev1.Event, error) {
+	u, ok := object.(*unstructured.Unstructured)
+	if !ok {
+		return nil, errors.Errorf("unexpected object type %T", object)
+	}
+
+	event := &corev1.Event{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, event); err != nil {
+		return nil, errors.Wrap(err, "convert object to event")
+	}
+
+	return event, nil
+}
+
+// eventLastSeen returns when an event was last seen. Events created with the
+// events API only set the event time.
+func eventLastSeen(event *corev1.Event) time.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	default:
+		return event.CreationTimestamp.Time
+	}
+}
@@ -0,0 +1,119 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package clusteroverview
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/kubenext/lissio/internal/describer"
+	"github.com/kubenext/lissio/internal/objectstore"
+	"github.com/kubenext/lissio/pkg/view/component"
+)
+
+const eventTimelineHelp = "Filter events with `type`, `reason`, `kind`, `namespace` and `since` filters, " +
+	"e.g. `type:Warning` or `since:1h`."
+
+// EventTimelineDescriber describes events across all namespaces as a timeline.
+type EventTimelineDescriber struct {
+	timeline *EventTimeline
+}
+
+// NewEventTimelineDescriber creates an instance of EventTimelineDescriber.
+func NewEventTimelineDescriber(timeline *EventTimeline) *EventTimelineDescriber {
+	return &EventTimelineDescriber{
+		timeline: timeline,
+	}
+}
+
+var _ describer.Describer = (*EventTimelineDescriber)(nil)
+
+// Describe describes the event timeline as content.
+func (d *EventTimelineDescriber) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
+	list := component.NewList("Events", nil)
+
+	if err := d.timeline.Start(ctx, options.ObjectStore()); err != nil {
+		if _, ok := err.(*objectstore.AccessError); !ok {
+			return component.EmptyContentResponse, err
+		}
+		list.Add(component.NewText(fmt.Sprintf("Unable to show events: %s", err)))
+		return component.ContentResponse{Components: []component.Component{list}}, nil
+	}
+
+	filter, err := eventFilterFromOptions(options)
+	if err != nil {
+		list.Add(component.NewText(fmt.Sprintf("Unable to filter events: %s", err)))
+	}
+
+	list.Add(component.NewMarkdownText(eventTimelineHelp))
+
+	tblCols := component.NewTableCols("Last Seen", "Type", "Reason", "Object", "Namespace", "Message", "Count", "First Seen")
+	tbl := component.NewTable("Events", "There are no events!", tblCols)
+	list.Add(tbl)
+
+	for _, group := range d.timeline.Groups(filter, time.Now()) {
+		ref := group.InvolvedObject
+		text := fmt.Sprintf("%s %s", ref.Kind, ref.Name)
+
+		var objectCell component.Component = component.NewText(text)
+		if objectLink, err := options.Link.ForGVK(ref.Namespace, ref.APIVersion, ref.Kind, ref.Name, text); err == nil {
+			objectCell = objectLink
+		}
+
+		tbl.Add(component.TableRow{
+			"Last Seen":  component.NewTimestamp(group.LastSeen),
+			"Type":       component.NewText(group.Type),
+			"Reason":     component.NewText(group.Reason),
+			"Object":     objectCell,
+			"Namespace":  component.NewText(ref.Namespace),
+			"Message":    component.NewText(group.Message),
+			"Count":      component.NewText(fmt.Sprintf("%d", group.Count)),
+			"First Seen": component.NewTimestamp(group.FirstSeen),
+		})
+	}
+
+	return component.ContentResponse{
+		Components: []component.Component{list},
+	}, nil
+}
+
+// eventFilterFromOptions creates an event filter from the label filters
+// in the describer options.
+func eventFilterFromOptions(options describer.Options) (EventFilter, error) {
+	var filter EventFilter
+	if options.LabelSet == nil {
+		return filter, nil
+	}
+
+	set := *options.LabelSet
+	filter.Type = set["type"]
+	filter.Reason = set["reason"]
+	filter.Kind = set["kind"]
+	filter.Namespace = set["namespace"]
+
+	if since := set["since"]; since != "" {
+		d, err := time.ParseDuration(since)
+		if err != nil {
+			return filter, errors.Errorf("invalid since %q: use a duration like 30m or 2h", since)
+		}
+		filter.Since = d
+	}
+
+	return filter, nil
+}
+
+func (d *EventTimelineDescriber) PathFilters() []describer.PathFilter {
+	filter := describer.NewPathFilter("/events", d)
+	return []describer.PathFilter{*filter}
+}
+
+func (d *EventTimelineDescriber) Reset(ctx context.Context) error {
+	d.timeline.Reset()
+	return nil
+}
@@ -0,0 +1,151 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package clusteroverview
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	kcache "k8s.io/client-go/tools/cache"
+
+	"github.com/kubenext/lissio/internal/describer"
+	"github.com/kubenext/lissio/internal/log"
+	"github.com/kubenext/lissio/internal/testutil"
+	"github.com/kubenext/lissio/pkg/store"
+	storeFake "github.com/kubenext/lissio/pkg/store/fake"
+)
+
+func TestEventTimeline(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	// decoded timestamps are in local time
+	now := time.Date(2019, 6, 1, 12, 0, 0, 0, time.Local)
+
+	var handler kcache.ResourceEventHandler
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Watch(gomock.Any(), eventKey, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ store.Key, h kcache.ResourceEventHandler) error {
+			handler = h
+			return nil
+		})
+
+	timeline := NewEventTimeline(log.NopLogger())
+	timeline.notifyDelay = time.Millisecond
+
+	updated := make(chan struct{}, 1)
+	cancel := timeline.OnUpdate(func() {
+		updated <- struct{}{}
+	})
+	defer cancel()
+
+	ctx := context.Background()
+	require.NoError(t, timeline.Start(ctx, objectStore))
+	// starting again with the same store does not watch again
+	require.NoError(t, timeline.Start(ctx, objectStore))
+
+	backOff1 := createTimelineEvent("backoff-1", "pod", "BackOff", corev1.EventTypeWarning, 2, now.Add(-10*time.Minute))
+	backOff2 := createTimelineEvent("backoff-2", "pod", "BackOff", corev1.EventTypeWarning, 3, now.Add(-time.Minute))
+	pulled := createTimelineEvent("pulled", "pod", "Pulled", corev1.EventTypeNormal, 1, now.Add(-2*time.Hour))
+	deleted := createTimelineEvent("deleted", "other", "Killing", corev1.EventTypeNormal, 1, now)
+
+	for _, event := range []*corev1.Event{backOff1, backOff2, pulled, deleted} {
+		handler.OnAdd(testutil.ToUnstructured(t, event))
+	}
+	handler.OnDelete(kcache.DeletedFinalStateUnknown{Obj: testutil.ToUnstructured(t, deleted)})
+
+	select {
+	case <-updated:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeline did not notify listener")
+	}
+
+	got := timeline.Groups(EventFilter{}, now)
+	require.Len(t, got, 2)
+
+	assert.Equal(t, EventGroup{
+		InvolvedObject: backOff2.InvolvedObject,
+		Reason:         "BackOff",
+		Type:           corev1.EventTypeWarning,
+		Message:        "backoff-2 message",
+		Count:          5,
+		FirstSeen:      now.Add(-10 * time.Minute),
+		LastSeen:       now.Add(-time.Minute),
+	}, got[0])
+	assert.Equal(t, "Pulled", got[1].Reason)
+
+	got = timeline.Groups(EventFilter{Type: corev1.EventTypeNormal}, now)
+	require.Len(t, got, 1)
+	assert.Equal(t, "Pulled", got[0].Reason)
+
+	got = timeline.Groups(EventFilter{Since: 5 * time.Minute}, now)
+	require.Len(t, got, 1)
+	assert.Equal(t, int32(3), got[0].Count)
+
+	got = timeline.Groups(EventFilter{Kind: "Deployment"}, now)
+	assert.Empty(t, got)
+
+	timeline.Reset()
+	assert.Empty(t, timeline.Groups(EventFilter{}, now))
+
+	// events from the previous watch are ignored after a reset
+	handler.OnAdd(testutil.ToUnstructured(t, pulled))
+	assert.Empty(t, timeline.Groups(EventFilter{}, now))
+}
+
+func Test_eventFilterFromOptions(t *testing.T) {
+	set := labels.Set{
+		"type":      "Warning",
+		"reason":    "BackOff",
+		"kind":      "Pod",
+		"namespace": "default",
+		"since":     "1h",
+	}
+
+	got, err := eventFilterFromOptions(describer.Options{LabelSet: &set})
+	require.NoError(t, err)
+
+	expected := EventFilter{
+		Type:      "Warning",
+		Reason:    "BackOff",
+		Kind:      "Pod",
+		Namespace: "default",
+		Since:     time.Hour,
+	}
+	assert.Equal(t, expected, got)
+
+	set = labels.Set{"since": "yesterday"}
+	_, err = eventFilterFromOptions(describer.Options{LabelSet: &set})
+	require.Error(t, err)
+}
+
+func createTimelineEvent(name, podName, reason, eventType string, count int32, lastSeen time.Time) *corev1.Event {
+	event := testutil.CreateEvent(name)
+	event.UID = types.UID(name)
+	event.InvolvedObject = corev1.ObjectReference{
+		APIVersion: "v1",
+		Kind:       "Pod",
+		Namespace:  event.Namespace,
+		Name:       podName,
+	}
+	event.Reason = reason
+	event.Type = eventType
+	event.Message = name + " message"
+	event.Count = count
+	event.FirstTimestamp = metav1.NewTime(lastSeen)
+	event.LastTimestamp = metav1.NewTime(lastSeen)
+
+	return event
+}
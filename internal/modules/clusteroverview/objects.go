@@ -60,6 +60,8 @@ var (
 
 	portForwardDescriber = NewPortForwardListDescriber()
 
+	certificateDescriber = NewCertificateListDescriber()
+
 	rootDescriber = describer.NewSection(
 		"/",
 		"Cluster Overview",
@@ -67,5 +69,6 @@ var (
 		rbacDescriber,
 		nodesDescriber,
 		portForwardDescriber,
+		certificateDescriber,
 	)
 )
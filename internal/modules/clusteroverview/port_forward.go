@@ -13,86 +13,52 @@ import (
 	"net/http"
 
 	"github.com/kubenext/lissio/internal/api"
+	"github.com/kubenext/lissio/internal/controllers"
 	"github.com/kubenext/lissio/internal/log"
 	"github.com/kubenext/lissio/internal/mime"
 	"github.com/kubenext/lissio/internal/portforward"
 	"github.com/kubenext/lissio/pkg/action"
 	"github.com/pkg/errors"
-
-	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
-type portForwardCreateRequest struct {
-	APIVersion string `json:"apiVersion,omitempty"`
-	Kind       string `json:"kind,omitempty"`
-	Name       string `json:"name,omitempty"`
-	Namespace  string `json:"namespace,omitempty"`
-	Port       uint16 `json:"port,omitempty"`
+// PortForwardStopper stops port forwards.
+type PortForwardStopper struct {
+	portForwarder portforward.PortForwarder
 }
 
-func (req *portForwardCreateRequest) Validate() error {
-	if req.APIVersion != "v1" && req.Kind == "Pod" {
-		return errors.New("only supports forwards for v1 Pods")
-	}
-
-	if req.Name == "" {
-		return errors.New("pod name is blank")
-	}
-
-	if req.Namespace == "" {
-		return errors.New("pod namespace is blank")
-	}
-
-	if req.Port < 1 {
-		return errors.New("port must be greater than 0")
-	}
+var _ action.Dispatcher = (*PortForwardStopper)(nil)
 
-	return nil
+// NewPortForwardStopper creates an instance of PortForwardStopper.
+func NewPortForwardStopper(portForwarder portforward.PortForwarder) *PortForwardStopper {
+	return &PortForwardStopper{portForwarder: portForwarder}
 }
 
-func (req *portForwardCreateRequest) gvk() schema.GroupVersionKind {
-	return schema.FromAPIVersionAndKind(req.APIVersion, req.Kind)
+// ActionName returns the name of this action.
+func (s *PortForwardStopper) ActionName() string {
+	return controllers.ActionStopPortForward
 }
 
-func portForwardRequestFromPayload(payload action.Payload) (*portForwardCreateRequest, error) {
-	apiVersion, err := payload.String("apiVersion")
+// Handle stops the port forward with the id in the payload.
+func (s *PortForwardStopper) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	id, err := payload.String("id")
 	if err != nil {
-		return nil, err
+		return errors.Wrap(err, "get port forward id from payload")
 	}
 
-	kind, err := payload.String("kind")
-	if err != nil {
-		return nil, err
+	state, ok := s.portForwarder.Get(id)
+	if !ok {
+		alert := action.CreateAlert(action.AlertTypeWarning, "Port forward was already stopped", action.DefaultAlertExpiration)
+		alerter.SendAlert(alert)
+		return nil
 	}
 
-	name, err := payload.String("name")
-	if err != nil {
-		return nil, err
-	}
+	s.portForwarder.StopForwarder(id)
 
-	namespace, err := payload.String("namespace")
-	if err != nil {
-		return nil, err
-	}
-
-	port, err := payload.Uint16("port")
-	if err != nil {
-		return nil, err
-	}
+	message := fmt.Sprintf("Stopped port forward to %s %q", state.Target.GVK.Kind, state.Target.Name)
+	alert := action.CreateAlert(action.AlertTypeInfo, message, action.DefaultAlertExpiration)
+	alerter.SendAlert(alert)
 
-	req := &portForwardCreateRequest{
-		APIVersion: apiVersion,
-		Kind:       kind,
-		Name:       name,
-		Namespace:  namespace,
-		Port:       port,
-	}
-
-	if err := req.Validate(); err != nil {
-		return nil, err
-	}
-
-	return req, nil
+	return nil
 }
 
 type portForwardError struct {
@@ -153,7 +119,7 @@ func createPortForward(ctx context.Context, body io.Reader, pfs portforward.Port
 	}
 	logger := log.From(ctx)
 
-	req := portForwardCreateRequest{}
+	req := portforward.PortRequest{}
 	if err := json.NewDecoder(body).Decode(&req); err != nil {
 		return &portForwardError{code: http.StatusBadRequest, message: "unable to decode request"}
 	}
@@ -166,7 +132,7 @@ func createPortForward(ctx context.Context, body io.Reader, pfs portforward.Port
 		}
 	}
 
-	resp, err := pfs.Create(ctx, req.gvk(), req.Name, req.Namespace, req.Port)
+	resp, err := pfs.CreateFromRequest(ctx, req.ToCreateRequest())
 	if err != nil {
 		return &portForwardError{
 			code:     http.StatusInternalServerError,
@@ -7,9 +7,12 @@ package clusteroverview
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/kubenext/lissio/internal/controllers"
 	"github.com/kubenext/lissio/internal/describer"
 	"github.com/kubenext/lissio/internal/portforward"
+	"github.com/kubenext/lissio/pkg/action"
 	"github.com/kubenext/lissio/pkg/view/component"
 )
 
@@ -29,23 +32,39 @@ func (d *PortForwardListDescriber) Describe(ctx context.Context, namespace strin
 
 	list := component.NewList("Port Forwards", nil)
 
-	tblCols := component.NewTableCols("Name", "Namespace", "Ports", "Age")
+	tblCols := component.NewTableCols("Name", "Namespace", "Ports", "Pod", "Status", "Age", "Actions")
 	tbl := component.NewTable("Port Forwards", "There are no port forwards!", tblCols)
 	list.Add(tbl)
 
 	for _, pf := range portForwarder.List(ctx) {
 		t := &pf.Target
 		apiVersion, kind := t.GVK.ToAPIVersionAndKind()
-		nameLink, err := options.Link.ForGVK(t.Namespace, apiVersion, kind, t.Name, t.Name)
+
+		title := t.Name
+		if pf.Alias != "" {
+			title = pf.Alias
+		}
+		nameLink, err := options.Link.ForGVK(t.Namespace, apiVersion, kind, t.Name, title)
 		if err != nil {
 			return component.EmptyContentResponse, err
 		}
 
+		podCell := component.Component(component.NewText(""))
+		if pf.Pod.Name != "" {
+			podCell, err = options.Link.ForGVK(pf.Pod.Namespace, "v1", "Pod", pf.Pod.Name, pf.Pod.Name)
+			if err != nil {
+				return component.EmptyContentResponse, err
+			}
+		}
+
 		pfRow := component.TableRow{
 			"Name":      nameLink,
 			"Namespace": component.NewText(t.Namespace),
 			"Ports":     component.NewPorts(describePortForwardPorts(pf)),
+			"Pod":       podCell,
+			"Status":    describePortForwardStatus(pf),
 			"Age":       component.NewTimestamp(pf.CreatedAt),
+			"Actions":   describePortForwardActions(pf),
 		}
 		tbl.Add(pfRow)
 	}
@@ -64,6 +83,33 @@ func (d *PortForwardListDescriber) Reset(ctx context.Context) error {
 	return nil
 }
 
+func describePortForwardStatus(pf portforward.State) *component.Text {
+	status := string(pf.Status)
+	if pf.Restarts > 0 {
+		status = fmt.Sprintf("%s (%d restarts)", status, pf.Restarts)
+	}
+	if pf.Status != portforward.StatusActive && pf.Error != "" {
+		status = fmt.Sprintf("%s: %s", status, pf.Error)
+	}
+
+	return component.NewText(status)
+}
+
+func describePortForwardActions(pf portforward.State) *component.ButtonGroup {
+	buttonGroup := component.NewButtonGroup()
+	buttonGroup.AddButton(
+		component.NewButton("Stop",
+			action.CreatePayload(controllers.ActionStopPortForward, map[string]interface{}{
+				"id": pf.ID,
+			}),
+			component.WithButtonConfirmation(
+				"Stop Port Forward",
+				fmt.Sprintf("Are you sure you want to stop the port forward to %s **%s**?", pf.Target.GVK.Kind, pf.Target.Name),
+			)))
+
+	return buttonGroup
+}
+
 func describePortForwardPorts(pf portforward.State) []component.Port {
 	var list []component.Port
 	apiVersion, kind := pf.Target.GVK.ToAPIVersionAndKind()
@@ -0,0 +1,55 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package clusteroverview
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubenext/lissio/internal/controllers"
+	"github.com/kubenext/lissio/internal/gvk"
+	"github.com/kubenext/lissio/internal/portforward"
+	portForwardFake "github.com/kubenext/lissio/internal/portforward/fake"
+	"github.com/kubenext/lissio/pkg/action"
+	actionFake "github.com/kubenext/lissio/pkg/action/fake"
+)
+
+func TestPortForwardStopper_Handle(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	pfs := portForwardFake.NewMockPortForwarder(controller)
+	alerter := actionFake.NewMockAlerter(controller)
+
+	state := portforward.State{
+		ID: "id",
+		Target: portforward.Target{
+			GVK:       gvk.Service,
+			Namespace: "default",
+			Name:      "redis",
+		},
+	}
+
+	pfs.EXPECT().Get("id").Return(state, true)
+	pfs.EXPECT().StopForwarder("id")
+
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		DoAndReturn(func(alert action.Alert) {
+			assert.Equal(t, action.AlertTypeInfo, alert.Type)
+			assert.Equal(t, `Stopped port forward to Service "redis"`, alert.Message)
+		})
+
+	s := NewPortForwardStopper(pfs)
+	require.Equal(t, controllers.ActionStopPortForward, s.ActionName())
+
+	payload := action.CreatePayload(controllers.ActionStopPortForward, map[string]interface{}{"id": "id"})
+	require.NoError(t, s.Handle(context.Background(), alerter, payload))
+}
@@ -0,0 +1,157 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package clusteroverview
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/kubenext/lissio/internal/cluster"
+	"github.com/kubenext/lissio/internal/config"
+	"github.com/kubenext/lissio/internal/controllers"
+	"github.com/kubenext/lissio/internal/objectstore"
+	"github.com/kubenext/lissio/internal/snapshot"
+	"github.com/kubenext/lissio/pkg/action"
+)
+
+// SnapshotExporter exports the objects in the cache to a snapshot in a
+// directory. The payload can select namespaces and kinds (e.g. apps/v1/Deployment)
+// and reveal secrets if the user can get them.
+type SnapshotExporter struct {
+	dashConfig config.Dash
+	dir        string
+	newAccess  func(client cluster.ClientInterface) objectstore.ResourceAccess
+}
+
+var _ action.Dispatcher = (*SnapshotExporter)(nil)
+
+// NewSnapshotExporter creates an instance of SnapshotExporter.
+func NewSnapshotExporter(dashConfig config.Dash, dir string) *SnapshotExporter {
+	return &SnapshotExporter{
+		dashConfig: dashConfig,
+		dir:        dir,
+		newAccess:  objectstore.NewResourceAccess,
+	}
+}
+
+// ActionName returns the name of this action.
+func (s *SnapshotExporter) ActionName() string {
+	return controllers.ActionExportSnapshot
+}
+
+// Handle exports a snapshot and alerts with its path.
+func (s *SnapshotExporter) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	options, err := snapshotExportOptionsFromPayload(payload)
+	if err != nil {
+		return errors.Wrap(err, "get snapshot options from payload")
+	}
+
+	lister, ok := s.dashConfig.ObjectStore().(objectstore.CachedObjectLister)
+	if !ok {
+		alert := action.CreateAlert(action.AlertTypeWarning, "The object store can't be exported", action.DefaultAlertExpiration)
+		alerter.SendAlert(alert)
+		return nil
+	}
+
+	logger := s.dashConfig.Logger().With("namespaces", options.Namespaces)
+
+	if options.RevealSecrets {
+		access := s.newAccess(s.dashConfig.ClusterClient())
+		if err := snapshot.CheckSecretAccess(ctx, access, options); err != nil {
+			logger.WithErr(err).Warnf("denied snapshot export with secret values")
+			alert := action.CreateAlert(action.AlertTypeWarning, fmt.Sprintf("Unable to export secret values: %s", err), action.DefaultAlertExpiration)
+			alerter.SendAlert(alert)
+			return nil
+		}
+	}
+
+	path, manifest, err := s.export(lister, options)
+	if err != nil {
+		logger.WithErr(err).Errorf("export snapshot")
+		alert := action.CreateAlert(action.AlertTypeError, fmt.Sprintf("Unable to export snapshot: %s", err), action.DefaultAlertExpiration)
+		alerter.SendAlert(alert)
+		return nil
+	}
+
+	logger.With(
+		"path", path,
+		"objects", manifest.Objects,
+		"secretsRedacted", manifest.SecretsRedacted,
+	).Infof("exported snapshot")
+
+	message := fmt.Sprintf("Exported %d objects to %s", manifest.Objects, path)
+	alert := action.CreateAlert(action.AlertTypeInfo, message, action.DefaultAlertExpiration)
+	alerter.SendAlert(alert)
+
+	return nil
+}
+
+func (s *SnapshotExporter) export(lister objectstore.CachedObjectLister, options snapshot.ExportOptions) (string, *snapshot.Manifest, error) {
+	if err := os.MkdirAll(s.dir, 0700); err != nil {
+		return "", nil, err
+	}
+
+	f, err := ioutil.TempFile(s.dir, ".snapshot-*")
+	if err != nil {
+		return "", nil, err
+	}
+	defer os.Remove(f.Name())
+
+	manifest, err := snapshot.Export(f, lister, s.dashConfig.ClusterClient(), options)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", nil, err
+	}
+
+	path := filepath.Join(s.dir, snapshot.FileName(manifest))
+	if err := os.Rename(f.Name(), path); err != nil {
+		return "", nil, err
+	}
+
+	return path, manifest, nil
+}
+
+func snapshotExportOptionsFromPayload(payload action.Payload) (snapshot.ExportOptions, error) {
+	var options snapshot.ExportOptions
+
+	if _, ok := payload["namespaces"]; ok {
+		namespaces, err := payload.StringSlice("namespaces")
+		if err != nil {
+			return snapshot.ExportOptions{}, err
+		}
+		options.Namespaces = namespaces
+	}
+
+	if _, ok := payload["kinds"]; ok {
+		kinds, err := payload.StringSlice("kinds")
+		if err != nil {
+			return snapshot.ExportOptions{}, err
+		}
+
+		for _, kind := range kinds {
+			groupVersionKind, err := snapshot.ParseKind(kind)
+			if err != nil {
+				return snapshot.ExportOptions{}, err
+			}
+			options.GroupVersionKinds = append(options.GroupVersionKinds, groupVersionKind)
+		}
+	}
+
+	revealSecrets, err := payload.OptionalBool("revealSecrets")
+	if err != nil {
+		return snapshot.ExportOptions{}, err
+	}
+	options.RevealSecrets = revealSecrets
+
+	return options, nil
+}
@@ -0,0 +1,155 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package clusteroverview
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kubenext/lissio/internal/cluster"
+	configFake "github.com/kubenext/lissio/internal/config/fake"
+	"github.com/kubenext/lissio/internal/controllers"
+	"github.com/kubenext/lissio/internal/gvk"
+	"github.com/kubenext/lissio/internal/log"
+	"github.com/kubenext/lissio/internal/objectstore"
+	"github.com/kubenext/lissio/internal/snapshot"
+	"github.com/kubenext/lissio/internal/testutil"
+	"github.com/kubenext/lissio/pkg/action"
+	actionFake "github.com/kubenext/lissio/pkg/action/fake"
+	"github.com/kubenext/lissio/pkg/store"
+)
+
+func TestSnapshotExporter_Handle(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dir, err := ioutil.TempDir("", "snapshots")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	objects := []*unstructured.Unstructured{
+		testutil.ToUnstructured(t, testutil.CreatePod("pod")),
+		testutil.ToUnstructured(t, testutil.CreateDeployment("deployment")),
+	}
+
+	objectStore, err := snapshot.NewStore(objects)
+	require.NoError(t, err)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().Logger().Return(log.NopLogger()).AnyTimes()
+	dashConfig.EXPECT().ObjectStore().Return(objectStore)
+	dashConfig.EXPECT().ClusterClient().Return(snapshot.NewClient("cluster", objects))
+
+	var message string
+	alerter := actionFake.NewMockAlerter(controller)
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		DoAndReturn(func(alert action.Alert) {
+			assert.Equal(t, action.AlertTypeInfo, alert.Type)
+			message = alert.Message
+		})
+
+	s := NewSnapshotExporter(dashConfig, filepath.Join(dir, "snapshots"))
+	require.Equal(t, controllers.ActionExportSnapshot, s.ActionName())
+
+	payload := action.CreatePayload(controllers.ActionExportSnapshot, map[string]interface{}{
+		"kinds": []interface{}{"apps/v1/Deployment"},
+	})
+	require.NoError(t, s.Handle(context.Background(), alerter, payload))
+
+	files, err := filepath.Glob(filepath.Join(dir, "snapshots", "snapshot-cluster-*.tar.gz"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	assert.Equal(t, fmt.Sprintf("Exported 1 objects to %s", files[0]), message)
+
+	snap, err := snapshot.Open(files[0])
+	require.NoError(t, err)
+	require.Len(t, snap.Objects, 1)
+	assert.Equal(t, "deployment", snap.Objects[0].GetName())
+}
+
+func TestSnapshotExporter_Handle_reveal_secrets_denied(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dir, err := ioutil.TempDir("", "snapshots")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	objects := []*unstructured.Unstructured{
+		testutil.ToUnstructured(t, testutil.CreateSecret("secret")),
+	}
+
+	objectStore, err := snapshot.NewStore(objects)
+	require.NoError(t, err)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().Logger().Return(log.NopLogger()).AnyTimes()
+	dashConfig.EXPECT().ObjectStore().Return(objectStore)
+	dashConfig.EXPECT().ClusterClient().Return(snapshot.NewClient("cluster", objects))
+
+	alerter := actionFake.NewMockAlerter(controller)
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		DoAndReturn(func(alert action.Alert) {
+			assert.Equal(t, action.AlertTypeWarning, alert.Type)
+		})
+
+	s := NewSnapshotExporter(dashConfig, filepath.Join(dir, "snapshots"))
+	s.newAccess = func(cluster.ClientInterface) objectstore.ResourceAccess {
+		return &deniedSecretAccess{}
+	}
+
+	payload := action.CreatePayload(controllers.ActionExportSnapshot, map[string]interface{}{
+		"namespaces":    []interface{}{"default"},
+		"revealSecrets": true,
+	})
+	require.NoError(t, s.Handle(context.Background(), alerter, payload))
+
+	files, err := filepath.Glob(filepath.Join(dir, "snapshots", "*"))
+	require.NoError(t, err)
+	assert.Empty(t, files)
+}
+
+type deniedSecretAccess struct {
+	objectstore.ResourceAccess
+}
+
+func (a *deniedSecretAccess) HasAccess(ctx context.Context, key store.Key, verb string) error {
+	return errors.Errorf("access denied: no %s access in %s to secrets", verb, key.Namespace)
+}
+
+func Test_snapshotExportOptionsFromPayload(t *testing.T) {
+	payload := action.Payload{
+		"namespaces":    []interface{}{"default"},
+		"kinds":         []interface{}{"v1/Pod"},
+		"revealSecrets": true,
+	}
+
+	got, err := snapshotExportOptionsFromPayload(payload)
+	require.NoError(t, err)
+
+	expected := snapshot.ExportOptions{
+		Namespaces:        []string{"default"},
+		GroupVersionKinds: []schema.GroupVersionKind{gvk.Pod},
+		RevealSecrets:     true,
+	}
+	assert.Equal(t, expected, got)
+
+	_, err = snapshotExportOptionsFromPayload(action.Payload{"kinds": []interface{}{"Pod"}})
+	assert.Error(t, err)
+}
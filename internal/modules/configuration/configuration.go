@@ -75,9 +75,10 @@ func (c *Configuration) Content(ctx context.Context, contentPath string, opts mo
 	}
 
 	options := describer.Options{
-		Fields:   pf.Fields(contentPath),
-		LabelSet: opts.LabelSet,
-		Dash:     c.DashConfig,
+		Fields:       pf.Fields(contentPath),
+		LabelSet:     opts.LabelSet,
+		TableQueries: opts.TableQueries,
+		Dash:         c.DashConfig,
 	}
 
 	cResponse, err := pf.Describer.Describe(ctx, "", options)
@@ -150,7 +151,17 @@ func (c Configuration) Generators() []controllers.Generator {
 func (c *Configuration) ActionPaths() map[string]action.DispatcherFunc {
 	objectDeleter := NewObjectDeleter(c.DashConfig.Logger(), c.DashConfig.ObjectStore())
 
-	return map[string]action.DispatcherFunc{
+	actionPaths := map[string]action.DispatcherFunc{
 		objectDeleter.ActionName(): objectDeleter.Handle,
 	}
+
+	for _, actionName := range []string{controllers.ActionEnablePlugin, controllers.ActionDisablePlugin, controllers.ActionRestartPlugin} {
+		changer := NewPluginStateChanger(actionName, c.DashConfig.Logger(), c.DashConfig.PluginManager())
+		actionPaths[changer.ActionName()] = changer.Handle
+	}
+
+	settingsUpdater := NewPluginSettingsUpdater(c.DashConfig.Logger(), c.DashConfig.PluginManager())
+	actionPaths[settingsUpdater.ActionName()] = settingsUpdater.Handle
+
+	return actionPaths
 }
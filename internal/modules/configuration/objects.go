@@ -8,11 +8,13 @@ package configuration
 import "github.com/kubenext/lissio/internal/describer"
 
 var (
-	pluginDescriber = &PluginListDescriber{}
+	pluginDescriber       = &PluginListDescriber{}
+	pluginDetailDescriber = &PluginDetailDescriber{}
 
 	rootDescriber = describer.NewSection(
 		"/",
 		"Configuration",
 		pluginDescriber,
+		pluginDetailDescriber,
 	)
 )
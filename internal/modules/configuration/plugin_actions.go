@@ -0,0 +1,77 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/kubenext/lissio/internal/controllers"
+	"github.com/kubenext/lissio/internal/log"
+	"github.com/kubenext/lissio/pkg/action"
+	"github.com/kubenext/lissio/pkg/plugin"
+)
+
+// PluginStateChanger enables, disables or restarts a plugin.
+type PluginStateChanger struct {
+	actionName    string
+	logger        log.Logger
+	pluginManager plugin.ManagerInterface
+}
+
+var _ action.Dispatcher = (*PluginStateChanger)(nil)
+
+// NewPluginStateChanger creates an instance of PluginStateChanger. The
+// action name determines what happens to the plugin.
+func NewPluginStateChanger(actionName string, logger log.Logger, pluginManager plugin.ManagerInterface) *PluginStateChanger {
+	return &PluginStateChanger{
+		actionName:    actionName,
+		logger:        logger.With("action", actionName),
+		pluginManager: pluginManager,
+	}
+}
+
+// ActionName returns the name of this action.
+func (c *PluginStateChanger) ActionName() string {
+	return c.actionName
+}
+
+// Handle changes the state of the plugin named in the payload.
+func (c *PluginStateChanger) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	c.logger.With("payload", payload).Debugf("changing plugin state")
+
+	name, err := payload.String("name")
+	if err != nil {
+		return errors.Wrap(err, "get plugin name from payload")
+	}
+
+	var verb string
+	switch c.actionName {
+	case controllers.ActionEnablePlugin:
+		verb = "Enabled"
+		err = c.pluginManager.Enable(ctx, name)
+	case controllers.ActionDisablePlugin:
+		verb = "Disabled"
+		err = c.pluginManager.Disable(ctx, name)
+	case controllers.ActionRestartPlugin:
+		verb = "Restarted"
+		err = c.pluginManager.Restart(ctx, name)
+	default:
+		return errors.Errorf("unknown plugin action %q", c.actionName)
+	}
+
+	alertType := action.AlertTypeInfo
+	message := fmt.Sprintf("%s plugin %q", verb, name)
+	if err != nil {
+		alertType = action.AlertTypeWarning
+		message = fmt.Sprintf("Unable to change plugin %q: %s", name, err)
+	}
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+
+	return nil
+}
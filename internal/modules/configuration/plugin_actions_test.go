@@ -0,0 +1,97 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubenext/lissio/internal/controllers"
+	"github.com/kubenext/lissio/internal/log"
+	"github.com/kubenext/lissio/pkg/action"
+	actionFake "github.com/kubenext/lissio/pkg/action/fake"
+	pluginFake "github.com/kubenext/lissio/pkg/plugin/fake"
+)
+
+func TestPluginStateChanger_Handle(t *testing.T) {
+	tests := []struct {
+		name            string
+		actionName      string
+		setup           func(pluginManager *pluginFake.MockManagerInterface)
+		expectedType    action.AlertType
+		expectedMessage string
+	}{
+		{
+			name:       "enable",
+			actionName: controllers.ActionEnablePlugin,
+			setup: func(pluginManager *pluginFake.MockManagerInterface) {
+				pluginManager.EXPECT().Enable(gomock.Any(), "plugin").Return(nil)
+			},
+			expectedType:    action.AlertTypeInfo,
+			expectedMessage: `Enabled plugin "plugin"`,
+		},
+		{
+			name:       "disable",
+			actionName: controllers.ActionDisablePlugin,
+			setup: func(pluginManager *pluginFake.MockManagerInterface) {
+				pluginManager.EXPECT().Disable(gomock.Any(), "plugin").Return(nil)
+			},
+			expectedType:    action.AlertTypeInfo,
+			expectedMessage: `Disabled plugin "plugin"`,
+		},
+		{
+			name:       "restart failed",
+			actionName: controllers.ActionRestartPlugin,
+			setup: func(pluginManager *pluginFake.MockManagerInterface) {
+				pluginManager.EXPECT().Restart(gomock.Any(), "plugin").Return(errors.New("plugin is disabled"))
+			},
+			expectedType:    action.AlertTypeWarning,
+			expectedMessage: `Unable to change plugin "plugin": plugin is disabled`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			pluginManager := pluginFake.NewMockManagerInterface(controller)
+			test.setup(pluginManager)
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				DoAndReturn(func(alert action.Alert) {
+					assert.Equal(t, test.expectedType, alert.Type)
+					assert.Equal(t, test.expectedMessage, alert.Message)
+				})
+
+			c := NewPluginStateChanger(test.actionName, log.NopLogger(), pluginManager)
+			require.Equal(t, test.actionName, c.ActionName())
+
+			ctx := context.Background()
+			require.NoError(t, c.Handle(ctx, alerter, action.Payload{"name": "plugin"}))
+		})
+	}
+}
+
+func TestPluginStateChanger_Handle_invalid_payload(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	pluginManager := pluginFake.NewMockManagerInterface(controller)
+	alerter := actionFake.NewMockAlerter(controller)
+
+	c := NewPluginStateChanger(controllers.ActionEnablePlugin, log.NopLogger(), pluginManager)
+
+	ctx := context.Background()
+	require.Error(t, c.Handle(ctx, alerter, action.Payload{}))
+}
@@ -8,12 +8,15 @@ package configuration
 import (
 	"context"
 	"fmt"
+	"path"
 	"strings"
 
-	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	"github.com/kubenext/lissio/internal/controllers"
 	"github.com/kubenext/lissio/internal/describer"
+	"github.com/kubenext/lissio/pkg/action"
+	"github.com/kubenext/lissio/pkg/plugin"
 	"github.com/kubenext/lissio/pkg/view/component"
 )
 
@@ -25,68 +28,109 @@ var _ describer.Describer = (*PluginListDescriber)(nil)
 
 // Describe describes a list of plugins
 func (d *PluginListDescriber) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
-	pluginStore := options.PluginManager().Store()
-
 	list := component.NewList("Plugins", nil)
-	tableCols := component.NewTableCols("Name", "Description", "Capabilities")
+	tableCols := component.NewTableCols("Name", "Description", "Capabilities", "Status", "Actions")
 	tbl := component.NewTable("Plugins", "There are no plugins!", tableCols)
 	list.Add(tbl)
 
-	for _, n := range pluginStore.ClientNames() {
-		metadata, err := pluginStore.GetMetadata(n)
-		if err != nil {
-			return component.EmptyContentResponse, errors.New("metadata is nil")
+	for _, status := range options.PluginManager().Plugins() {
+		row := component.TableRow{
+			"Name":         component.NewLink("", status.Name, pluginPath(status.Name)),
+			"Description":  component.NewText(""),
+			"Capabilities": component.NewText(""),
+			"Status":       component.NewText(describePluginStatus(status)),
+			"Actions":      describePluginActions(status),
 		}
 
-		var summaryItems []string
-		if metadata.Capabilities.IsModule {
-			summaryItems = append(summaryItems, "Module")
+		if metadata := status.Metadata; metadata != nil {
+			row["Name"] = component.NewLink("", metadata.Name, pluginPath(status.Name))
+			row["Description"] = component.NewText(metadata.Description)
+			row["Capabilities"] = component.NewText(describePluginCapabilities(metadata))
 		}
 
-		if actionNames := metadata.Capabilities.ActionNames; len(actionNames) > 0 {
-			summaryItems = append(summaryItems, fmt.Sprintf("Actions: %s",
-				strings.Join(actionNames, ", ")))
-		}
+		tbl.Add(row)
+	}
 
-		in := []struct {
-			name string
-			list []schema.GroupVersionKind
-		}{
-			{name: "Object Status", list: metadata.Capabilities.SupportsObjectStatus},
-			{name: "Printer Config", list: metadata.Capabilities.SupportsPrinterConfig},
-			{name: "Printer Items", list: metadata.Capabilities.SupportsPrinterItems},
-			{name: "Printer Status", list: metadata.Capabilities.SupportsPrinterStatus},
-			{name: "Tab", list: metadata.Capabilities.SupportsTab},
-		}
+	tbl.Sort("Name", false)
 
-		for _, item := range in {
-			support, ok := summarizeSupports(item.name, item.list)
-			if ok {
-				summaryItems = append(summaryItems, support)
-			}
-		}
+	return component.ContentResponse{
+		Components: []component.Component{list},
+	}, nil
+}
 
-		var sb strings.Builder
-		for i := range summaryItems {
-			sb.WriteString(fmt.Sprintf("[%s]", summaryItems[i]))
-			if i < len(summaryItems)-1 {
-				sb.WriteString(", ")
-			}
+func describePluginCapabilities(metadata *plugin.Metadata) string {
+	var summaryItems []string
+	if metadata.Capabilities.IsModule {
+		summaryItems = append(summaryItems, "Module")
+	}
+
+	if actionNames := metadata.Capabilities.ActionNames; len(actionNames) > 0 {
+		summaryItems = append(summaryItems, fmt.Sprintf("Actions: %s",
+			strings.Join(actionNames, ", ")))
+	}
+
+	in := []struct {
+		name string
+		list []schema.GroupVersionKind
+	}{
+		{name: "Object Status", list: metadata.Capabilities.SupportsObjectStatus},
+		{name: "Printer Config", list: metadata.Capabilities.SupportsPrinterConfig},
+		{name: "Printer Items", list: metadata.Capabilities.SupportsPrinterItems},
+		{name: "Printer Status", list: metadata.Capabilities.SupportsPrinterStatus},
+		{name: "Tab", list: metadata.Capabilities.SupportsTab},
+		{name: "Object Visitor", list: metadata.Capabilities.SupportsObjectVisitor},
+	}
+
+	for _, item := range in {
+		support, ok := summarizeSupports(item.name, item.list)
+		if ok {
+			summaryItems = append(summaryItems, support)
 		}
+	}
 
-		row := component.TableRow{
-			"Name":         component.NewText(metadata.Name),
-			"Description":  component.NewText(metadata.Description),
-			"Capabilities": component.NewText(sb.String()),
+	var sb strings.Builder
+	for i := range summaryItems {
+		sb.WriteString(fmt.Sprintf("[%s]", summaryItems[i]))
+		if i < len(summaryItems)-1 {
+			sb.WriteString(", ")
 		}
-		tbl.Add(row)
 	}
 
-	tbl.Sort("Name", false)
+	return sb.String()
+}
 
-	return component.ContentResponse{
-		Components: []component.Component{list},
-	}, nil
+func describePluginStatus(status plugin.PluginStatus) string {
+	if status.State == plugin.PluginStateCrashing {
+		return fmt.Sprintf("%s (%d crashes)", status.State, status.Health.Crashes)
+	}
+
+	return string(status.State)
+}
+
+func describePluginActions(status plugin.PluginStatus) *component.ButtonGroup {
+	payload := map[string]interface{}{
+		"name": status.Name,
+	}
+
+	buttonGroup := component.NewButtonGroup()
+
+	if status.State == plugin.PluginStateDisabled {
+		buttonGroup.AddButton(
+			component.NewButton("Enable", action.CreatePayload(controllers.ActionEnablePlugin, payload)))
+		return buttonGroup
+	}
+
+	buttonGroup.AddButton(
+		component.NewButton("Restart", action.CreatePayload(controllers.ActionRestartPlugin, payload)))
+	buttonGroup.AddButton(
+		component.NewButton("Disable",
+			action.CreatePayload(controllers.ActionDisablePlugin, payload),
+			component.WithButtonConfirmation(
+				"Disable Plugin",
+				fmt.Sprintf("Are you sure you want to disable plugin **%s**?", status.Name),
+			)))
+
+	return buttonGroup
 }
 
 func (d *PluginListDescriber) PathFilters() []describer.PathFilter {
@@ -102,6 +146,11 @@ func NewPluginListDescriber() *PluginListDescriber {
 	return &PluginListDescriber{}
 }
 
+// pluginPath returns the content path for a plugin's detail page.
+func pluginPath(name string) string {
+	return path.Join("/configuration/plugins", name)
+}
+
 func summarizeSupports(name string, list []schema.GroupVersionKind) (string, bool) {
 	if len(list) < 1 {
 		return "", false
@@ -10,15 +10,15 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
-	"github.com/hashicorp/go-plugin"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	configFake "github.com/kubenext/lissio/internal/config/fake"
+	"github.com/kubenext/lissio/internal/controllers"
 	"github.com/kubenext/lissio/internal/describer"
 	"github.com/kubenext/lissio/internal/gvk"
+	"github.com/kubenext/lissio/pkg/action"
 	dashPlugin "github.com/kubenext/lissio/pkg/plugin"
-	"github.com/kubenext/lissio/pkg/plugin/fake"
 	pluginFake "github.com/kubenext/lissio/pkg/plugin/fake"
 	"github.com/kubenext/lissio/pkg/view/component"
 )
@@ -43,12 +43,12 @@ func TestPluginDescriber(t *testing.T) {
 		},
 	}
 
-	store := dashPlugin.NewDefaultStore()
-	client := newFakePluginClient(name, controller)
-	require.NoError(t, store.Store(name, client, metadata, "cmd"))
-
 	pluginManager := pluginFake.NewMockManagerInterface(controller)
-	pluginManager.EXPECT().Store().Return(store).AnyTimes()
+	pluginManager.EXPECT().Plugins().Return([]dashPlugin.PluginStatus{
+		{Name: "plugin-test", Cmd: "/plugins/plugin-test", State: dashPlugin.PluginStateRunning, Metadata: metadata},
+		{Name: "disabled", Cmd: "/plugins/disabled", State: dashPlugin.PluginStateDisabled},
+		{Name: "crashing", Cmd: "/plugins/crashing", State: dashPlugin.PluginStateCrashing, Health: dashPlugin.Health{Crashes: 2}},
+	})
 
 	dashConfig := configFake.NewMockDash(controller)
 	dashConfig.EXPECT().PluginManager().Return(pluginManager)
@@ -65,13 +65,47 @@ func TestPluginDescriber(t *testing.T) {
 
 	capabilitiesData := "[Module], [Actions: action], [Object Status: v1 Pod], [Printer Config: v1 Pod], [Printer Items: v1 Pod], [Printer Status: v1 Pod], [Tab: v1 Pod]"
 
+	runningActions := component.NewButtonGroup()
+	runningActions.AddButton(component.NewButton("Restart",
+		action.CreatePayload(controllers.ActionRestartPlugin, map[string]interface{}{"name": name})))
+	runningActions.AddButton(component.NewButton("Disable",
+		action.CreatePayload(controllers.ActionDisablePlugin, map[string]interface{}{"name": name}),
+		component.WithButtonConfirmation("Disable Plugin", "Are you sure you want to disable plugin **plugin-test**?")))
+
+	crashingActions := component.NewButtonGroup()
+	crashingActions.AddButton(component.NewButton("Restart",
+		action.CreatePayload(controllers.ActionRestartPlugin, map[string]interface{}{"name": "crashing"})))
+	crashingActions.AddButton(component.NewButton("Disable",
+		action.CreatePayload(controllers.ActionDisablePlugin, map[string]interface{}{"name": "crashing"}),
+		component.WithButtonConfirmation("Disable Plugin", "Are you sure you want to disable plugin **crashing**?")))
+
+	disabledActions := component.NewButtonGroup()
+	disabledActions.AddButton(component.NewButton("Enable",
+		action.CreatePayload(controllers.ActionEnablePlugin, map[string]interface{}{"name": "disabled"})))
+
 	list := component.NewList("Plugins", nil)
-	tableCols := component.NewTableCols("Name", "Description", "Capabilities")
+	tableCols := component.NewTableCols("Name", "Description", "Capabilities", "Status", "Actions")
 	table := component.NewTable("Plugins", "There are no plugins!", tableCols)
 	table.Add(component.TableRow{
-		"Name":         component.NewText(name),
+		"Name":         component.NewLink("", "crashing", "/configuration/plugins/crashing"),
+		"Description":  component.NewText(""),
+		"Capabilities": component.NewText(""),
+		"Status":       component.NewText("Crashing (2 crashes)"),
+		"Actions":      crashingActions,
+	})
+	table.Add(component.TableRow{
+		"Name":         component.NewLink("", "disabled", "/configuration/plugins/disabled"),
+		"Description":  component.NewText(""),
+		"Capabilities": component.NewText(""),
+		"Status":       component.NewText("Disabled"),
+		"Actions":      disabledActions,
+	})
+	table.Add(component.TableRow{
+		"Name":         component.NewLink("", name, "/configuration/plugins/plugin-test"),
 		"Description":  component.NewText("this is a test"),
 		"Capabilities": component.NewText(capabilitiesData),
+		"Status":       component.NewText("Running"),
+		"Actions":      runningActions,
 	})
 
 	list.Add(table)
@@ -79,34 +113,3 @@ func TestPluginDescriber(t *testing.T) {
 	require.Len(t, cResponse.Components, 1)
 	component.AssertEqual(t, list, cResponse.Components[0])
 }
-
-func newFakePluginClient(name string, controller *gomock.Controller) *fakePluginClient {
-	service := fake.NewMockService(controller)
-	metadata := dashPlugin.Metadata{
-		Name: name,
-	}
-	service.EXPECT().Register(gomock.Any(), gomock.Eq("localhost:54321")).Return(metadata, nil).AnyTimes()
-
-	clientProtocol := fake.NewMockClientProtocol(controller)
-	clientProtocol.EXPECT().Dispense("plugin").Return(service, nil).AnyTimes()
-
-	return &fakePluginClient{
-		service:        service,
-		clientProtocol: clientProtocol,
-		name:           name,
-	}
-}
-
-type fakePluginClient struct {
-	clientProtocol *fake.MockClientProtocol
-	service        *fake.MockService
-	name           string
-}
-
-var _ dashPlugin.Client = (*fakePluginClient)(nil)
-
-func (c *fakePluginClient) Client() (plugin.ClientProtocol, error) {
-	return c.clientProtocol, nil
-}
-
-func (c *fakePluginClient) Kill() {}
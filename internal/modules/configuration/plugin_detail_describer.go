@@ -0,0 +1,171 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/kubenext/lissio/internal/api"
+	"github.com/kubenext/lissio/internal/describer"
+	"github.com/kubenext/lissio/pkg/plugin"
+	"github.com/kubenext/lissio/pkg/view/component"
+)
+
+// PluginDetailDescriber describes the health of a plugin.
+type PluginDetailDescriber struct {
+}
+
+var _ describer.Describer = (*PluginDetailDescriber)(nil)
+
+// NewPluginDetailDescriber creates an instance of PluginDetailDescriber.
+func NewPluginDetailDescriber() *PluginDetailDescriber {
+	return &PluginDetailDescriber{}
+}
+
+// Describe describes a plugin's status, RPC statistics and logs.
+func (d *PluginDetailDescriber) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
+	name := options.Fields["name"]
+
+	var status *plugin.PluginStatus
+	for _, s := range options.PluginManager().Plugins() {
+		if s.Name == name {
+			s := s
+			status = &s
+			break
+		}
+	}
+
+	if status == nil {
+		return component.EmptyContentResponse, api.NewNotFoundError(pluginPath(name))
+	}
+
+	title := status.Name
+	if status.Metadata != nil {
+		title = status.Metadata.Name
+	}
+
+	list := component.NewList(fmt.Sprintf("Plugin: %s", title), nil)
+	list.Add(describePluginSummary(*status))
+
+	if status.Metadata != nil && len(status.Metadata.Settings) > 0 {
+		list.Add(describePluginSettings(*status))
+	}
+
+	list.Add(
+		describePluginRPCs(status.Health),
+		describePluginLogs(status.Health),
+	)
+
+	return component.ContentResponse{
+		Components: []component.Component{list},
+	}, nil
+}
+
+func describePluginSummary(status plugin.PluginStatus) *component.Summary {
+	sections := []component.SummarySection{
+		{Header: "Status", Content: component.NewText(describePluginStatus(status))},
+	}
+
+	if status.URL != "" {
+		sections = append(sections, component.SummarySection{Header: "URL", Content: component.NewText(status.URL)})
+	} else {
+		sections = append(sections, component.SummarySection{Header: "Command", Content: component.NewText(status.Cmd)})
+	}
+
+	if metadata := status.Metadata; metadata != nil {
+		sections = append(sections,
+			component.SummarySection{Header: "Description", Content: component.NewText(metadata.Description)},
+			component.SummarySection{Header: "Capabilities", Content: component.NewText(describePluginCapabilities(metadata))},
+		)
+	}
+
+	health := status.Health
+	sections = append(sections, component.SummarySection{
+		Header:  "Crashes",
+		Content: component.NewText(fmt.Sprintf("%d", health.Crashes)),
+	})
+
+	if !health.LastCrash.IsZero() {
+		sections = append(sections,
+			component.SummarySection{Header: "Last Crash", Content: component.NewTimestamp(health.LastCrash)},
+			component.SummarySection{Header: "Last Error", Content: component.NewText(health.LastError)},
+		)
+	}
+
+	if !health.SkippedUntil.IsZero() {
+		sections = append(sections, component.SummarySection{
+			Header:  "Skipped Until",
+			Content: component.NewTimestamp(health.SkippedUntil),
+		})
+	}
+
+	if status.State == plugin.PluginStateCrashing {
+		sections = append(sections, component.SummarySection{
+			Header:  "Next Restart",
+			Content: component.NewTimestamp(health.NextRestart),
+		})
+	}
+
+	sections = append(sections, component.SummarySection{
+		Header:  "Actions",
+		Content: describePluginActions(status),
+	})
+
+	return component.NewSummary("Status", sections...)
+}
+
+func describePluginRPCs(health plugin.Health) *component.Table {
+	cols := component.NewTableCols("Method", "Calls", "Errors", "Average Latency", "Max Latency", "Last Error")
+	table := component.NewTable("RPCs", "The plugin hasn't been called", cols)
+
+	var methods []string
+	for method := range health.RPC {
+		methods = append(methods, method)
+	}
+	sort.Strings(methods)
+
+	for _, method := range methods {
+		stats := health.RPC[method]
+		table.Add(component.TableRow{
+			"Method":          component.NewText(method),
+			"Calls":           component.NewText(fmt.Sprintf("%d", stats.Calls)),
+			"Errors":          component.NewText(fmt.Sprintf("%d", stats.Errors)),
+			"Average Latency": component.NewText(stats.AverageLatency().String()),
+			"Max Latency":     component.NewText(stats.MaxLatency.String()),
+			"Last Error":      component.NewText(stats.LastError),
+		})
+	}
+
+	return table
+}
+
+func describePluginLogs(health plugin.Health) *component.Table {
+	cols := component.NewTableCols("Time", "Level", "Message")
+	table := component.NewTable("Logs", "The plugin hasn't logged anything", cols)
+
+	// Show the newest entries first.
+	for i := len(health.Logs) - 1; i >= 0; i-- {
+		entry := health.Logs[i]
+		table.Add(component.TableRow{
+			"Time":    component.NewTimestamp(entry.Timestamp),
+			"Level":   component.NewText(entry.Level),
+			"Message": component.NewText(entry.Message),
+		})
+	}
+
+	return table
+}
+
+func (d *PluginDetailDescriber) PathFilters() []describer.PathFilter {
+	filter := describer.NewPathFilter("/plugins/(?P<name>[^/]+)", d)
+	return []describer.PathFilter{*filter}
+}
+
+func (d *PluginDetailDescriber) Reset(ctx context.Context) error {
+	return nil
+}
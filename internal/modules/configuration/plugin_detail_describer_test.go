@@ -0,0 +1,127 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	configFake "github.com/kubenext/lissio/internal/config/fake"
+	"github.com/kubenext/lissio/internal/describer"
+	dashPlugin "github.com/kubenext/lissio/pkg/plugin"
+	pluginFake "github.com/kubenext/lissio/pkg/plugin/fake"
+	"github.com/kubenext/lissio/pkg/view/component"
+)
+
+func TestPluginDetailDescriber(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	now := time.Unix(1000, 0)
+	status := dashPlugin.PluginStatus{
+		Name:     "plugin-test",
+		Cmd:      "/plugins/plugin-test",
+		State:    dashPlugin.PluginStateRunning,
+		Metadata: &dashPlugin.Metadata{Name: "plugin-test", Description: "this is a test"},
+		Health: dashPlugin.Health{
+			Crashes:   1,
+			LastCrash: now,
+			LastError: "crashed",
+			RPC: map[string]dashPlugin.RPCStats{
+				"Print": {Calls: 2, Errors: 1, TotalLatency: 4 * time.Millisecond, MaxLatency: 3 * time.Millisecond, LastError: "failed"},
+			},
+			Logs: []dashPlugin.LogEntry{
+				{Timestamp: now, Level: "info", Message: "first"},
+				{Timestamp: now, Level: "error", Message: "second"},
+			},
+		},
+	}
+
+	pluginManager := pluginFake.NewMockManagerInterface(controller)
+	pluginManager.EXPECT().Plugins().Return([]dashPlugin.PluginStatus{status})
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().PluginManager().Return(pluginManager)
+
+	d := NewPluginDetailDescriber()
+
+	options := describer.Options{
+		Dash:   dashConfig,
+		Fields: map[string]string{"name": "plugin-test"},
+	}
+
+	cResponse, err := d.Describe(context.Background(), "", options)
+	require.NoError(t, err)
+
+	summary := component.NewSummary("Status",
+		component.SummarySection{Header: "Status", Content: component.NewText("Running")},
+		component.SummarySection{Header: "Command", Content: component.NewText("/plugins/plugin-test")},
+		component.SummarySection{Header: "Description", Content: component.NewText("this is a test")},
+		component.SummarySection{Header: "Capabilities", Content: component.NewText("")},
+		component.SummarySection{Header: "Crashes", Content: component.NewText("1")},
+		component.SummarySection{Header: "Last Crash", Content: component.NewTimestamp(now)},
+		component.SummarySection{Header: "Last Error", Content: component.NewText("crashed")},
+		component.SummarySection{Header: "Actions", Content: describePluginActions(status)},
+	)
+
+	rpcs := component.NewTable("RPCs", "The plugin hasn't been called",
+		component.NewTableCols("Method", "Calls", "Errors", "Average Latency", "Max Latency", "Last Error"))
+	rpcs.Add(component.TableRow{
+		"Method":          component.NewText("Print"),
+		"Calls":           component.NewText("2"),
+		"Errors":          component.NewText("1"),
+		"Average Latency": component.NewText("2ms"),
+		"Max Latency":     component.NewText("3ms"),
+		"Last Error":      component.NewText("failed"),
+	})
+
+	logs := component.NewTable("Logs", "The plugin hasn't logged anything",
+		component.NewTableCols("Time", "Level", "Message"))
+	logs.Add(
+		component.TableRow{
+			"Time":    component.NewTimestamp(now),
+			"Level":   component.NewText("error"),
+			"Message": component.NewText("second"),
+		},
+		component.TableRow{
+			"Time":    component.NewTimestamp(now),
+			"Level":   component.NewText("info"),
+			"Message": component.NewText("first"),
+		},
+	)
+
+	list := component.NewList("Plugin: plugin-test", nil)
+	list.Add(summary, rpcs, logs)
+
+	require.Len(t, cResponse.Components, 1)
+	component.AssertEqual(t, list, cResponse.Components[0])
+}
+
+func TestPluginDetailDescriber_not_found(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	pluginManager := pluginFake.NewMockManagerInterface(controller)
+	pluginManager.EXPECT().Plugins().Return(nil)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().PluginManager().Return(pluginManager)
+
+	d := NewPluginDetailDescriber()
+
+	options := describer.Options{
+		Dash:   dashConfig,
+		Fields: map[string]string{"name": "missing"},
+	}
+
+	_, err := d.Describe(context.Background(), "", options)
+	assert.Error(t, err)
+}
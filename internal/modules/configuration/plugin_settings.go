@@ -0,0 +1,178 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/kubenext/lissio/internal/controllers"
+	"github.com/kubenext/lissio/internal/log"
+	"github.com/kubenext/lissio/pkg/action"
+	"github.com/kubenext/lissio/pkg/plugin"
+	"github.com/kubenext/lissio/pkg/view/component"
+)
+
+const (
+	// settingFieldPrefix prefixes setting names in the settings form so they
+	// don't collide with the form's own fields.
+	settingFieldPrefix = "setting."
+)
+
+// PluginSettingsUpdater updates a plugin's settings.
+type PluginSettingsUpdater struct {
+	logger        log.Logger
+	pluginManager plugin.ManagerInterface
+}
+
+var _ action.Dispatcher = (*PluginSettingsUpdater)(nil)
+
+// NewPluginSettingsUpdater creates an instance of PluginSettingsUpdater.
+func NewPluginSettingsUpdater(logger log.Logger, pluginManager plugin.ManagerInterface) *PluginSettingsUpdater {
+	return &PluginSettingsUpdater{
+		logger:        logger.With("action", controllers.ActionUpdatePluginSettings),
+		pluginManager: pluginManager,
+	}
+}
+
+// ActionName returns the name of this action.
+func (u *PluginSettingsUpdater) ActionName() string {
+	return controllers.ActionUpdatePluginSettings
+}
+
+// Handle updates the settings of the plugin named in the payload.
+func (u *PluginSettingsUpdater) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	u.logger.Debugf("updating plugin settings")
+
+	name, err := payload.String("name")
+	if err != nil {
+		return errors.Wrap(err, "get plugin name from payload")
+	}
+
+	var status *plugin.PluginStatus
+	for _, s := range u.pluginManager.Plugins() {
+		if s.Name == name {
+			s := s
+			status = &s
+			break
+		}
+	}
+
+	if status == nil || status.Metadata == nil {
+		return errors.Errorf("plugin %q is not running", name)
+	}
+
+	settings, err := settingsFromPayload(status.Metadata.Settings, payload)
+	if err != nil {
+		return err
+	}
+
+	alertType := action.AlertTypeInfo
+	message := fmt.Sprintf("Updated settings for plugin %q", name)
+	if err := u.pluginManager.UpdateSettings(ctx, name, settings); err != nil {
+		alertType = action.AlertTypeWarning
+		message = fmt.Sprintf("Unable to update settings for plugin %q: %s", name, err)
+	}
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+
+	return nil
+}
+
+// settingsFromPayload reads setting values from a settings form payload.
+// Secrets which were left blank keep their current value.
+func settingsFromPayload(fields []plugin.SettingField, payload action.Payload) (plugin.Settings, error) {
+	settings := plugin.Settings{}
+
+	for _, field := range fields {
+		key := settingFieldPrefix + field.Name
+
+		switch field.Type {
+		case plugin.SettingTypeBool:
+			// Unchecked check boxes aren't included in the payload.
+			checked, _ := payload.StringSlice(key)
+			settings[field.Name] = strconv.FormatBool(len(checked) > 0)
+		case plugin.SettingTypeNumber:
+			if _, ok := payload[key]; !ok {
+				continue
+			}
+
+			f, err := payload.Float64(key)
+			if err != nil {
+				return nil, errors.Wrapf(err, "setting %q requires a number", field.Name)
+			}
+			settings[field.Name] = strconv.FormatFloat(f, 'f', -1, 64)
+		default:
+			value, err := payload.OptionalString(key)
+			if err != nil {
+				return nil, errors.Wrapf(err, "get setting %q from payload", field.Name)
+			}
+
+			if field.Type == plugin.SettingTypeSecret && value == "" {
+				continue
+			}
+			settings[field.Name] = value
+		}
+	}
+
+	return settings, nil
+}
+
+// describePluginSettings describes a plugin's settings. It includes an
+// action for editing them.
+func describePluginSettings(status plugin.PluginStatus) *component.Summary {
+	summary := component.NewSummary("Settings")
+
+	var formFields []component.FormField
+
+	for _, field := range status.Metadata.Settings {
+		label := field.Label
+		if label == "" {
+			label = field.Name
+		}
+
+		value := status.Settings[field.Name]
+		key := settingFieldPrefix + field.Name
+
+		var content component.Component = component.NewText(value)
+
+		switch field.Type {
+		case plugin.SettingTypeSecret:
+			// Secrets aren't sent to the browser.
+			content = component.NewText("(not set)")
+			if value != "" {
+				content = component.NewText("(set)")
+			}
+			formFields = append(formFields, component.NewFormFieldPassword(label, key, ""))
+		case plugin.SettingTypeNumber:
+			formFields = append(formFields, component.NewFormFieldNumber(label, key, value))
+		case plugin.SettingTypeBool:
+			checked, _ := strconv.ParseBool(value)
+			formFields = append(formFields, component.NewFormFieldCheckBox(label, key, []component.InputChoice{
+				{Label: "Enabled", Value: "true", Checked: checked},
+			}))
+		default:
+			formFields = append(formFields, component.NewFormFieldText(label, key, value))
+		}
+
+		summary.Add(component.SummarySection{Header: label, Content: content})
+	}
+
+	formFields = append(formFields,
+		component.NewFormFieldHidden("name", status.Name),
+		component.NewFormFieldHidden("action", controllers.ActionUpdatePluginSettings),
+	)
+
+	summary.AddAction(component.Action{
+		Name:  "Edit",
+		Title: "Plugin Settings",
+		Form:  component.Form{Fields: formFields},
+	})
+
+	return summary
+}
@@ -0,0 +1,158 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubenext/lissio/internal/log"
+	"github.com/kubenext/lissio/pkg/action"
+	actionFake "github.com/kubenext/lissio/pkg/action/fake"
+	"github.com/kubenext/lissio/pkg/plugin"
+	pluginFake "github.com/kubenext/lissio/pkg/plugin/fake"
+)
+
+var testSettingFields = []plugin.SettingField{
+	{Name: "url", Type: plugin.SettingTypeString},
+	{Name: "token", Type: plugin.SettingTypeSecret},
+	{Name: "limit", Type: plugin.SettingTypeNumber},
+	{Name: "verbose", Type: plugin.SettingTypeBool},
+}
+
+func Test_settingsFromPayload(t *testing.T) {
+	tests := []struct {
+		name     string
+		payload  action.Payload
+		expected plugin.Settings
+		isErr    bool
+	}{
+		{
+			name: "all settings",
+			payload: action.Payload{
+				"setting.url":     "https://jira.example.com",
+				"setting.token":   "secret",
+				"setting.limit":   float64(25),
+				"setting.verbose": []interface{}{"true"},
+			},
+			expected: plugin.Settings{
+				"url":     "https://jira.example.com",
+				"token":   "secret",
+				"limit":   "25",
+				"verbose": "true",
+			},
+		},
+		{
+			name: "blank secret and unchecked bool",
+			payload: action.Payload{
+				"setting.url":   "https://jira.example.com",
+				"setting.token": "",
+			},
+			expected: plugin.Settings{
+				"url":     "https://jira.example.com",
+				"verbose": "false",
+			},
+		},
+		{
+			name:    "invalid number",
+			payload: action.Payload{"setting.limit": "ten"},
+			isErr:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := settingsFromPayload(testSettingFields, test.payload)
+			if test.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func TestPluginSettingsUpdater_Handle(t *testing.T) {
+	tests := []struct {
+		name            string
+		updateErr       error
+		expectedType    action.AlertType
+		expectedMessage string
+	}{
+		{
+			name:            "updated",
+			expectedType:    action.AlertTypeInfo,
+			expectedMessage: `Updated settings for plugin "plugin"`,
+		},
+		{
+			name:            "update failed",
+			updateErr:       errors.New("setting \"limit\" requires a number"),
+			expectedType:    action.AlertTypeWarning,
+			expectedMessage: `Unable to update settings for plugin "plugin": setting "limit" requires a number`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			pluginManager := pluginFake.NewMockManagerInterface(controller)
+			pluginManager.EXPECT().Plugins().Return([]plugin.PluginStatus{
+				{
+					Name:     "plugin",
+					State:    plugin.PluginStateRunning,
+					Metadata: &plugin.Metadata{Name: "plugin", Settings: testSettingFields},
+				},
+			})
+			pluginManager.EXPECT().
+				UpdateSettings(gomock.Any(), "plugin", plugin.Settings{
+					"url":     "https://jira.example.com",
+					"verbose": "false",
+				}).
+				Return(test.updateErr)
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				DoAndReturn(func(alert action.Alert) {
+					assert.Equal(t, test.expectedType, alert.Type)
+					assert.Equal(t, test.expectedMessage, alert.Message)
+				})
+
+			u := NewPluginSettingsUpdater(log.NopLogger(), pluginManager)
+
+			payload := action.Payload{
+				"name":        "plugin",
+				"setting.url": "https://jira.example.com",
+			}
+
+			ctx := context.Background()
+			require.NoError(t, u.Handle(ctx, alerter, payload))
+		})
+	}
+}
+
+func TestPluginSettingsUpdater_Handle_plugin_not_running(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	pluginManager := pluginFake.NewMockManagerInterface(controller)
+	pluginManager.EXPECT().Plugins().Return(nil)
+
+	u := NewPluginSettingsUpdater(log.NopLogger(), pluginManager)
+
+	ctx := context.Background()
+	err := u.Handle(ctx, actionFake.NewMockAlerter(controller), action.Payload{"name": "plugin"})
+	require.Error(t, err)
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/kubenext/lissio/internal/generator"
 	"github.com/kubenext/lissio/internal/log"
 	"github.com/kubenext/lissio/internal/module"
+	"github.com/kubenext/lissio/internal/objectstore"
 	"github.com/kubenext/lissio/pkg/action"
 	"github.com/kubenext/lissio/pkg/icon"
 	"github.com/kubenext/lissio/pkg/navigation"
@@ -44,6 +45,9 @@ type Overview struct {
 	pathMatcher *describer.PathMatcher
 	logger      log.Logger
 
+	// secretAccess checks access before secret values are revealed.
+	secretAccess objectstore.ResourceAccess
+
 	watchedCRDs []*unstructured.Unstructured
 
 	mu sync.Mutex
@@ -65,6 +69,8 @@ func New(ctx context.Context, options Options) (*Overview, error) {
 	co := &Overview{
 		dashConfig: options.DashConfig,
 		logger:     options.DashConfig.Logger().With("module", "overview"),
+
+		secretAccess: objectstore.NewResourceAccess(options.DashConfig.ClusterClient()),
 	}
 
 	if err := co.bootstrap(ctx); err != nil {
@@ -89,6 +95,9 @@ func (co *Overview) SetContext(ctx context.Context, contextName string) error {
 
 	customResourcesDescriber := describer.NamespacedCRD()
 	co.contextName = contextName
+	co.secretAccess.UpdateClient(co.dashConfig.ClusterClient())
+	co.secretAccess.Reset()
+
 	for i := range co.watchedCRDs {
 		describer.DeleteCRD(ctx, co.watchedCRDs[i], co.pathMatcher, customResourcesDescriber, co, co.dashConfig.ObjectStore())
 	}
@@ -254,7 +263,8 @@ func (co *Overview) Stop() {
 func (co *Overview) Content(ctx context.Context, contentPath string, opts module.ContentOptions) (component.ContentResponse, error) {
 	ctx = log.WithLoggerContext(ctx, co.dashConfig.Logger())
 	genOpts := generator.Options{
-		LabelSet: opts.LabelSet,
+		LabelSet:     opts.LabelSet,
+		TableQueries: opts.TableQueries,
 	}
 	return co.generator.Generate(ctx, contentPath, genOpts)
 }
@@ -330,6 +340,7 @@ func (co *Overview) ActionPaths() map[string]action.DispatcherFunc {
 		controllers.NewDeploymentConfigurationEditor(co.logger, co.dashConfig.ObjectStore()),
 		controllers.NewContainerEditor(co.dashConfig.ObjectStore()),
 		controllers.NewServiceConfigurationEditor(co.dashConfig.ObjectStore()),
+		controllers.NewSecretRevealer(co.logger, co.dashConfig.ObjectStore(), co.secretAccess, co.dashConfig.SecretReveals()),
 	}
 
 	return dispatchers.ToActionPaths()
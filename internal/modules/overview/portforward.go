@@ -12,7 +12,6 @@ import (
 	"net/http"
 
 	"github.com/pkg/errors"
-	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/kubenext/lissio/internal/api"
 	"github.com/kubenext/lissio/internal/log"
@@ -20,39 +19,6 @@ import (
 	"github.com/kubenext/lissio/internal/portforward"
 )
 
-type portForwardCreateRequest struct {
-	APIVersion string `json:"apiVersion,omitempty"`
-	Kind       string `json:"kind,omitempty"`
-	Type       string `json:"type,omitempty"`
-	Name       string `json:"name,omitempty"`
-	Namespace  string `json:"namespace,omitempty"`
-	Port       uint16 `json:"port,omitempty"`
-}
-
-func (req *portForwardCreateRequest) Validate() error {
-	if req.APIVersion != "v1" && req.Kind == "Pod" {
-		return errors.New("only supports forwards for v1 Pods")
-	}
-
-	if req.Name == "" {
-		return errors.New("pod name is blank")
-	}
-
-	if req.Namespace == "" {
-		return errors.New("pod namespace is blank")
-	}
-
-	if req.Port < 1 {
-		return errors.New("port must be greater than 0")
-	}
-
-	return nil
-}
-
-func (req *portForwardCreateRequest) gvk() schema.GroupVersionKind {
-	return schema.FromAPIVersionAndKind(req.APIVersion, req.Kind)
-}
-
 type portForwardError struct {
 	code     int
 	message  string
@@ -71,7 +37,7 @@ func createPortForward(ctx context.Context, body io.Reader, pfs portforward.Port
 	}
 	logger := log.From(ctx)
 
-	req := portForwardCreateRequest{}
+	req := portforward.PortRequest{}
 	if err := json.NewDecoder(body).Decode(&req); err != nil {
 		return &portForwardError{code: http.StatusBadRequest, message: "unable to decode request"}
 	}
@@ -84,7 +50,7 @@ func createPortForward(ctx context.Context, body io.Reader, pfs portforward.Port
 		}
 	}
 
-	resp, err := pfs.Create(ctx, req.gvk(), req.Name, req.Namespace, req.Port)
+	resp, err := pfs.CreateFromRequest(ctx, req.ToCreateRequest())
 	if err != nil {
 		return &portForwardError{
 			code:     http.StatusInternalServerError,
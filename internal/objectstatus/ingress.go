@@ -8,6 +8,7 @@ package objectstatus
 import (
 	"context"
 	"strconv"
+	"time"
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -16,6 +17,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes/scheme"
 
+	"github.com/kubenext/lissio/internal/secrets"
 	"github.com/kubenext/lissio/pkg/store"
 )
 
@@ -135,7 +137,8 @@ func (is *ingressStatus) run(ctx context.Context) (ObjectStatus, error) {
 			Name:       tls.SecretName,
 		}
 
-		_, found, err := is.objectstore.Get(ctx, key)
+		secret := &corev1.Secret{}
+		found, err := store.GetAs(ctx, is.objectstore, key, secret)
 		if err != nil {
 			status.SetError()
 			status.AddDetailf("Unable to load Secret %q: %s", tls.SecretName, err)
@@ -145,6 +148,12 @@ func (is *ingressStatus) run(ctx context.Context) (ObjectStatus, error) {
 		if !found {
 			status.SetError()
 			status.AddDetailf("Secret %q does not exist", tls.SecretName)
+			continue
+		}
+
+		// certificates which can't be parsed are reported by the secret's status
+		if certificates, err := secrets.ParseCertificates(secret.Data[corev1.TLSCertKey]); err == nil {
+			certificateExpiryStatus(&status, tls.SecretName, certificates, time.Now())
 		}
 	}
 
@@ -8,6 +8,7 @@ package objectstatus
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -99,6 +100,23 @@ func Test_runIngressStatus(t *testing.T) {
 				Details:    []component.Component{component.NewText("Secret \"no-such-secret\" does not exist")},
 			},
 		},
+		{
+			name: "TLS certificate expires soon",
+			init: func(t *testing.T, o *storefake.MockStore) runtime.Object {
+				mockServiceInCache(t, o, "default", "my-service", "service_my-service.yaml")
+
+				secret := testutil.CreateTLSSecret(t, "no-such-secret", "sslexample.foo.com", time.Now().Add(10*24*time.Hour+time.Hour))
+				key := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Secret", Name: "no-such-secret"}
+				o.EXPECT().Get(gomock.Any(), gomock.Eq(key)).Return(testutil.ToUnstructured(t, secret), true, nil)
+
+				objectFile := "ingress_ingress-bad-tls-host.yaml"
+				return testutil.LoadObjectFromFile(t, objectFile)
+			},
+			expected: ObjectStatus{
+				nodeStatus: component.NodeStatusWarning,
+				Details:    []component.Component{component.NewText("Certificate \"CN=sslexample.foo.com\" in Secret \"no-such-secret\" expires in 10 days")},
+			},
+		},
 		{
 			name: "object is nil",
 			init: func(t *testing.T, o *storefake.MockStore) runtime.Object {
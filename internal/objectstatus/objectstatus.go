@@ -34,6 +34,7 @@ var (
 		{apiVersion: "batch/v1", kind: "Job"}:                  runJobStatus,
 		{apiVersion: "v1", kind: "Pod"}:                        pod,
 		{apiVersion: "v1", kind: "ReplicationController"}:      replicationController,
+		{apiVersion: "v1", kind: "Secret"}:                     runSecretStatus,
 		{apiVersion: "v1", kind: "Service"}:                    service,
 		{apiVersion: "extensions/v1beta1", kind: "Ingress"}:    runIngressStatus,
 		{apiVersion: "extensions/v1beta1", kind: "ReplicaSet"}: replicaSetExtV1Beta1,
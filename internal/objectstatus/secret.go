@@ -0,0 +1,75 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstatus
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"github.com/kubenext/lissio/internal/secrets"
+	"github.com/kubenext/lissio/pkg/store"
+)
+
+// CertificateExpiryWarningDays is how many days before a certificate
+// expires that it is reported as a warning.
+const CertificateExpiryWarningDays = 30
+
+func runSecretStatus(_ context.Context, object runtime.Object, _ store.Store) (ObjectStatus, error) {
+	if object == nil {
+		return ObjectStatus{}, errors.Errorf("secret is nil")
+	}
+
+	secret := &corev1.Secret{}
+
+	if err := scheme.Scheme.Convert(object, secret, 0); err != nil {
+		return ObjectStatus{}, errors.Wrap(err, "convert object to secret")
+	}
+
+	status := ObjectStatus{}
+
+	if secret.Type == corev1.SecretTypeTLS {
+		certificates, err := secrets.ParseCertificates(secret.Data[corev1.TLSCertKey])
+		switch {
+		case err != nil:
+			status.SetError()
+			status.AddDetailf("Unable to parse certificate: %s", err)
+		case len(certificates) == 0:
+			status.SetError()
+			status.AddDetail("Secret does not contain a certificate")
+		default:
+			certificateExpiryStatus(&status, secret.Name, certificates, time.Now())
+		}
+	}
+
+	if len(status.Details) == 0 {
+		status.AddDetail("Secret is OK")
+	}
+
+	return status, nil
+}
+
+// certificateExpiryStatus updates status for certificates which have
+// expired or are about to expire.
+func certificateExpiryStatus(status *ObjectStatus, secretName string, certificates []secrets.Certificate, now time.Time) {
+	for _, certificate := range certificates {
+		days := certificate.DaysUntilExpiry(now)
+		switch {
+		case days < 0:
+			status.SetError()
+			status.AddDetailf("Certificate %q in Secret %q expired on %s",
+				certificate.Subject, secretName, certificate.NotAfter.UTC().Format("2006-01-02"))
+		case days <= CertificateExpiryWarningDays:
+			status.SetWarning()
+			status.AddDetailf("Certificate %q in Secret %q expires in %d days",
+				certificate.Subject, secretName, days)
+		}
+	}
+}
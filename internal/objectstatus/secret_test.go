@@ -0,0 +1,111 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstatus
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubenext/lissio/internal/testutil"
+	storefake "github.com/kubenext/lissio/pkg/store/fake"
+	"github.com/kubenext/lissio/pkg/view/component"
+)
+
+func Test_runSecretStatus(t *testing.T) {
+	day := 24 * time.Hour
+
+	cases := []struct {
+		name     string
+		object   func(t *testing.T) runtime.Object
+		expected ObjectStatus
+		isErr    bool
+	}{
+		{
+			name: "opaque secret",
+			object: func(t *testing.T) runtime.Object {
+				return testutil.CreateSecret("secret")
+			},
+			expected: ObjectStatus{
+				Details: []component.Component{component.NewText("Secret is OK")},
+			},
+		},
+		{
+			name: "valid certificate",
+			object: func(t *testing.T) runtime.Object {
+				return testutil.CreateTLSSecret(t, "secret", "example.com", time.Now().Add(90*day))
+			},
+			expected: ObjectStatus{
+				Details: []component.Component{component.NewText("Secret is OK")},
+			},
+		},
+		{
+			name: "certificate expires soon",
+			object: func(t *testing.T) runtime.Object {
+				return testutil.CreateTLSSecret(t, "secret", "example.com", time.Now().Add(5*day+time.Hour))
+			},
+			expected: ObjectStatus{
+				nodeStatus: component.NodeStatusWarning,
+				Details:    []component.Component{component.NewText(`Certificate "CN=example.com" in Secret "secret" expires in 5 days`)},
+			},
+		},
+		{
+			name: "certificate expired",
+			object: func(t *testing.T) runtime.Object {
+				notAfter := time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC)
+				return testutil.CreateTLSSecret(t, "secret", "example.com", notAfter)
+			},
+			expected: ObjectStatus{
+				nodeStatus: component.NodeStatusError,
+				Details:    []component.Component{component.NewText(`Certificate "CN=example.com" in Secret "secret" expired on 2019-01-02`)},
+			},
+		},
+		{
+			name: "missing certificate",
+			object: func(t *testing.T) runtime.Object {
+				secret := testutil.CreateSecret("secret")
+				secret.Type = corev1.SecretTypeTLS
+				return secret
+			},
+			expected: ObjectStatus{
+				nodeStatus: component.NodeStatusError,
+				Details:    []component.Component{component.NewText("Secret does not contain a certificate")},
+			},
+		},
+		{
+			name: "object is nil",
+			object: func(t *testing.T) runtime.Object {
+				return nil
+			},
+			isErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			o := storefake.NewMockStore(controller)
+
+			ctx := context.Background()
+			status, err := runSecretStatus(ctx, tc.object(t), o)
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expected, status)
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 type informerSynced struct {
 	status map[string]bool
+	keys   map[string]store.Key
 
 	mu sync.RWMutex
 }
@@ -17,6 +18,7 @@ type informerSynced struct {
 func initInformerSynced() *informerSynced {
 	return &informerSynced{
 		status: make(map[string]bool),
+		keys:   make(map[string]store.Key),
 	}
 }
 
@@ -25,6 +27,7 @@ func (c *informerSynced) setSynced(key store.Key, value bool) {
 	defer c.mu.Unlock()
 
 	c.status[key.String()] = value
+	c.keys[key.String()] = key
 }
 
 func (c *informerSynced) hasSynced(key store.Key) bool {
@@ -50,6 +53,19 @@ func (c *informerSynced) reset() {
 
 	for key := range c.status {
 		delete(c.status, key)
+		delete(c.keys, key)
+	}
+}
+
+func (c *informerSynced) deleteGroupVersionKind(groupVersionKind schema.GroupVersionKind) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for s, key := range c.keys {
+		if key.GroupVersionKind() == groupVersionKind {
+			delete(c.status, s)
+			delete(c.keys, s)
+		}
 	}
 }
 
@@ -159,6 +175,15 @@ func (c *seenGVKsCache) reset() {
 	}
 }
 
+func (c *seenGVKsCache) deleteGroupVersionKind(groupVersionKind schema.GroupVersionKind) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, seen := range c.seenGVKs {
+		delete(seen, groupVersionKind)
+	}
+}
+
 type informerContextCache struct {
 	cache map[schema.GroupVersionResource]chan struct{}
 
@@ -26,6 +26,10 @@ func Test_informerSynced(t *testing.T) {
 
 	c.setSynced(key, false)
 	require.False(t, c.hasSynced(key))
+
+	c.deleteGroupVersionKind(key.GroupVersionKind())
+	require.False(t, c.hasSeen(key))
+	require.True(t, c.hasSynced(key))
 }
 
 func Test_factoriesCache(t *testing.T) {
@@ -44,7 +48,8 @@ func Test_factoriesCache(t *testing.T) {
 	c := initFactoriesCache()
 
 	ctx := context.Background()
-	factory, err := initInformerFactory(ctx, client, namespaceName)
+	dc := &DynamicCache{transform: TrimObject}
+	factory, err := dc.initInformerFactory(ctx, client, namespaceName)
 	require.NoError(t, err)
 
 	c.set(namespaceName, factory)
@@ -98,6 +103,19 @@ func Test_seenGVKsCache(t *testing.T) {
 	}
 }
 
+func Test_seenGVKsCache_deleteGroupVersionKind(t *testing.T) {
+	c := initSeenGVKsCache()
+	c.setSeen("test", gvk.Pod, true)
+	c.setSeen("other", gvk.Pod, true)
+	c.setSeen("test", gvk.Deployment, true)
+
+	c.deleteGroupVersionKind(gvk.Pod)
+
+	require.False(t, c.hasSeen("test", gvk.Pod))
+	require.False(t, c.hasSeen("other", gvk.Pod))
+	require.True(t, c.hasSeen("test", gvk.Deployment))
+}
+
 func Test_informerContextCache(t *testing.T) {
 	c := initInformerContextCache()
 
@@ -0,0 +1,72 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"sort"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/informers"
+
+	"github.com/kubenext/lissio/pkg/store"
+)
+
+// CachedObjectLister lists the objects an object store has cached.
+type CachedObjectLister interface {
+	CachedObjects() []*unstructured.Unstructured
+}
+
+var _ CachedObjectLister = (*DynamicCache)(nil)
+
+// CachedObjects returns copies of the objects in the informers' caches. It
+// doesn't start informers. An object cached by more than one informer,
+// e.g. for a namespace and for all namespaces, is returned once. Objects
+// are sorted by api version, kind, namespace and name.
+func (dc *DynamicCache) CachedObjects() []*unstructured.Unstructured {
+	dc.usageMu.Lock()
+	var list []informers.GenericInformer
+	for _, usage := range dc.usage {
+		for informer := range usage.informers {
+			list = append(list, informer)
+		}
+	}
+	dc.usageMu.Unlock()
+
+	seen := make(map[store.Key]bool)
+	var objects []*unstructured.Unstructured
+	for _, informer := range list {
+		for _, item := range informer.Informer().GetStore().List() {
+			object, ok := item.(*unstructured.Unstructured)
+			if !ok {
+				continue
+			}
+
+			key, err := store.KeyFromObject(object)
+			if err != nil || seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			objects = append(objects, object.DeepCopy())
+		}
+	}
+
+	sort.Slice(objects, func(i, j int) bool {
+		a, b := objects[i], objects[j]
+		switch {
+		case a.GetAPIVersion() != b.GetAPIVersion():
+			return a.GetAPIVersion() < b.GetAPIVersion()
+		case a.GetKind() != b.GetKind():
+			return a.GetKind() < b.GetKind()
+		case a.GetNamespace() != b.GetNamespace():
+			return a.GetNamespace() < b.GetNamespace()
+		default:
+			return a.GetName() < b.GetName()
+		}
+	})
+
+	return objects
+}
@@ -0,0 +1,51 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
+
+	clusterFake "github.com/kubenext/lissio/internal/cluster/fake"
+	"github.com/kubenext/lissio/internal/gvk"
+	"github.com/kubenext/lissio/internal/testutil"
+)
+
+func TestDynamicCache_CachedObjects(t *testing.T) {
+	h := initDynamicCacheTestHarness(t)
+	defer h.finish()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c, err := h.factory(ctx)
+	require.NoError(t, err)
+
+	pod1 := testutil.ToUnstructured(t, testutil.CreatePod("pod1"))
+	pod2 := testutil.ToUnstructured(t, testutil.CreatePod("pod2"))
+
+	namespaceStore := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	require.NoError(t, namespaceStore.Add(pod2))
+	allStore := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	require.NoError(t, allStore.Add(pod1))
+	require.NoError(t, allStore.Add(pod2))
+
+	for _, s := range []cache.Store{namespaceStore, allStore} {
+		sharedInformer := clusterFake.NewMockSharedIndexInformer(h.controller)
+		sharedInformer.EXPECT().GetStore().Return(s)
+		informer := clusterFake.NewMockGenericInformer(h.controller)
+		informer.EXPECT().Informer().Return(sharedInformer)
+		c.markUsed("", gvk.Pod, informer)
+	}
+
+	expected := []*unstructured.Unstructured{pod1, pod2}
+	assert.Equal(t, expected, c.CachedObjects())
+}
@@ -10,7 +10,7 @@ import (
 	"github.com/kubenext/lissio/internal/log"
 )
 
-func initStatusCheck(stopCh <-chan struct{}, logger log.Logger, factories *factoriesCache) {
+func initStatusCheck(stopCh <-chan struct{}, logger log.Logger, stats func() []InformerStats) {
 	sigCh := make(chan os.Signal, 1)
 	signal.Notify(sigCh, syscall.SIGUSR2)
 
@@ -20,7 +20,17 @@ func initStatusCheck(stopCh <-chan struct{}, logger log.Logger, factories *facto
 		case <-stopCh:
 			done = true
 		case <-sigCh:
-			logger.With("factory-count", len(factories.factories)).Debugf("dynamic cache status")
+			for _, s := range stats() {
+				logger.With(
+					"apiVersion", s.APIVersion,
+					"kind", s.Kind,
+					"informers", s.Informers,
+					"objects", s.Objects,
+					"bytes", s.Bytes,
+					"lastUsed", s.LastUsed,
+					"pinned", s.Pinned,
+				).Debugf("dynamic cache status")
+			}
 		}
 	}
 
@@ -6,6 +6,6 @@ import (
 	"github.com/kubenext/lissio/internal/log"
 )
 
-func initStatusCheck(stopCh <-chan struct{}, logger log.Logger, factories *factoriesCache) {
+func initStatusCheck(stopCh <-chan struct{}, logger log.Logger, stats func() []InformerStats) {
 
 }
@@ -36,12 +36,12 @@ const (
 	initialInformerSyncTimeout = time.Second * 10
 )
 
-func initInformerFactory(ctx context.Context, client cluster.ClientInterface, namespace string) (InformerFactory, error) {
+func (dc *DynamicCache) initInformerFactory(ctx context.Context, client cluster.ClientInterface, namespace string) (InformerFactory, error) {
 	dynamicClient, err := client.DynamicClient()
 	if err != nil {
 		return nil, err
 	}
-	return newInformerFactory(ctx.Done(), dynamicClient, defaultInformerResync, namespace), nil
+	return newInformerFactory(ctx.Done(), dynamicClient, defaultInformerResync, namespace, dc.transform), nil
 }
 
 // DynamicCacheOpt is an option for configuration DynamicCache.
@@ -54,6 +54,40 @@ func Access(resourceAccess ResourceAccess) DynamicCacheOpt {
 	}
 }
 
+// Index sets an indexer which is fed every object held in the
+// DynamicCache's informers.
+func Index(indexer ObjectIndexer) DynamicCacheOpt {
+	return func(dc *DynamicCache) {
+		dc.indexer = indexer
+	}
+}
+
+// Transform sets the transform applied to objects before they are cached.
+// Objects are trimmed with TrimObject by default. A nil transform caches
+// objects as they are.
+func Transform(transform ObjectTransform) DynamicCacheOpt {
+	return func(dc *DynamicCache) {
+		dc.transform = transform
+	}
+}
+
+// EvictIdleInformers stops informers for group version kinds which haven't
+// been used for the idle duration. Informers which have handlers added by
+// Watch are only stopped if the handlers were added with an evictable
+// context. A zero duration keeps informers forever.
+func EvictIdleInformers(idle time.Duration) DynamicCacheOpt {
+	return func(dc *DynamicCache) {
+		dc.idleTimeout = idle
+	}
+}
+
+// ObjectIndexer indexes objects held in informers.
+type ObjectIndexer interface {
+	kcache.ResourceEventHandler
+	RemoveGroupKind(groupKind schema.GroupKind)
+	Reset()
+}
+
 // DynamicCache is a cache based on the dynamic shared informer factory.
 type DynamicCache struct {
 	initFactoryFunc func(context.Context, cluster.ClientInterface, string) (InformerFactory, error)
@@ -64,6 +98,15 @@ type DynamicCache struct {
 	access          ResourceAccess
 	updateFns       []store.UpdateFn
 	updateMu        sync.Mutex
+	indexer         ObjectIndexer
+	indexed         map[informers.GenericInformer]bool
+	indexMu         sync.Mutex
+	transform       ObjectTransform
+	idleTimeout     time.Duration
+	usage           map[schema.GroupVersionKind]*informerUsage
+	watches         map[schema.GroupVersionKind][]*watchRegistration
+	usageMu         sync.Mutex
+	nowFunc         func() time.Time
 
 	syncTimeoutFunc func(context.Context, store.Key, chan bool)
 	waitForSyncFunc func(context.Context, store.Key, *DynamicCache, informers.GenericInformer, chan bool)
@@ -97,13 +140,18 @@ var _ store.Store = (*DynamicCache)(nil)
 // NewDynamicCache creates an instance of DynamicCache.
 func NewDynamicCache(ctx context.Context, client cluster.ClientInterface, options ...DynamicCacheOpt) (*DynamicCache, error) {
 	c := &DynamicCache{
-		initFactoryFunc: initInformerFactory,
 		syncTimeoutFunc: syncTimeout,
 		waitForSyncFunc: waitForSync,
 		client:          client,
 		seenGVKs:        initSeenGVKsCache(),
 		informerSynced:  initInformerSynced(),
+		indexed:         make(map[informers.GenericInformer]bool),
+		transform:       TrimObject,
+		usage:           make(map[schema.GroupVersionKind]*informerUsage),
+		watches:         make(map[schema.GroupVersionKind][]*watchRegistration),
+		nowFunc:         time.Now,
 	}
+	c.initFactoryFunc = c.initInformerFactory
 
 	for _, option := range options {
 		option(c)
@@ -112,7 +160,11 @@ func NewDynamicCache(ctx context.Context, client cluster.ClientInterface, option
 	logger := log.From(ctx).With("component", "DynamicCache")
 
 	c.factories = initFactoriesCache()
-	go initStatusCheck(ctx.Done(), logger, c.factories)
+	go initStatusCheck(ctx.Done(), logger, c.Stats)
+
+	if c.idleTimeout > 0 {
+		go c.evictIdleInformers(ctx, c.idleTimeout)
+	}
 
 	factory, err := c.initFactoryFunc(context.Background(), client, "")
 	if err != nil {
@@ -162,12 +214,32 @@ func (dc *DynamicCache) currentInformer(ctx context.Context, key store.Key) (inf
 
 	informer := factory.ForResource(gvr)
 
+	dc.addToIndex(informer)
+	dc.markUsed(key.Namespace, gvk, informer)
 	dc.checkKeySynced(ctx, informer, key)
 	dc.seenGVKs.setSeen(key.Namespace, gvk, true)
 
 	return informer, dc.informerSynced.hasSynced(key), nil
 }
 
+// addToIndex feeds an informer's objects to the indexer. Informers are only
+// added once.
+func (dc *DynamicCache) addToIndex(informer informers.GenericInformer) {
+	if dc.indexer == nil {
+		return
+	}
+
+	dc.indexMu.Lock()
+	defer dc.indexMu.Unlock()
+
+	if dc.indexed[informer] {
+		return
+	}
+
+	informer.Informer().AddEventHandler(dc.indexer)
+	dc.indexed[informer] = true
+}
+
 func (dc *DynamicCache) checkKeySynced(ctx context.Context, informer informers.GenericInformer, key store.Key) {
 	dc.updateMu.Lock()
 	defer dc.updateMu.Unlock()
@@ -187,6 +259,8 @@ func (dc *DynamicCache) List(ctx context.Context, key store.Key) (*unstructured.
 	ctx, span := trace.StartSpan(ctx, "dynamicCache:list")
 	defer span.End()
 
+	store.RecordKey(ctx, key)
+
 	if err := dc.access.HasAccess(ctx, key, "list"); err != nil {
 		if meta.IsNoMatchError(err) {
 			return &unstructured.UnstructuredList{}, false, nil
@@ -203,6 +277,32 @@ func (dc *DynamicCache) List(ctx context.Context, key store.Key) (*unstructured.
 	return dc.listFromInformer(ctx, key)
 }
 
+var _ Warmer = (*DynamicCache)(nil)
+
+// Warm starts the informer for a key and waits until it has synced.
+func (dc *DynamicCache) Warm(ctx context.Context, key store.Key) error {
+	if err := dc.access.HasAccess(ctx, key, "list"); err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil
+		}
+		return errors.Wrapf(err, "list access forbidden to %+v", key)
+	}
+
+	// The informer and the check for it syncing outlive a canceled warm,
+	// so they don't use its context.
+	informerCtx := log.WithLoggerContext(context.Background(), log.From(ctx))
+	informer, _, err := dc.currentInformer(informerCtx, key)
+	if err != nil {
+		return errors.Wrapf(err, "retrieving informer for %+v", key)
+	}
+
+	if !kcache.WaitForCacheSync(ctx.Done(), informer.Informer().HasSynced) {
+		return ctx.Err()
+	}
+
+	return nil
+}
+
 func (dc *DynamicCache) listFromInformer(ctx context.Context, key store.Key) (*unstructured.UnstructuredList, bool, error) {
 	ctx, span := trace.StartSpan(ctx, "dynamicCache:list:informer")
 	defer span.End()
@@ -214,7 +314,13 @@ func (dc *DynamicCache) listFromInformer(ctx context.Context, key store.Key) (*u
 
 	if !hasSynced {
 		list, err := dc.listFromDynamicClient(ctx, key)
-		return list, false, err
+		if err != nil {
+			return nil, false, err
+		}
+		for i := range list.Items {
+			dc.transformObject(&list.Items[i])
+		}
+		return list, false, nil
 	}
 
 	var l lister
@@ -280,6 +386,8 @@ func (dc *DynamicCache) Get(ctx context.Context, key store.Key) (*unstructured.U
 	ctx, span := trace.StartSpan(ctx, "dynamicCacheGet")
 	defer span.End()
 
+	store.RecordKey(ctx, key)
+
 	if err := dc.access.HasAccess(ctx, key, "get"); err != nil {
 		return nil, false, errors.Wrapf(err, "get access forbidden to %+v", key)
 	}
@@ -315,7 +423,12 @@ func (dc *DynamicCache) getFromInformer(ctx context.Context, key store.Key) (*un
 	}
 
 	if !hasSynced {
-		return dc.getFromDynamicClient(ctx, key)
+		object, err := dc.getFromDynamicClient(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		dc.transformObject(object)
+		return object, nil
 	}
 
 	var g getter
@@ -365,6 +478,7 @@ func (dc *DynamicCache) Watch(ctx context.Context, key store.Key, handler kcache
 	}
 
 	informer.Informer().AddEventHandler(handler)
+	dc.addWatch(key.Namespace, key.GroupVersionKind(), handler, isEvictableWatch(ctx), informer)
 	return nil
 }
 
@@ -384,6 +498,24 @@ func (dc *DynamicCache) Unwatch(ctx context.Context, groupVersionKinds ...schema
 
 	}
 
+	for _, groupVersionKind := range groupVersionKinds {
+		informers := dc.forget(groupVersionKind)
+		dc.seenGVKs.deleteGroupVersionKind(groupVersionKind)
+		dc.informerSynced.deleteGroupVersionKind(groupVersionKind)
+
+		dc.indexMu.Lock()
+		for _, informer := range informers {
+			delete(dc.indexed, informer)
+		}
+		dc.indexMu.Unlock()
+	}
+
+	if dc.indexer != nil {
+		for _, groupVersionKind := range groupVersionKinds {
+			dc.indexer.RemoveGroupKind(groupVersionKind.GroupKind())
+		}
+	}
+
 	return nil
 }
 
@@ -420,6 +552,42 @@ func (dc *DynamicCache) Delete(ctx context.Context, key store.Key) error {
 	return dynamicClient.Resource(gvr).Namespace(key.Namespace).Delete(key.Name, deleteOptions)
 }
 
+// Create creates an object in the cluster. The created object is returned.
+func (dc *DynamicCache) Create(ctx context.Context, object *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	_, span := trace.StartSpan(ctx, "dynamicCache:create")
+	defer span.End()
+
+	if object == nil {
+		return nil, errors.New("can't create nil object")
+	}
+
+	key := store.Key{
+		Namespace:  object.GetNamespace(),
+		APIVersion: object.GetAPIVersion(),
+		Kind:       object.GetKind(),
+	}
+
+	if err := dc.access.HasAccess(ctx, key, "create"); err != nil {
+		return nil, errors.Wrapf(err, "create access forbidden to %+v", key)
+	}
+
+	dynamicClient, err := dc.client.DynamicClient()
+	if err != nil {
+		return nil, err
+	}
+
+	gvr, err := dc.client.Resource(key.GroupVersionKind().GroupKind())
+	if err != nil {
+		return nil, err
+	}
+
+	if key.Namespace == "" {
+		return dynamicClient.Resource(gvr).Create(object, metav1.CreateOptions{})
+	}
+
+	return dynamicClient.Resource(gvr).Namespace(key.Namespace).Create(object, metav1.CreateOptions{})
+}
+
 // UpdateClusterClient updates the cluster client.
 func (dc *DynamicCache) UpdateClusterClient(ctx context.Context, client cluster.ClientInterface) error {
 	logger := log.From(ctx)
@@ -434,6 +602,18 @@ func (dc *DynamicCache) UpdateClusterClient(ctx context.Context, client cluster.
 	dc.access.UpdateClient(client)
 	dc.updateMu.Unlock()
 
+	dc.indexMu.Lock()
+	dc.indexed = make(map[informers.GenericInformer]bool)
+	if dc.indexer != nil {
+		dc.indexer.Reset()
+	}
+	dc.indexMu.Unlock()
+
+	dc.usageMu.Lock()
+	dc.usage = make(map[schema.GroupVersionKind]*informerUsage)
+	dc.watches = make(map[schema.GroupVersionKind][]*watchRegistration)
+	dc.usageMu.Unlock()
+
 	for _, fn := range dc.updateFns {
 		fn(dc)
 	}
@@ -452,16 +632,21 @@ func (dc *DynamicCache) Update(ctx context.Context, key store.Key, updater func(
 		return errors.New("can't update object")
 	}
 
+	if err := dc.access.HasAccess(ctx, key, "get"); err != nil {
+		return errors.Wrapf(err, "get access forbidden to %+v", key)
+	}
+
 	err := kretry.RetryOnConflict(kretry.DefaultRetry, func() error {
-		object, found, err := dc.Get(ctx, key)
+		// Cached objects are transformed, so the object is retrieved from
+		// the cluster to avoid writing back removed fields.
+		object, err := dc.getFromDynamicClient(ctx, key)
 		if err != nil {
+			if kerrors.IsNotFound(err) {
+				return errors.Errorf("object not found")
+			}
 			return err
 		}
 
-		if !found {
-			return errors.Errorf("object not found")
-		}
-
 		gvk := object.GroupVersionKind()
 
 		gvr, err := dc.client.Resource(gvk.GroupKind())
@@ -487,6 +672,14 @@ func (dc *DynamicCache) Update(ctx context.Context, key store.Key, updater func(
 	return err
 }
 
+// transformObject applies the cache's transform to an object retrieved from
+// the dynamic client, so it matches objects from informers.
+func (dc *DynamicCache) transformObject(object *unstructured.Unstructured) {
+	if dc.transform != nil && object != nil {
+		dc.transform(object)
+	}
+}
+
 func (dc *DynamicCache) IsLoading(ctx context.Context, key store.Key) bool {
 	return !dc.informerSynced.hasSynced(key)
 }
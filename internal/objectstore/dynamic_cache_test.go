@@ -26,6 +26,7 @@ import (
 	"github.com/kubenext/lissio/internal/cluster"
 	clusterFake "github.com/kubenext/lissio/internal/cluster/fake"
 	"github.com/kubenext/lissio/internal/objectstore/fake"
+	"github.com/kubenext/lissio/internal/search"
 	"github.com/kubenext/lissio/internal/testutil"
 	"github.com/kubenext/lissio/pkg/store"
 )
@@ -101,6 +102,31 @@ func Test_DynamicCache_List(t *testing.T) {
 	assert.Equal(t, expected, got)
 }
 
+func TestDynamicCache_Warm(t *testing.T) {
+	h := initDynamicCacheTestHarness(t)
+	defer h.finish()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pod := testutil.CreatePod("pod")
+
+	sharedInformer := clusterFake.NewMockSharedIndexInformer(h.controller)
+	sharedInformer.EXPECT().HasSynced().Return(true).AnyTimes()
+	informer := h.informerFor(podGVR)
+	informer.EXPECT().Informer().Return(sharedInformer)
+
+	h.mapResources(pod.GroupVersionKind(), podGVR)
+
+	c, err := h.factory(ctx)
+	require.NoError(t, err)
+
+	key := h.keyFromObject(t, pod)
+	key.Name = ""
+
+	require.NoError(t, c.Warm(ctx, key))
+}
+
 func Test_DynamicCache_Get(t *testing.T) {
 	h := initDynamicCacheTestHarness(t)
 	defer h.finish()
@@ -137,18 +163,13 @@ func TestDynamicCache_Update(t *testing.T) {
 	defer cancel()
 
 	pod := testutil.ToUnstructured(t, testutil.CreatePod("pod"))
-
-	podInformer := h.informerFor(podGVR)
 	h.mapResources(pod.GroupVersionKind(), podGVR)
 
-	l := &fakeLister{getObject: pod}
-	podInformer.EXPECT().Lister().Return(l)
-
 	scheme := runtime.NewScheme()
 
 	dc := dynamicFake.NewSimpleDynamicClient(scheme, pod)
 
-	h.client.EXPECT().DynamicClient().Return(dc, nil)
+	h.client.EXPECT().DynamicClient().Return(dc, nil).Times(2)
 
 	c, err := h.factory(ctx)
 	require.NoError(t, err)
@@ -160,10 +181,11 @@ func TestDynamicCache_Update(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	assert.Len(t, dc.Actions(), 1)
-
-	action := dc.Actions()[0]
-	assert.Equal(t, "update", action.GetVerb())
+	// Cached objects are trimmed, so the object is retrieved from the
+	// cluster before it is updated.
+	require.Len(t, dc.Actions(), 2)
+	assert.Equal(t, "get", dc.Actions()[0].GetVerb())
+	assert.Equal(t, "update", dc.Actions()[1].GetVerb())
 }
 
 func TestDynamicCache_Delete(t *testing.T) {
@@ -204,6 +226,33 @@ func TestDynamicCache_Delete(t *testing.T) {
 	assert.Equal(t, expected, got)
 }
 
+func TestDynamicCache_Create(t *testing.T) {
+	h := initDynamicCacheTestHarness(t)
+	defer h.finish()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pod := testutil.ToUnstructured(t, testutil.CreatePod("pod"))
+	h.mapResources(pod.GroupVersionKind(), podGVR)
+
+	scheme := runtime.NewScheme()
+
+	dc := dynamicFake.NewSimpleDynamicClient(scheme)
+	h.client.EXPECT().DynamicClient().Return(dc, nil)
+
+	c, err := h.factory(ctx)
+	require.NoError(t, err)
+
+	got, err := c.Create(ctx, pod)
+	require.NoError(t, err)
+	assert.Equal(t, pod, got)
+
+	require.Len(t, dc.Actions(), 1)
+	assert.Equal(t, "create", dc.Actions()[0].GetVerb())
+	assert.Equal(t, pod.GetNamespace(), dc.Actions()[0].GetNamespace())
+}
+
 func TestDynamicCache_Unwatch(t *testing.T) {
 	h := initDynamicCacheTestHarness(t)
 	defer h.finish()
@@ -223,6 +272,46 @@ func TestDynamicCache_Unwatch(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestDynamicCache_Index(t *testing.T) {
+	h := initDynamicCacheTestHarness(t)
+	defer h.finish()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pod := testutil.CreatePod("pod")
+	h.mapResources(pod.GroupVersionKind(), podGVR)
+
+	index := search.NewIndex()
+
+	l := &fakeLister{getObject: testutil.ToUnstructured(t, pod)}
+	informer := h.informerFor(podGVR)
+	informer.EXPECT().Lister().Return(l).Times(2)
+	h.informerFactory.EXPECT().ForResource(podGVR).Return(informer)
+
+	sharedInformer := clusterFake.NewMockSharedIndexInformer(h.controller)
+	informer.EXPECT().Informer().Return(sharedInformer)
+	sharedInformer.EXPECT().AddEventHandler(index)
+
+	c, err := h.factory(ctx, Index(index))
+	require.NoError(t, err)
+
+	h.setSynced(t, c, pod)
+	key := h.keyFromObject(t, pod)
+
+	for i := 0; i < 2; i++ {
+		_, _, err = c.Get(ctx, key)
+		require.NoError(t, err)
+	}
+
+	index.Add(testutil.ToUnstructured(t, pod))
+	require.Equal(t, 1, index.Len())
+
+	h.informerFactory.EXPECT().Delete(podGVR).MinTimes(1)
+	require.NoError(t, c.Unwatch(ctx, pod.GroupVersionKind()))
+	assert.Equal(t, 0, index.Len())
+}
+
 type dynamicCacheTestHarness struct {
 	controller       *gomock.Controller
 	client           *clusterFake.MockClientInterface
@@ -265,7 +354,7 @@ func (h *dynamicCacheTestHarness) finish() {
 	h.controller.Finish()
 }
 
-func (h *dynamicCacheTestHarness) factory(ctx context.Context) (*DynamicCache, error) {
+func (h *dynamicCacheTestHarness) factory(ctx context.Context, options ...DynamicCacheOpt) (*DynamicCache, error) {
 	factoryFunc := func(c *DynamicCache) {
 		c.initFactoryFunc = func(i context.Context, clientInterface cluster.ClientInterface, s string) (factory InformerFactory, e error) {
 			return h.informerFactory, nil
@@ -277,7 +366,8 @@ func (h *dynamicCacheTestHarness) factory(ctx context.Context) (*DynamicCache, e
 	}
 
 	resourceAccess := NewResourceAccess(h.client)
-	return NewDynamicCache(ctx, h.client, factoryFunc, Access(resourceAccess))
+	options = append([]DynamicCacheOpt{factoryFunc, Access(resourceAccess)}, options...)
+	return NewDynamicCache(ctx, h.client, options...)
 }
 
 func (h *dynamicCacheTestHarness) informerFor(gvr schema.GroupVersionResource) *clusterFake.MockGenericInformer {
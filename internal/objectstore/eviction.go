@@ -0,0 +1,202 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/informers"
+	kcache "k8s.io/client-go/tools/cache"
+
+	"github.com/kubenext/lissio/internal/log"
+)
+
+const (
+	// maxEvictionInterval is the longest time between checks for idle
+	// informers.
+	maxEvictionInterval = time.Minute
+)
+
+type evictableWatchKey struct{}
+
+// WithEvictableWatch marks handlers passed to Watch with the returned
+// context as evictable. Informers with evictable handlers can be stopped
+// when they are idle. The handlers are added again when the informer is
+// recreated.
+func WithEvictableWatch(ctx context.Context) context.Context {
+	return context.WithValue(ctx, evictableWatchKey{}, true)
+}
+
+func isEvictableWatch(ctx context.Context) bool {
+	evictable, _ := ctx.Value(evictableWatchKey{}).(bool)
+	return evictable
+}
+
+// informerUsage tracks the informers for a group version kind and when
+// they were last used.
+type informerUsage struct {
+	lastUsed  time.Time
+	informers map[informers.GenericInformer]bool
+}
+
+// watchRegistration is a handler added to informers with Watch.
+type watchRegistration struct {
+	namespace string
+	handler   kcache.ResourceEventHandler
+	evictable bool
+	added     map[informers.GenericInformer]bool
+}
+
+// markUsed records that an informer for a group version kind was used.
+// Evictable handlers which haven't been added to the informer, because it
+// was recreated after being evicted, are added.
+func (dc *DynamicCache) markUsed(namespace string, groupVersionKind schema.GroupVersionKind, informer informers.GenericInformer) {
+	dc.usageMu.Lock()
+	defer dc.usageMu.Unlock()
+
+	usage, ok := dc.usage[groupVersionKind]
+	if !ok {
+		usage = &informerUsage{
+			informers: make(map[informers.GenericInformer]bool),
+		}
+		dc.usage[groupVersionKind] = usage
+	}
+
+	usage.lastUsed = dc.nowFunc()
+	usage.informers[informer] = true
+
+	for _, registration := range dc.watches[groupVersionKind] {
+		if !registration.evictable || registration.namespace != namespace || registration.added[informer] {
+			continue
+		}
+
+		informer.Informer().AddEventHandler(registration.handler)
+		registration.added[informer] = true
+	}
+}
+
+// addWatch records a handler added to an informer.
+func (dc *DynamicCache) addWatch(namespace string, groupVersionKind schema.GroupVersionKind, handler kcache.ResourceEventHandler, evictable bool, informer informers.GenericInformer) {
+	dc.usageMu.Lock()
+	defer dc.usageMu.Unlock()
+
+	registration := &watchRegistration{
+		namespace: namespace,
+		handler:   handler,
+		evictable: evictable,
+		added: map[informers.GenericInformer]bool{
+			informer: true,
+		},
+	}
+
+	dc.watches[groupVersionKind] = append(dc.watches[groupVersionKind], registration)
+}
+
+// forget removes the usage for a group version kind and returns its
+// informers. Evictable handlers are kept so they can be added to new
+// informers.
+func (dc *DynamicCache) forget(groupVersionKind schema.GroupVersionKind) []informers.GenericInformer {
+	dc.usageMu.Lock()
+	defer dc.usageMu.Unlock()
+
+	var list []informers.GenericInformer
+	if usage, ok := dc.usage[groupVersionKind]; ok {
+		for informer := range usage.informers {
+			list = append(list, informer)
+		}
+		delete(dc.usage, groupVersionKind)
+	}
+
+	var registrations []*watchRegistration
+	for _, registration := range dc.watches[groupVersionKind] {
+		if !registration.evictable {
+			continue
+		}
+
+		registration.added = make(map[informers.GenericInformer]bool)
+		registrations = append(registrations, registration)
+	}
+
+	if len(registrations) == 0 {
+		delete(dc.watches, groupVersionKind)
+	} else {
+		dc.watches[groupVersionKind] = registrations
+	}
+
+	return list
+}
+
+// isPinned returns true if a group version kind has handlers which can't
+// be evicted. The caller must hold the usage lock.
+func (dc *DynamicCache) isPinned(groupVersionKind schema.GroupVersionKind) bool {
+	for _, registration := range dc.watches[groupVersionKind] {
+		if !registration.evictable {
+			return true
+		}
+	}
+
+	return false
+}
+
+// idleGroupVersionKinds returns the group version kinds which can be
+// evicted and haven't been used for the idle duration.
+func (dc *DynamicCache) idleGroupVersionKinds(idle time.Duration) []schema.GroupVersionKind {
+	dc.usageMu.Lock()
+	defer dc.usageMu.Unlock()
+
+	now := dc.nowFunc()
+
+	var list []schema.GroupVersionKind
+	for groupVersionKind, usage := range dc.usage {
+		if now.Sub(usage.lastUsed) < idle || dc.isPinned(groupVersionKind) {
+			continue
+		}
+		list = append(list, groupVersionKind)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].String() < list[j].String()
+	})
+
+	return list
+}
+
+// evictIdle stops informers which haven't been used for the idle duration.
+func (dc *DynamicCache) evictIdle(ctx context.Context, idle time.Duration) error {
+	list := dc.idleGroupVersionKinds(idle)
+	if len(list) == 0 {
+		return nil
+	}
+
+	log.From(ctx).With("groupVersionKinds", list).Debugf("evicting idle informers")
+	return dc.Unwatch(ctx, list...)
+}
+
+// evictIdleInformers periodically evicts idle informers until the context
+// is canceled.
+func (dc *DynamicCache) evictIdleInformers(ctx context.Context, idle time.Duration) {
+	interval := idle
+	if interval > maxEvictionInterval {
+		interval = maxEvictionInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := dc.evictIdle(ctx, idle); err != nil {
+				log.From(ctx).WithErr(err).Errorf("evict idle informers")
+			}
+		}
+	}
+}
@@ -0,0 +1,119 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/cache"
+
+	clusterFake "github.com/kubenext/lissio/internal/cluster/fake"
+	"github.com/kubenext/lissio/internal/gvk"
+	"github.com/kubenext/lissio/pkg/store"
+)
+
+func TestDynamicCache_evictIdle(t *testing.T) {
+	h := initDynamicCacheTestHarness(t)
+	defer h.finish()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	h.mapResources(gvk.Pod, podGVR)
+
+	c, err := h.factory(ctx)
+	require.NoError(t, err)
+
+	now := time.Unix(1570000000, 0)
+	c.nowFunc = func() time.Time {
+		return now
+	}
+
+	key := store.Key{Namespace: "test", APIVersion: "v1", Kind: "Pod"}
+	c.informerSynced.setSynced(key, true)
+	c.seenGVKs.setSeen(key.Namespace, gvk.Pod, true)
+
+	informer := clusterFake.NewMockGenericInformer(h.controller)
+	c.markUsed(key.Namespace, gvk.Pod, informer)
+
+	now = now.Add(time.Minute)
+	require.NoError(t, c.evictIdle(ctx, 2*time.Minute))
+	require.Len(t, c.usage, 1)
+
+	now = now.Add(time.Minute)
+	h.informerFactory.EXPECT().Delete(podGVR)
+	require.NoError(t, c.evictIdle(ctx, 2*time.Minute))
+
+	assert.Empty(t, c.usage)
+	assert.False(t, c.informerSynced.hasSeen(key))
+	assert.False(t, c.seenGVKs.hasSeen(key.Namespace, gvk.Pod))
+}
+
+func TestDynamicCache_evictIdle_pinned(t *testing.T) {
+	h := initDynamicCacheTestHarness(t)
+	defer h.finish()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c, err := h.factory(ctx)
+	require.NoError(t, err)
+
+	now := time.Unix(1570000000, 0)
+	c.nowFunc = func() time.Time {
+		return now
+	}
+
+	informer := clusterFake.NewMockGenericInformer(h.controller)
+	c.markUsed("test", gvk.Pod, informer)
+	c.addWatch("test", gvk.Pod, cache.ResourceEventHandlerFuncs{}, false, informer)
+
+	now = now.Add(time.Hour)
+	require.NoError(t, c.evictIdle(ctx, time.Minute))
+	require.Len(t, c.usage, 1)
+}
+
+func TestDynamicCache_evictIdle_evictableWatch(t *testing.T) {
+	h := initDynamicCacheTestHarness(t)
+	defer h.finish()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	h.mapResources(gvk.Pod, podGVR)
+
+	c, err := h.factory(ctx)
+	require.NoError(t, err)
+
+	now := time.Unix(1570000000, 0)
+	c.nowFunc = func() time.Time {
+		return now
+	}
+
+	handler := &cache.ResourceEventHandlerFuncs{}
+
+	informer := clusterFake.NewMockGenericInformer(h.controller)
+	c.markUsed("test", gvk.Pod, informer)
+	c.addWatch("test", gvk.Pod, handler, isEvictableWatch(WithEvictableWatch(ctx)), informer)
+
+	now = now.Add(time.Hour)
+	h.informerFactory.EXPECT().Delete(podGVR)
+	require.NoError(t, c.evictIdle(ctx, time.Minute))
+	require.Empty(t, c.usage)
+
+	// The handler is added to the recreated informer once.
+	sharedInformer := clusterFake.NewMockSharedIndexInformer(h.controller)
+	sharedInformer.EXPECT().AddEventHandler(handler)
+	recreated := clusterFake.NewMockGenericInformer(h.controller)
+	recreated.EXPECT().Informer().Return(sharedInformer)
+
+	c.markUsed("test", gvk.Pod, recreated)
+	c.markUsed("test", gvk.Pod, recreated)
+}
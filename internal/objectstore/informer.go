@@ -6,7 +6,6 @@ import (
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
-	"k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/tools/cache"
 )
@@ -27,20 +26,21 @@ type informerFactory struct {
 
 	lock                 sync.Mutex
 	informers            map[schema.GroupVersionResource]informers.GenericInformer
-	tweakListOptions     dynamicinformer.TweakListOptionsFunc
+	transform            ObjectTransform
 	stopCh               <-chan struct{}
 	informerContextCache *informerContextCache
 }
 
 var _ InformerFactory = (*informerFactory)(nil)
 
-func newInformerFactory(stopCh <-chan struct{}, client dynamic.Interface, defaultResync time.Duration, namespace string) *informerFactory {
+func newInformerFactory(stopCh <-chan struct{}, client dynamic.Interface, defaultResync time.Duration, namespace string, transform ObjectTransform) *informerFactory {
 	return &informerFactory{
 		stopCh:               stopCh,
 		client:               client,
 		defaultResync:        defaultResync,
 		namespace:            namespace,
 		informers:            map[schema.GroupVersionResource]informers.GenericInformer{},
+		transform:            transform,
 		informerContextCache: initInformerContextCache(),
 	}
 }
@@ -56,7 +56,7 @@ func (f *informerFactory) ForResource(gvr schema.GroupVersionResource) informers
 		return informer
 	}
 
-	informer = dynamicinformer.NewFilteredDynamicInformer(f.client, gvr, f.namespace, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+	informer = newDynamicInformer(f.client, gvr, f.namespace, f.defaultResync, f.transform)
 	f.informers[key] = informer
 
 	stopCh := f.informerContextCache.addChild(gvr)
@@ -73,7 +73,6 @@ func (f *informerFactory) Delete(gvr schema.GroupVersionResource) {
 	if _, ok := f.informers[gvr]; ok {
 		f.informerContextCache.delete(gvr)
 		delete(f.informers, gvr)
-		f.informers[gvr] = nil
 	}
 }
 
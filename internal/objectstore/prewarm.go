@@ -0,0 +1,188 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"context"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kubenext/lissio/internal/log"
+	"github.com/kubenext/lissio/pkg/store"
+)
+
+const (
+	// DefaultPrewarmConcurrency is the number of informers prewarmed at once.
+	DefaultPrewarmConcurrency = 4
+)
+
+// Warmer starts caching the objects described by a key and waits until
+// they are cached.
+type Warmer interface {
+	Warm(ctx context.Context, key store.Key) error
+}
+
+// PrewarmProgress describes how far a prewarm has got.
+type PrewarmProgress struct {
+	Namespace string `json:"namespace"`
+	// Total is the number of group version kinds being prewarmed.
+	Total int `json:"total"`
+	// Done is the number of group version kinds which are cached or
+	// couldn't be cached.
+	Done int `json:"done"`
+	// Failed is the number of group version kinds which couldn't be
+	// cached, e.g. because they can't be listed.
+	Failed int `json:"failed"`
+}
+
+// Finished returns true if every group version kind has been prewarmed.
+func (p PrewarmProgress) Finished() bool {
+	return p.Done >= p.Total
+}
+
+// PrewarmProgressFunc is called as a prewarm progresses.
+type PrewarmProgressFunc func(progress PrewarmProgress)
+
+// Prewarmer starts informers for a namespace before they are viewed, so
+// switching namespaces doesn't wait for each page's informers to start.
+// Only one prewarm runs at a time. Starting a prewarm cancels the previous
+// one.
+type Prewarmer struct {
+	warmer      Warmer
+	concurrency int
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+}
+
+// NewPrewarmer creates an instance of Prewarmer. At most concurrency
+// informers are started at once.
+func NewPrewarmer(warmer Warmer, concurrency int) *Prewarmer {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	return &Prewarmer{
+		warmer:      warmer,
+		concurrency: concurrency,
+	}
+}
+
+// Prewarm warms the group version kinds in a namespace in the background.
+// Group version kinds in priority are warmed first. progress is called
+// when the prewarm starts and after each group version kind is warmed.
+func (p *Prewarmer) Prewarm(ctx context.Context, namespace string, priority, groupVersionKinds []schema.GroupVersionKind, progress PrewarmProgressFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	p.mu.Lock()
+	if p.cancel != nil {
+		p.cancel()
+	}
+	p.cancel = cancel
+	p.mu.Unlock()
+
+	keys := prewarmKeys(namespace, priority, groupVersionKinds)
+	go p.run(ctx, namespace, keys, progress)
+}
+
+// Stop cancels the prewarm in progress.
+func (p *Prewarmer) Stop() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.cancel != nil {
+		p.cancel()
+		p.cancel = nil
+	}
+}
+
+func (p *Prewarmer) run(ctx context.Context, namespace string, keys []store.Key, progress PrewarmProgressFunc) {
+	logger := log.From(ctx).With("component", "prewarmer", "namespace", namespace)
+
+	current := PrewarmProgress{
+		Namespace: namespace,
+		Total:     len(keys),
+	}
+
+	var mu sync.Mutex
+	report := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		current.Done++
+		if err != nil {
+			current.Failed++
+		}
+
+		if progress != nil {
+			progress(current)
+		}
+	}
+
+	if progress != nil {
+		progress(current)
+	}
+
+	queue := make(chan store.Key)
+	var wg sync.WaitGroup
+	for i := 0; i < p.concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for key := range queue {
+				err := p.warmer.Warm(ctx, key)
+				if ctx.Err() != nil {
+					// The prewarm was canceled. Progress is no longer reported.
+					continue
+				}
+
+				if err != nil {
+					logger.With("key", key).WithErr(err).Debugf("unable to prewarm")
+				}
+				report(err)
+			}
+		}()
+	}
+
+	for _, key := range keys {
+		if ctx.Err() != nil {
+			break
+		}
+		select {
+		case queue <- key:
+		case <-ctx.Done():
+		}
+	}
+	close(queue)
+	wg.Wait()
+
+	if ctx.Err() == nil {
+		logger.With("kinds", current.Total, "failed", current.Failed).Debugf("prewarmed namespace")
+	}
+}
+
+// prewarmKeys returns the keys for the group version kinds in a namespace
+// with priority ones first. Each group version kind is included once.
+func prewarmKeys(namespace string, priority, groupVersionKinds []schema.GroupVersionKind) []store.Key {
+	seen := make(map[schema.GroupVersionKind]bool)
+
+	var keys []store.Key
+	for _, list := range [][]schema.GroupVersionKind{priority, groupVersionKinds} {
+		for _, groupVersionKind := range list {
+			if seen[groupVersionKind] {
+				continue
+			}
+			seen[groupVersionKind] = true
+
+			key := store.KeyFromGroupVersionKind(groupVersionKind)
+			key.Namespace = namespace
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
@@ -0,0 +1,142 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kubenext/lissio/internal/gvk"
+	"github.com/kubenext/lissio/pkg/store"
+)
+
+type fakeWarmer struct {
+	mu       sync.Mutex
+	warmed   []store.Key
+	active   int
+	maxActive int
+	block    chan struct{}
+	err      map[string]error
+}
+
+func (w *fakeWarmer) Warm(ctx context.Context, key store.Key) error {
+	w.mu.Lock()
+	w.warmed = append(w.warmed, key)
+	w.active++
+	if w.active > w.maxActive {
+		w.maxActive = w.active
+	}
+	w.mu.Unlock()
+
+	defer func() {
+		w.mu.Lock()
+		w.active--
+		w.mu.Unlock()
+	}()
+
+	if w.block != nil {
+		select {
+		case <-w.block:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return w.err[key.Kind]
+}
+
+func waitForPrewarm(t *testing.T, ch <-chan PrewarmProgress) PrewarmProgress {
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case progress := <-ch:
+			if progress.Finished() {
+				return progress
+			}
+		case <-timeout:
+			require.FailNow(t, "prewarm didn't finish")
+		}
+	}
+}
+
+func TestPrewarmer_Prewarm(t *testing.T) {
+	warmer := &fakeWarmer{
+		err: map[string]error{"Secret": errors.New("forbidden")},
+	}
+	p := NewPrewarmer(warmer, 1)
+
+	ch := make(chan PrewarmProgress, 10)
+	priority := []schema.GroupVersionKind{gvk.Deployment}
+	groupVersionKinds := []schema.GroupVersionKind{gvk.Pod, gvk.Deployment, gvk.Secret}
+	p.Prewarm(context.Background(), "default", priority, groupVersionKinds, func(progress PrewarmProgress) {
+		ch <- progress
+	})
+
+	got := waitForPrewarm(t, ch)
+	expected := PrewarmProgress{Namespace: "default", Total: 3, Done: 3, Failed: 1}
+	assert.Equal(t, expected, got)
+
+	var kinds []string
+	for _, key := range warmer.warmed {
+		assert.Equal(t, "default", key.Namespace)
+		kinds = append(kinds, key.Kind)
+	}
+	assert.Equal(t, []string{"Deployment", "Pod", "Secret"}, kinds)
+}
+
+func TestPrewarmer_Prewarm_concurrency(t *testing.T) {
+	warmer := &fakeWarmer{block: make(chan struct{})}
+	p := NewPrewarmer(warmer, 2)
+
+	ch := make(chan PrewarmProgress, 10)
+	groupVersionKinds := []schema.GroupVersionKind{gvk.Pod, gvk.Deployment, gvk.Secret, gvk.Service}
+	p.Prewarm(context.Background(), "default", nil, groupVersionKinds, func(progress PrewarmProgress) {
+		ch <- progress
+	})
+
+	close(warmer.block)
+	waitForPrewarm(t, ch)
+
+	assert.Len(t, warmer.warmed, 4)
+	assert.True(t, warmer.maxActive <= 2, "warmed %d at once", warmer.maxActive)
+}
+
+func TestPrewarmer_Prewarm_cancelsPrevious(t *testing.T) {
+	warmer := &fakeWarmer{block: make(chan struct{})}
+	p := NewPrewarmer(warmer, 1)
+
+	var mu sync.Mutex
+	var canceled []PrewarmProgress
+	p.Prewarm(context.Background(), "old", nil, []schema.GroupVersionKind{gvk.Pod, gvk.Deployment}, func(progress PrewarmProgress) {
+		mu.Lock()
+		defer mu.Unlock()
+		canceled = append(canceled, progress)
+	})
+
+	ch := make(chan PrewarmProgress, 10)
+	p.Prewarm(context.Background(), "new", nil, []schema.GroupVersionKind{gvk.Pod}, func(progress PrewarmProgress) {
+		ch <- progress
+	})
+	close(warmer.block)
+
+	got := waitForPrewarm(t, ch)
+	assert.Equal(t, "new", got.Namespace)
+
+	mu.Lock()
+	defer mu.Unlock()
+	for _, progress := range canceled {
+		assert.False(t, progress.Finished())
+	}
+
+	p.Stop()
+}
@@ -0,0 +1,100 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/informers"
+)
+
+// InformerStats describes the objects cached for a group version kind.
+type InformerStats struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	// Informers is the number of informers for the group version kind.
+	Informers int `json:"informers"`
+	// Objects is the number of cached objects.
+	Objects int `json:"objects"`
+	// Bytes is the approximate size of the cached objects. It is the
+	// size of the objects encoded as JSON.
+	Bytes int `json:"bytes"`
+	// LastUsed is when the informers were last used.
+	LastUsed time.Time `json:"lastUsed"`
+	// Pinned is true if the informers are never evicted.
+	Pinned bool `json:"pinned"`
+}
+
+// StatsReporter reports informer stats.
+type StatsReporter interface {
+	Stats() []InformerStats
+}
+
+var _ StatsReporter = (*DynamicCache)(nil)
+
+// Stats returns stats for each group version kind with informers. The
+// stats are sorted by size with the largest first.
+func (dc *DynamicCache) Stats() []InformerStats {
+	type usageSnapshot struct {
+		groupVersionKind schema.GroupVersionKind
+		lastUsed         time.Time
+		pinned           bool
+		informers        []informers.GenericInformer
+	}
+
+	dc.usageMu.Lock()
+	var snapshots []usageSnapshot
+	for groupVersionKind, usage := range dc.usage {
+		snapshot := usageSnapshot{
+			groupVersionKind: groupVersionKind,
+			lastUsed:         usage.lastUsed,
+			pinned:           dc.isPinned(groupVersionKind),
+		}
+		for informer := range usage.informers {
+			snapshot.informers = append(snapshot.informers, informer)
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	dc.usageMu.Unlock()
+
+	var list []InformerStats
+	for _, snapshot := range snapshots {
+		apiVersion, kind := snapshot.groupVersionKind.ToAPIVersionAndKind()
+		stats := InformerStats{
+			APIVersion: apiVersion,
+			Kind:       kind,
+			Informers:  len(snapshot.informers),
+			LastUsed:   snapshot.lastUsed,
+			Pinned:     snapshot.pinned,
+		}
+
+		for _, informer := range snapshot.informers {
+			for _, object := range informer.Informer().GetStore().List() {
+				stats.Objects++
+				if data, err := json.Marshal(object); err == nil {
+					stats.Bytes += len(data)
+				}
+			}
+		}
+
+		list = append(list, stats)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Bytes != list[j].Bytes {
+			return list[i].Bytes > list[j].Bytes
+		}
+		if list[i].APIVersion != list[j].APIVersion {
+			return list[i].APIVersion < list[j].APIVersion
+		}
+		return list[i].Kind < list[j].Kind
+	})
+
+	return list
+}
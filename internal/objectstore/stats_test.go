@@ -0,0 +1,65 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/cache"
+
+	clusterFake "github.com/kubenext/lissio/internal/cluster/fake"
+	"github.com/kubenext/lissio/internal/gvk"
+	"github.com/kubenext/lissio/internal/testutil"
+)
+
+func TestDynamicCache_Stats(t *testing.T) {
+	h := initDynamicCacheTestHarness(t)
+	defer h.finish()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c, err := h.factory(ctx)
+	require.NoError(t, err)
+
+	now := time.Unix(1570000000, 0)
+	c.nowFunc = func() time.Time {
+		return now
+	}
+
+	pod := testutil.ToUnstructured(t, testutil.CreatePod("pod"))
+	podStore := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	require.NoError(t, podStore.Add(pod))
+
+	sharedInformer := clusterFake.NewMockSharedIndexInformer(h.controller)
+	sharedInformer.EXPECT().GetStore().Return(podStore)
+	informer := clusterFake.NewMockGenericInformer(h.controller)
+	informer.EXPECT().Informer().Return(sharedInformer)
+
+	c.markUsed("test", gvk.Pod, informer)
+	c.addWatch("test", gvk.Pod, cache.ResourceEventHandlerFuncs{}, false, informer)
+
+	data, err := json.Marshal(pod)
+	require.NoError(t, err)
+
+	expected := []InformerStats{
+		{
+			APIVersion: "v1",
+			Kind:       "Pod",
+			Informers:  1,
+			Objects:    1,
+			Bytes:      len(data),
+			LastUsed:   now,
+			Pinned:     true,
+		},
+	}
+	assert.Equal(t, expected, c.Stats())
+}
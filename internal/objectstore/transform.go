@@ -0,0 +1,101 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	kruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamiclister"
+	"k8s.io/client-go/informers"
+	kcache "k8s.io/client-go/tools/cache"
+)
+
+const (
+	// lastAppliedConfigAnnotation is the annotation kubectl apply stores
+	// the applied object in.
+	lastAppliedConfigAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+)
+
+// ObjectTransform changes objects before they are cached by informers.
+type ObjectTransform func(object *unstructured.Unstructured)
+
+// TrimObject removes fields which use a lot of memory and aren't shown
+// from an object: managed fields and the last applied configuration.
+func TrimObject(object *unstructured.Unstructured) {
+	unstructured.RemoveNestedField(object.Object, "metadata", "managedFields")
+
+	annotations := object.GetAnnotations()
+	if _, ok := annotations[lastAppliedConfigAnnotation]; ok {
+		delete(annotations, lastAppliedConfigAnnotation)
+		object.SetAnnotations(annotations)
+	}
+}
+
+// newDynamicInformer creates an informer for a dynamic type. Objects are
+// transformed before they are cached.
+func newDynamicInformer(client dynamic.Interface, gvr schema.GroupVersionResource, namespace string, resync time.Duration, transform ObjectTransform) informers.GenericInformer {
+	listWatch := &kcache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (kruntime.Object, error) {
+			list, err := client.Resource(gvr).Namespace(namespace).List(options)
+			if err != nil {
+				return nil, err
+			}
+
+			if transform != nil {
+				for i := range list.Items {
+					transform(&list.Items[i])
+				}
+			}
+
+			return list, nil
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			w, err := client.Resource(gvr).Namespace(namespace).Watch(options)
+			if err != nil || transform == nil {
+				return w, err
+			}
+
+			return watch.Filter(w, func(event watch.Event) (watch.Event, bool) {
+				if object, ok := event.Object.(*unstructured.Unstructured); ok {
+					transform(object)
+				}
+				return event, true
+			}), nil
+		},
+	}
+
+	informer := kcache.NewSharedIndexInformer(
+		listWatch,
+		&unstructured.Unstructured{},
+		resync,
+		kcache.Indexers{kcache.NamespaceIndex: kcache.MetaNamespaceIndexFunc})
+
+	return &dynamicInformer{
+		informer: informer,
+		gvr:      gvr,
+	}
+}
+
+type dynamicInformer struct {
+	informer kcache.SharedIndexInformer
+	gvr      schema.GroupVersionResource
+}
+
+var _ informers.GenericInformer = (*dynamicInformer)(nil)
+
+func (d *dynamicInformer) Informer() kcache.SharedIndexInformer {
+	return d.informer
+}
+
+func (d *dynamicInformer) Lister() kcache.GenericLister {
+	return dynamiclister.NewRuntimeObjectShim(dynamiclister.New(d.informer.GetIndexer(), d.gvr))
+}
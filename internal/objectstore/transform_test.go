@@ -0,0 +1,41 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestTrimObject(t *testing.T) {
+	object := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"metadata": map[string]interface{}{
+				"name": "pod",
+				"annotations": map[string]interface{}{
+					lastAppliedConfigAnnotation: "{}",
+					"other":                     "value",
+				},
+				"managedFields": []interface{}{
+					map[string]interface{}{"manager": "kubectl"},
+				},
+			},
+		},
+	}
+
+	TrimObject(object)
+
+	_, found, err := unstructured.NestedFieldNoCopy(object.Object, "metadata", "managedFields")
+	require.NoError(t, err)
+	assert.False(t, found)
+	assert.Equal(t, map[string]string{"other": "value"}, object.GetAnnotations())
+	assert.Equal(t, "pod", object.GetName())
+}
@@ -0,0 +1,212 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	kcache "k8s.io/client-go/tools/cache"
+
+	"github.com/kubenext/lissio/internal/log"
+	"github.com/kubenext/lissio/pkg/store"
+)
+
+// Watcher notifies subscribers when objects in a store change. Handlers
+// can't be removed from informers, so Watcher adds one handler for each
+// namespace and group version kind and forwards its events to the current
+// subscribers.
+type Watcher struct {
+	objectStore store.Store
+	logger      log.Logger
+	registered  map[store.Key]bool
+	subscribers map[store.Key]map[int]func()
+	nextID      int
+
+	// registerMu serializes adding handlers to the store. mu isn't held
+	// while a handler is added because stores can call the handler before
+	// Watch returns, e.g. with the objects they already have, and the
+	// handler takes mu.
+	registerMu sync.Mutex
+	mu         sync.Mutex
+}
+
+// NewWatcher creates an instance of Watcher.
+func NewWatcher(objectStore store.Store, logger log.Logger) *Watcher {
+	w := &Watcher{
+		objectStore: objectStore,
+		logger:      logger,
+		registered:  make(map[store.Key]bool),
+		subscribers: make(map[store.Key]map[int]func()),
+	}
+
+	objectStore.RegisterOnUpdate(w.reset)
+
+	return w
+}
+
+// Subscribe calls fn when an object matching the key's namespace and group
+// version kind is added, updated or deleted. fn is called from the
+// store's event handler, so it must not block. The returned function
+// cancels the subscription.
+func (w *Watcher) Subscribe(ctx context.Context, key store.Key, fn func()) (func(), error) {
+	if fn == nil {
+		return nil, errors.New("subscriber is nil")
+	}
+
+	key = watchKey(key)
+
+	w.registerMu.Lock()
+	defer w.registerMu.Unlock()
+
+	w.mu.Lock()
+	registered := w.registered[key]
+	w.mu.Unlock()
+
+	if !registered {
+		if err := w.register(key); err != nil {
+			return nil, err
+		}
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.subscribers[key] == nil {
+		w.subscribers[key] = make(map[int]func())
+	}
+
+	id := w.nextID
+	w.nextID++
+	w.subscribers[key][id] = fn
+
+	cancel := func() {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+
+		delete(w.subscribers[key], id)
+		if len(w.subscribers[key]) == 0 {
+			delete(w.subscribers, key)
+		}
+	}
+
+	return cancel, nil
+}
+
+// register adds a handler for a key to the store. The caller must hold
+// registerMu and must not hold mu.
+func (w *Watcher) register(key store.Key) error {
+	// The handler outlives the subscriber which caused it to be added,
+	// so it isn't tied to the subscriber's context. Subscribers view the
+	// objects they watch, so the store may evict the informer once they
+	// stop and add the handler again when it is recreated.
+	ctx := WithEvictableWatch(log.WithLoggerContext(context.Background(), w.logger))
+
+	w.mu.Lock()
+	objectStore := w.objectStore
+	w.mu.Unlock()
+
+	if err := objectStore.Watch(ctx, key, w.handler(key)); err != nil {
+		return errors.Wrapf(err, "watch %s", key)
+	}
+
+	w.mu.Lock()
+	w.registered[key] = true
+	w.mu.Unlock()
+
+	return nil
+}
+
+func (w *Watcher) handler(key store.Key) kcache.ResourceEventHandler {
+	return kcache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			w.notify(key, obj)
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldObject, ok := oldObj.(*unstructured.Unstructured)
+			newObject, ok2 := newObj.(*unstructured.Unstructured)
+			if ok && ok2 && oldObject.GetResourceVersion() == newObject.GetResourceVersion() {
+				// Resyncs don't change objects.
+				return
+			}
+			w.notify(key, newObj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			w.notify(key, obj)
+		},
+	}
+}
+
+// notify calls the subscribers for a key. Informers can be shared across
+// namespaces, so objects from other namespaces are ignored.
+func (w *Watcher) notify(key store.Key, obj interface{}) {
+	if tombstone, ok := obj.(kcache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+
+	if object, ok := obj.(*unstructured.Unstructured); ok {
+		if key.Namespace != "" && object.GetNamespace() != key.Namespace {
+			return
+		}
+	}
+
+	w.mu.Lock()
+	var fns []func()
+	for _, fn := range w.subscribers[key] {
+		fns = append(fns, fn)
+	}
+	w.mu.Unlock()
+
+	for _, fn := range fns {
+		fn()
+	}
+}
+
+// reset registers handlers with an updated store. Informers are recreated
+// when the cluster client changes, so the old handlers are gone. Every
+// subscriber is notified because the objects they watched are from the
+// previous cluster.
+func (w *Watcher) reset(objectStore store.Store) {
+	w.registerMu.Lock()
+
+	w.mu.Lock()
+	w.objectStore = objectStore
+	w.registered = make(map[store.Key]bool)
+
+	var keys []store.Key
+	var fns []func()
+	for key, subscribers := range w.subscribers {
+		keys = append(keys, key)
+		for _, fn := range subscribers {
+			fns = append(fns, fn)
+		}
+	}
+	w.mu.Unlock()
+
+	for _, key := range keys {
+		if err := w.register(key); err != nil {
+			w.logger.WithErr(err).Warnf("unable to watch after store update")
+		}
+	}
+
+	w.registerMu.Unlock()
+
+	for _, fn := range fns {
+		fn()
+	}
+}
+
+// watchKey returns the key a handler is registered for. Subscribers are
+// notified about any object of a kind in a namespace.
+func watchKey(key store.Key) store.Key {
+	return store.Key{
+		Namespace:  key.Namespace,
+		APIVersion: key.APIVersion,
+		Kind:       key.Kind,
+	}
+}
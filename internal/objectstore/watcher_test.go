@@ -0,0 +1,85 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubenext/lissio/internal/log"
+	"github.com/kubenext/lissio/internal/testutil"
+	"github.com/kubenext/lissio/pkg/store"
+	storeFake "github.com/kubenext/lissio/pkg/store/fake"
+)
+
+func TestWatcher(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	var onUpdate store.UpdateFn
+	var handlers []cache.ResourceEventHandler
+
+	watchKey := store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Pod"}
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		RegisterOnUpdate(gomock.Any()).
+		Do(func(fn store.UpdateFn) {
+			onUpdate = fn
+		})
+	objectStore.EXPECT().
+		Watch(gomock.Any(), watchKey, gomock.Any()).
+		Do(func(ctx context.Context, key store.Key, handler cache.ResourceEventHandler) {
+			handlers = append(handlers, handler)
+		}).
+		Return(nil).
+		Times(2)
+
+	w := NewWatcher(objectStore, log.NopLogger())
+
+	ctx := context.Background()
+
+	calls1, calls2 := 0, 0
+	cancel1, err := w.Subscribe(ctx, store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Pod", Name: "pod"}, func() {
+		calls1++
+	})
+	require.NoError(t, err)
+
+	_, err = w.Subscribe(ctx, watchKey, func() {
+		calls2++
+	})
+	require.NoError(t, err)
+
+	// One handler is registered for both subscribers.
+	require.Len(t, handlers, 1)
+
+	pod := testutil.ToUnstructured(t, testutil.CreatePod("pod"))
+	handlers[0].OnAdd(pod)
+	assert.Equal(t, 1, calls1)
+	assert.Equal(t, 1, calls2)
+
+	// Resyncs and objects in other namespaces are ignored.
+	handlers[0].OnUpdate(pod, pod)
+	other := pod.DeepCopy()
+	other.SetNamespace("other")
+	handlers[0].OnDelete(other)
+	assert.Equal(t, 1, calls1)
+
+	cancel1()
+	handlers[0].OnDelete(pod)
+	assert.Equal(t, 1, calls1)
+	assert.Equal(t, 2, calls2)
+
+	// A store update registers the handler again and notifies subscribers.
+	onUpdate(objectStore)
+	require.Len(t, handlers, 2)
+	assert.Equal(t, 3, calls2)
+}
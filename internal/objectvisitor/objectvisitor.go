@@ -82,14 +82,17 @@ var _ Visitor = (*DefaultVisitor)(nil)
 
 // NewDefaultVisitor creates an instance of DefaultVisitor.
 func NewDefaultVisitor(dashConfig config.Dash, q queryer.Queryer, options ...DefaultVisitorOption) (*DefaultVisitor, error) {
+	typedVisitors := []TypedVisitor{
+		NewIngress(q),
+		NewPod(q),
+		NewSecret(q),
+		NewService(q),
+	}
+
 	dv := &DefaultVisitor{
-		queryer: q,
-		visited: make(map[types.UID]bool),
-		typedVisitors: []TypedVisitor{
-			NewIngress(q),
-			NewPod(q),
-			NewService(q),
-		},
+		queryer:        q,
+		visited:        make(map[types.UID]bool),
+		typedVisitors:  append(typedVisitors, pluginVisitors(dashConfig)...),
 		defaultHandler: NewObject(dashConfig, q),
 	}
 
@@ -152,8 +155,8 @@ func (dv *DefaultVisitor) Visit(ctx context.Context, object *unstructured.Unstru
 	return dv.visitObject(ctx, object, handler, visitDescendants)
 }
 
-// visitObject visits an object. If the object is a service, ingress, or pod, it
-// also runs custom visitor code for them.
+// visitObject visits an object. If the object is a service, ingress, pod, or secret, or
+// a plugin finds related objects for it, it also runs custom visitor code for them.
 func (dv *DefaultVisitor) visitObject(ctx context.Context, object runtime.Object, handler ObjectHandler, visitDescendants bool) error {
 	ctx, span := trace.StartSpan(ctx, "visitObject")
 	defer span.End()
@@ -174,13 +177,11 @@ func (dv *DefaultVisitor) visitObject(ctx context.Context, object runtime.Object
 
 	objectGVK := schema.FromAPIVersionAndKind(apiVersion, kind)
 
-	tvMap := make(map[schema.GroupVersionKind]TypedVisitor)
-	for _, typedVisitor := range dv.typedVisitors {
-		tvMap[typedVisitor.Supports()] = typedVisitor
-	}
+	for _, tv := range dv.typedVisitors {
+		if tv.Supports() != objectGVK {
+			continue
+		}
 
-	tv, ok := tvMap[objectGVK]
-	if ok {
 		if err := tv.Visit(ctx, u, handler, dv, visitDescendants); err != nil {
 			return err
 		}
@@ -18,13 +18,18 @@ import (
 	ovFake "github.com/kubenext/lissio/internal/objectvisitor/fake"
 	queryerFake "github.com/kubenext/lissio/internal/queryer/fake"
 	"github.com/kubenext/lissio/internal/testutil"
+	pluginFake "github.com/kubenext/lissio/pkg/plugin/fake"
 )
 
 func TestDefaultVisitor_Visit_use_typed_visitor(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
 
+	pluginManager := pluginFake.NewMockManagerInterface(controller)
+	pluginManager.EXPECT().ObjectVisitorGVKs().Return(nil)
+
 	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().PluginManager().Return(pluginManager)
 
 	pod := testutil.CreatePod("pod")
 	unstructuredPod := testutil.ToUnstructured(t, pod)
@@ -0,0 +1,108 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectvisitor
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.opencensus.io/trace"
+	"golang.org/x/sync/errgroup"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kubenext/lissio/internal/config"
+	"github.com/kubenext/lissio/internal/log"
+	"github.com/kubenext/lissio/internal/util/kubernetes"
+	"github.com/kubenext/lissio/pkg/plugin"
+	"github.com/kubenext/lissio/pkg/store"
+)
+
+// Plugin is a typed visitor which asks plugins for objects related to
+// an object.
+type Plugin struct {
+	gvk           schema.GroupVersionKind
+	objectStore   store.Store
+	pluginManager plugin.ManagerInterface
+}
+
+var _ TypedVisitor = (*Plugin)(nil)
+
+// NewPlugin creates an instance of Plugin.
+func NewPlugin(groupVersionKind schema.GroupVersionKind, objectStore store.Store, pluginManager plugin.ManagerInterface) *Plugin {
+	return &Plugin{
+		gvk:           groupVersionKind,
+		objectStore:   objectStore,
+		pluginManager: pluginManager,
+	}
+}
+
+// Supports returns the gvk this typed visitor supports.
+func (p *Plugin) Supports() schema.GroupVersionKind {
+	return p.gvk
+}
+
+// Visit visits an object. It visits the objects plugins consider related
+// to the object. Failing plugins are logged so they don't prevent the
+// rest of the object's relationships from being shown.
+func (p *Plugin) Visit(ctx context.Context, object *unstructured.Unstructured, handler ObjectHandler, visitor Visitor, visitDescendants bool) error {
+	ctx, span := trace.StartSpan(ctx, "visitPlugin")
+	defer span.End()
+
+	keys, err := p.pluginManager.RelatedObjects(ctx, object)
+	if err != nil {
+		log.From(ctx).
+			WithErr(err).
+			With("object", kubernetes.PrintObject(object)).
+			Errorf("find related objects with plugins")
+		return nil
+	}
+
+	var g errgroup.Group
+
+	for i := range keys {
+		key := keys[i]
+		g.Go(func() error {
+			u, found, err := p.objectStore.Get(ctx, key)
+			if err != nil {
+				return errors.Wrapf(err, "get related object %s", key)
+			}
+
+			if !found {
+				return nil
+			}
+
+			if err := visitor.Visit(ctx, u, handler, true); err != nil {
+				return errors.Wrapf(err, "%s visit related object %s",
+					kubernetes.PrintObject(object), kubernetes.PrintObject(u))
+			}
+
+			return handler.AddEdge(ctx, object, u)
+		})
+	}
+
+	return g.Wait()
+}
+
+// pluginVisitors creates a typed visitor for each GVK plugins find related
+// objects for.
+func pluginVisitors(dashConfig config.Dash) []TypedVisitor {
+	if dashConfig == nil {
+		return nil
+	}
+
+	pluginManager := dashConfig.PluginManager()
+	if pluginManager == nil {
+		return nil
+	}
+
+	var list []TypedVisitor
+	for _, groupVersionKind := range pluginManager.ObjectVisitorGVKs() {
+		list = append(list, NewPlugin(groupVersionKind, dashConfig.ObjectStore(), pluginManager))
+	}
+
+	return list
+}
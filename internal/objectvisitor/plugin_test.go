@@ -0,0 +1,86 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectvisitor_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kubenext/lissio/internal/gvk"
+	"github.com/kubenext/lissio/internal/objectvisitor"
+	"github.com/kubenext/lissio/internal/objectvisitor/fake"
+	"github.com/kubenext/lissio/internal/testutil"
+	pluginFake "github.com/kubenext/lissio/pkg/plugin/fake"
+	"github.com/kubenext/lissio/pkg/store"
+	storeFake "github.com/kubenext/lissio/pkg/store/fake"
+)
+
+func TestPlugin_Visit(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	certificateGVK := schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1alpha2", Kind: "Certificate"}
+
+	certificate := &unstructured.Unstructured{}
+	certificate.SetAPIVersion(certificateGVK.GroupVersion().String())
+	certificate.SetKind(certificateGVK.Kind)
+	certificate.SetNamespace("default")
+	certificate.SetName("certificate")
+
+	secret := testutil.ToUnstructured(t, testutil.CreateSecret("secret"))
+	secretKey, err := store.KeyFromObject(secret)
+	require.NoError(t, err)
+	missingKey := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Secret", Name: "missing"}
+
+	pluginManager := pluginFake.NewMockManagerInterface(controller)
+	pluginManager.EXPECT().
+		RelatedObjects(gomock.Any(), certificate).
+		Return([]store.Key{secretKey, missingKey}, nil)
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().Get(gomock.Any(), secretKey).Return(secret, true, nil)
+	objectStore.EXPECT().Get(gomock.Any(), missingKey).Return(nil, false, nil)
+
+	handler := fake.NewMockObjectHandler(controller)
+	handler.EXPECT().AddEdge(gomock.Any(), certificate, secret).Return(nil)
+
+	visitor := fake.NewMockVisitor(controller)
+	visitor.EXPECT().Visit(gomock.Any(), secret, handler, true).Return(nil)
+
+	p := objectvisitor.NewPlugin(certificateGVK, objectStore, pluginManager)
+	assert.Equal(t, certificateGVK, p.Supports())
+
+	ctx := context.Background()
+	require.NoError(t, p.Visit(ctx, certificate, handler, visitor, true))
+}
+
+func TestPlugin_Visit_plugin_error(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	pod := testutil.ToUnstructured(t, testutil.CreatePod("pod"))
+
+	pluginManager := pluginFake.NewMockManagerInterface(controller)
+	pluginManager.EXPECT().
+		RelatedObjects(gomock.Any(), pod).
+		Return(nil, errors.New("plugin crashed"))
+
+	objectStore := storeFake.NewMockStore(controller)
+	handler := fake.NewMockObjectHandler(controller)
+	visitor := fake.NewMockVisitor(controller)
+
+	p := objectvisitor.NewPlugin(gvk.Pod, objectStore, pluginManager)
+
+	ctx := context.Background()
+	assert.NoError(t, p.Visit(ctx, pod, handler, visitor, true))
+}
@@ -0,0 +1,75 @@
+package objectvisitor
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.opencensus.io/trace"
+	"golang.org/x/sync/errgroup"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kubenext/lissio/internal/gvk"
+	"github.com/kubenext/lissio/internal/queryer"
+	"github.com/kubenext/lissio/internal/util/kubernetes"
+)
+
+// Secret is a typed visitor for secrets.
+type Secret struct {
+	queryer queryer.Queryer
+}
+
+var _ TypedVisitor = (*Secret)(nil)
+
+// NewSecret creates an instance of Secret.
+func NewSecret(q queryer.Queryer) *Secret {
+	return &Secret{queryer: q}
+}
+
+// Supports returns the gvk this typed visitor supports.
+func (Secret) Supports() schema.GroupVersionKind {
+	return gvk.Secret
+}
+
+// Visit visits a secret. It looks for ingresses which use the secret for TLS.
+func (s *Secret) Visit(ctx context.Context, object *unstructured.Unstructured, handler ObjectHandler, visitor Visitor, visitDescendants bool) error {
+	ctx, span := trace.StartSpan(ctx, "visitSecret")
+	defer span.End()
+
+	secret := &corev1.Secret{}
+	if err := convertToType(object, secret); err != nil {
+		return err
+	}
+
+	if secret.Type != corev1.SecretTypeTLS {
+		return nil
+	}
+
+	ingresses, err := s.queryer.IngressesForSecret(ctx, secret)
+	if err != nil {
+		return err
+	}
+
+	var g errgroup.Group
+
+	for i := range ingresses {
+		ingress := ingresses[i]
+		g.Go(func() error {
+			m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(ingress)
+			if err != nil {
+				return err
+			}
+			u := &unstructured.Unstructured{Object: m}
+			if err := visitor.Visit(ctx, u, handler, true); err != nil {
+				return errors.Wrapf(err, "secret %s visit ingress %s",
+					kubernetes.PrintObject(secret), kubernetes.PrintObject(ingress))
+			}
+
+			return handler.AddEdge(ctx, object, u)
+		})
+	}
+
+	return g.Wait()
+}
@@ -0,0 +1,73 @@
+package objectvisitor_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	extv1beta1 "k8s.io/api/extensions/v1beta1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kubenext/lissio/internal/objectvisitor"
+	"github.com/kubenext/lissio/internal/objectvisitor/fake"
+	queryerFake "github.com/kubenext/lissio/internal/queryer/fake"
+	"github.com/kubenext/lissio/internal/testutil"
+)
+
+func TestSecret_Visit(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	object := testutil.CreateSecret("secret")
+	object.Type = corev1.SecretTypeTLS
+	u := testutil.ToUnstructured(t, object)
+
+	q := queryerFake.NewMockQueryer(controller)
+	ingress := testutil.CreateIngress("ingress")
+	q.EXPECT().
+		IngressesForSecret(gomock.Any(), object).
+		Return([]*extv1beta1.Ingress{ingress}, nil)
+
+	handler := fake.NewMockObjectHandler(controller)
+	handler.EXPECT().
+		AddEdge(gomock.Any(), u, testutil.ToUnstructured(t, ingress)).
+		Return(nil)
+
+	var visited []unstructured.Unstructured
+	visitor := fake.NewMockVisitor(controller)
+	visitor.EXPECT().
+		Visit(gomock.Any(), gomock.Any(), handler, true).
+		DoAndReturn(func(ctx context.Context, object *unstructured.Unstructured, handler objectvisitor.ObjectHandler, _ bool) error {
+			visited = append(visited, *object)
+			return nil
+		})
+
+	secret := objectvisitor.NewSecret(q)
+
+	ctx := context.Background()
+	err := secret.Visit(ctx, u, handler, visitor, true)
+
+	expected := testutil.ToUnstructuredList(t, ingress)
+	assert.Equal(t, expected.Items, visited)
+	assert.NoError(t, err)
+}
+
+func TestSecret_Visit_not_tls(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	object := testutil.CreateSecret("secret")
+	u := testutil.ToUnstructured(t, object)
+
+	q := queryerFake.NewMockQueryer(controller)
+	handler := fake.NewMockObjectHandler(controller)
+	visitor := fake.NewMockVisitor(controller)
+
+	secret := objectvisitor.NewSecret(q)
+
+	ctx := context.Background()
+	err := secret.Visit(ctx, u, handler, visitor, true)
+	assert.NoError(t, err)
+}
@@ -8,14 +8,20 @@ package portforward
 import (
 	"context"
 	"os"
+	"path/filepath"
 
 	"github.com/kubenext/lissio/internal/cluster"
 	"github.com/kubenext/lissio/internal/log"
+	"github.com/kubenext/lissio/internal/util/configdir"
 	"github.com/kubenext/lissio/pkg/store"
 
 	"github.com/pkg/errors"
 )
 
+// definitionsFile is the file in Lissio's configuration directory persistent
+// port forwards are saved to.
+const definitionsFile = "port-forwards.json"
+
 // Default create a port forward instance.
 func Default(ctx context.Context, client cluster.ClientInterface, objectStore store.Store) (PortForwarder, error) {
 	logger := log.From(ctx)
@@ -24,10 +30,6 @@ func Default(ctx context.Context, client cluster.ClientInterface, objectStore st
 		return nil, errors.Wrap(err, "fetching RESTClient")
 	}
 
-	go func() {
-
-	}()
-
 	pfOpts := ServiceOptions{
 		RESTClient:  restClient,
 		Config:      client.RESTConfig(),
@@ -41,8 +43,18 @@ func Default(ctx context.Context, client cluster.ClientInterface, objectStore st
 		},
 	}
 
+	if dir, err := configdir.Default(); err != nil {
+		logger.WithErr(err).Warnf("port forwards will not be persisted")
+	} else {
+		pfOpts.DefinitionsPath = filepath.Join(dir, definitionsFile)
+	}
+
 	// FIXME: logger is in context
 	svc := New(ctx, pfOpts, logger)
 
+	if err := svc.Restore(); err != nil {
+		logger.WithErr(err).Errorf("restoring saved port forwards")
+	}
+
 	return svc, nil
 }
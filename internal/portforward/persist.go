@@ -0,0 +1,82 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package portforward
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+)
+
+// definitions persists port forward definitions so they can be
+// restored when Lissio restarts.
+type definitions struct {
+	fs   afero.Fs
+	path string
+
+	mu sync.Mutex
+}
+
+func newDefinitions(fs afero.Fs, path string) *definitions {
+	if fs == nil {
+		fs = afero.NewOsFs()
+	}
+
+	return &definitions{
+		fs:   fs,
+		path: path,
+	}
+}
+
+// load loads saved definitions. A missing definitions file is not an error.
+func (d *definitions) load() ([]CreateRequest, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	data, err := afero.ReadFile(d.fs, d.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "read port forward definitions")
+	}
+
+	var requests []CreateRequest
+	if err := json.Unmarshal(data, &requests); err != nil {
+		return nil, errors.Wrap(err, "decode port forward definitions")
+	}
+
+	return requests, nil
+}
+
+// save replaces the saved definitions.
+func (d *definitions) save(requests []CreateRequest) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if requests == nil {
+		requests = []CreateRequest{}
+	}
+
+	data, err := json.MarshalIndent(requests, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "encode port forward definitions")
+	}
+
+	if err := d.fs.MkdirAll(filepath.Dir(d.path), 0700); err != nil {
+		return errors.Wrap(err, "create port forward definitions directory")
+	}
+
+	if err := afero.WriteFile(d.fs, d.path, data, 0600); err != nil {
+		return errors.Wrap(err, "write port forward definitions")
+	}
+
+	return nil
+}
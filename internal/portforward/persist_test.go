@@ -0,0 +1,40 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package portforward
+
+import (
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_definitions(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	d := newDefinitions(fs, "/config/lissio/port-forwards.json")
+
+	got, err := d.load()
+	require.NoError(t, err)
+	assert.Empty(t, got)
+
+	requests := []CreateRequest{
+		{
+			Namespace:  "default",
+			APIVersion: "v1",
+			Kind:       "Service",
+			Name:       "redis",
+			Ports:      []PortForwardPortSpec{{Remote: 6379, Local: 6379}},
+			Alias:      "redis",
+			Persist:    true,
+		},
+	}
+	require.NoError(t, d.save(requests))
+
+	got, err = d.load()
+	require.NoError(t, err)
+	assert.Equal(t, requests, got)
+}
@@ -0,0 +1,124 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package portforward
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/kubenext/lissio/pkg/action"
+)
+
+// PortRequest is a client request to forward a single port of an object.
+// A local port of zero is chosen at random.
+type PortRequest struct {
+	APIVersion string `json:"apiVersion,omitempty"`
+	Kind       string `json:"kind,omitempty"`
+	Name       string `json:"name,omitempty"`
+	Namespace  string `json:"namespace,omitempty"`
+	Port       uint16 `json:"port,omitempty"`
+	LocalPort  uint16 `json:"localPort,omitempty"`
+	Alias      string `json:"alias,omitempty"`
+	Persist    bool   `json:"persist,omitempty"`
+}
+
+// PortRequestFromPayload creates a PortRequest from an action payload.
+func PortRequestFromPayload(payload action.Payload) (*PortRequest, error) {
+	apiVersion, err := payload.String("apiVersion")
+	if err != nil {
+		return nil, err
+	}
+
+	kind, err := payload.String("kind")
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := payload.String("name")
+	if err != nil {
+		return nil, err
+	}
+
+	namespace, err := payload.String("namespace")
+	if err != nil {
+		return nil, err
+	}
+
+	port, err := payload.Uint16("port")
+	if err != nil {
+		return nil, err
+	}
+
+	localPort, err := payload.OptionalUint16("localPort")
+	if err != nil {
+		return nil, err
+	}
+
+	alias, err := payload.OptionalString("alias")
+	if err != nil {
+		return nil, err
+	}
+
+	persist, err := payload.OptionalBool("persist")
+	if err != nil {
+		return nil, err
+	}
+
+	req := &PortRequest{
+		APIVersion: apiVersion,
+		Kind:       kind,
+		Name:       name,
+		Namespace:  namespace,
+		Port:       port,
+		LocalPort:  localPort,
+		Alias:      alias,
+		Persist:    persist,
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// Validate returns an error if the request can't be forwarded.
+func (req *PortRequest) Validate() error {
+	if !IsForwardable(req.APIVersion, req.Kind) {
+		return errors.Errorf("port forwards do not support %s %s", req.APIVersion, req.Kind)
+	}
+
+	if req.Name == "" {
+		return errors.New("name is blank")
+	}
+
+	if req.Namespace == "" {
+		return errors.New("namespace is blank")
+	}
+
+	if req.Port < 1 {
+		return errors.New("port must be greater than 0")
+	}
+
+	return nil
+}
+
+// ToCreateRequest converts the request to a CreateRequest.
+func (req *PortRequest) ToCreateRequest() CreateRequest {
+	return CreateRequest{
+		Namespace:  req.Namespace,
+		APIVersion: req.APIVersion,
+		Kind:       req.Kind,
+		Name:       req.Name,
+		Ports: []PortForwardPortSpec{
+			{
+				Remote: req.Port,
+				Local:  req.LocalPort,
+			},
+		},
+		Alias:   req.Alias,
+		Persist: req.Persist,
+	}
+}
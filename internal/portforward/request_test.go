@@ -0,0 +1,46 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package portforward
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubenext/lissio/pkg/action"
+)
+
+func TestPortRequestFromPayload(t *testing.T) {
+	payload := action.Payload{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"name":       "web",
+		"namespace":  "default",
+		"port":       float64(80),
+		"localPort":  float64(8080),
+		"alias":      "web",
+		"persist":    true,
+	}
+
+	got, err := PortRequestFromPayload(payload)
+	require.NoError(t, err)
+
+	expected := CreateRequest{
+		Namespace:  "default",
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       "web",
+		Ports:      []PortForwardPortSpec{{Remote: 80, Local: 8080}},
+		Alias:      "web",
+		Persist:    true,
+	}
+	assert.Equal(t, expected, got.ToCreateRequest())
+
+	payload["kind"] = "ConfigMap"
+	_, err = PortRequestFromPayload(payload)
+	require.Error(t, err)
+}
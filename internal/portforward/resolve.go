@@ -0,0 +1,247 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package portforward
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/kubenext/lissio/pkg/store"
+)
+
+// forwardableKinds are the kinds a port forward can target. Anything other
+// than a pod is resolved into a ready pod using its selector.
+var forwardableKinds = map[string][]string{
+	"Pod":         {"v1"},
+	"Service":     {"v1"},
+	"Deployment":  {"apps/v1", "apps/v1beta2", "apps/v1beta1", "extensions/v1beta1"},
+	"StatefulSet": {"apps/v1", "apps/v1beta2", "apps/v1beta1"},
+	"ReplicaSet":  {"apps/v1", "apps/v1beta2", "extensions/v1beta1"},
+	"DaemonSet":   {"apps/v1", "apps/v1beta2", "extensions/v1beta1"},
+}
+
+// IsForwardable returns true if a port forward can target objects with
+// the given apiVersion and kind.
+func IsForwardable(apiVersion, kind string) bool {
+	for _, v := range forwardableKinds[kind] {
+		if v == apiVersion {
+			return true
+		}
+	}
+
+	return false
+}
+
+// resolvedTarget is a pod a port forward request resolved to.
+type resolvedTarget struct {
+	podName string
+	// ports are the request ports with remote ports translated to pod ports.
+	ports []PortForwardPortSpec
+}
+
+// resolveTarget resolves a port forward request into an active pod we can
+// forward to. Service and workload selectors are resolved into pods and the
+// first ready one will be chosen. For services, remote ports are translated
+// to the target ports of the selected pod.
+func (s *Service) resolveTarget(ctx context.Context, r CreateRequest) (resolvedTarget, error) {
+	o := s.opts.ObjectStore
+	if o == nil {
+		return resolvedTarget{}, errors.New("nil objectstore")
+	}
+
+	if r.APIVersion == "v1" && r.Kind == "Pod" {
+		// Verify pod exists and status is running
+		if ok, err := s.verifyPod(ctx, r.Namespace, r.Name); !ok || err != nil {
+			return resolvedTarget{}, errors.Errorf("verifying pod %q: %v", r.Name, err)
+		}
+		return resolvedTarget{podName: r.Name, ports: r.Ports}, nil
+	}
+
+	key := store.Key{
+		Namespace:  r.Namespace,
+		APIVersion: r.APIVersion,
+		Kind:       r.Kind,
+		Name:       r.Name,
+	}
+
+	object, found, err := o.Get(ctx, key)
+	if err != nil {
+		return resolvedTarget{}, errors.Wrapf(err, "get %s %q", r.Kind, r.Name)
+	}
+	if !found {
+		return resolvedTarget{}, errors.Errorf("%s %q not found", r.Kind, r.Name)
+	}
+
+	var service *corev1.Service
+	var selector *metav1.LabelSelector
+
+	if r.APIVersion == "v1" && r.Kind == "Service" {
+		service = &corev1.Service{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(object.Object, service); err != nil {
+			return resolvedTarget{}, errors.Wrap(err, "convert object to service")
+		}
+		selector = &metav1.LabelSelector{MatchLabels: service.Spec.Selector}
+	} else {
+		selector, err = workloadSelector(object)
+		if err != nil {
+			return resolvedTarget{}, err
+		}
+	}
+
+	pod, err := s.readyPodForSelector(ctx, r.Namespace, selector)
+	if err != nil {
+		return resolvedTarget{}, errors.Wrapf(err, "resolve pod for %s %q", r.Kind, r.Name)
+	}
+
+	ports := r.Ports
+	if service != nil {
+		ports = servicePodPorts(service, pod, r.Ports)
+	}
+
+	return resolvedTarget{podName: pod.Name, ports: ports}, nil
+}
+
+// workloadSelector returns the pod selector from a workload's spec.
+func workloadSelector(object *unstructured.Unstructured) (*metav1.LabelSelector, error) {
+	m, found, err := unstructured.NestedMap(object.Object, "spec", "selector")
+	if err != nil {
+		return nil, errors.Wrapf(err, "get selector for %s %q", object.GetKind(), object.GetName())
+	}
+	if !found {
+		return nil, errors.Errorf("%s %q has no selector", object.GetKind(), object.GetName())
+	}
+
+	selector := &metav1.LabelSelector{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, selector); err != nil {
+		return nil, errors.Wrap(err, "convert selector")
+	}
+
+	return selector, nil
+}
+
+// readyPodForSelector finds a running and ready pod matching a selector. Pods
+// are chosen in name order so the same pod is picked while it stays healthy.
+func (s *Service) readyPodForSelector(ctx context.Context, namespace string, labelSelector *metav1.LabelSelector) (*corev1.Pod, error) {
+	if labelSelector == nil || (len(labelSelector.MatchLabels) == 0 && len(labelSelector.MatchExpressions) == 0) {
+		return nil, errors.New("selector is empty")
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(labelSelector)
+	if err != nil {
+		return nil, errors.Wrap(err, "convert label selector")
+	}
+
+	key := store.Key{
+		Namespace:  namespace,
+		APIVersion: "v1",
+		Kind:       "Pod",
+	}
+	if len(labelSelector.MatchLabels) > 0 {
+		set := labels.Set(labelSelector.MatchLabels)
+		key.Selector = &set
+	}
+
+	list, _, err := s.opts.ObjectStore.List(ctx, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "list pods")
+	}
+
+	var pods []*corev1.Pod
+	for i := range list.Items {
+		if !selector.Matches(labels.Set(list.Items[i].GetLabels())) {
+			continue
+		}
+
+		pod := &corev1.Pod{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(list.Items[i].Object, pod); err != nil {
+			return nil, errors.Wrap(err, "convert object to pod")
+		}
+
+		if isPodReady(pod) {
+			pods = append(pods, pod)
+		}
+	}
+
+	if len(pods) == 0 {
+		return nil, errors.New("no ready pods found")
+	}
+
+	sort.Slice(pods, func(i, j int) bool {
+		return pods[i].Name < pods[j].Name
+	})
+
+	return pods[0], nil
+}
+
+// isPodReady returns true if a pod is running, ready and is not being deleted.
+func isPodReady(pod *corev1.Pod) bool {
+	if pod.DeletionTimestamp != nil || pod.Status.Phase != corev1.PodRunning {
+		return false
+	}
+
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+// servicePodPorts translates service ports into the ports of a pod
+// backing the service. Ports which aren't exposed by the service are
+// assumed to be pod ports.
+func servicePodPorts(service *corev1.Service, pod *corev1.Pod, ports []PortForwardPortSpec) []PortForwardPortSpec {
+	var out []PortForwardPortSpec
+
+	for _, p := range ports {
+		translated := p
+
+		for _, servicePort := range service.Spec.Ports {
+			if servicePort.Port != int32(p.Remote) {
+				continue
+			}
+
+			if podPort, ok := targetPort(servicePort, pod); ok {
+				translated.Remote = podPort
+			}
+			break
+		}
+
+		out = append(out, translated)
+	}
+
+	return out
+}
+
+// targetPort finds the pod port for a service port.
+func targetPort(servicePort corev1.ServicePort, pod *corev1.Pod) (uint16, bool) {
+	switch servicePort.TargetPort.Type {
+	case intstr.Int:
+		if servicePort.TargetPort.IntVal > 0 {
+			return uint16(servicePort.TargetPort.IntVal), true
+		}
+		return uint16(servicePort.Port), true
+	case intstr.String:
+		for _, container := range pod.Spec.Containers {
+			for _, containerPort := range container.Ports {
+				if containerPort.Name == servicePort.TargetPort.StrVal {
+					return uint16(containerPort.ContainerPort), true
+				}
+			}
+		}
+	}
+
+	return 0, false
+}
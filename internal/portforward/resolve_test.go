@@ -0,0 +1,170 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package portforward
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/kubenext/lissio/internal/log"
+	"github.com/kubenext/lissio/internal/testutil"
+	"github.com/kubenext/lissio/pkg/store"
+	storeFake "github.com/kubenext/lissio/pkg/store/fake"
+)
+
+func TestIsForwardable(t *testing.T) {
+	assert.True(t, IsForwardable("v1", "Pod"))
+	assert.True(t, IsForwardable("v1", "Service"))
+	assert.True(t, IsForwardable("apps/v1", "Deployment"))
+	assert.False(t, IsForwardable("v1", "ConfigMap"))
+	assert.False(t, IsForwardable("apps/v1", "Pod"))
+}
+
+func TestService_resolveTarget(t *testing.T) {
+	readyPod := func(name string) *corev1.Pod {
+		return testutil.CreatePod(name, func(pod *corev1.Pod) {
+			pod.Labels = map[string]string{"app": "app"}
+			pod.Spec.Containers = []corev1.Container{
+				{
+					Name:  "container",
+					Ports: []corev1.ContainerPort{{Name: "http", ContainerPort: 8080}},
+				},
+			}
+			pod.Status.Phase = corev1.PodRunning
+			pod.Status.Conditions = []corev1.PodCondition{
+				{Type: corev1.PodReady, Status: corev1.ConditionTrue},
+			}
+		})
+	}
+
+	notReadyPod := testutil.CreatePod("a-pod", func(pod *corev1.Pod) {
+		pod.Labels = map[string]string{"app": "app"}
+		pod.Status.Phase = corev1.PodPending
+	})
+
+	service := testutil.CreateService("service")
+	service.Spec.Selector = map[string]string{"app": "app"}
+	service.Spec.Ports = []corev1.ServicePort{
+		{Port: 80, TargetPort: intstr.FromString("http")},
+		{Port: 81, TargetPort: intstr.FromInt(9090)},
+	}
+
+	deployment := testutil.CreateDeployment("deployment")
+	deployment.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"app": "app"}}
+
+	podListKey := store.Key{
+		Namespace:  "namespace",
+		APIVersion: "v1",
+		Kind:       "Pod",
+		Selector:   &labels.Set{"app": "app"},
+	}
+
+	tests := []struct {
+		name     string
+		request  CreateRequest
+		init     func(o *storeFake.MockStore)
+		expected resolvedTarget
+		isErr    bool
+	}{
+		{
+			name: "pod",
+			request: CreateRequest{
+				Namespace: "namespace", APIVersion: "v1", Kind: "Pod", Name: "pod",
+				Ports: []PortForwardPortSpec{{Remote: 8080}},
+			},
+			init: func(o *storeFake.MockStore) {
+				key := store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Pod", Name: "pod"}
+				o.EXPECT().Get(gomock.Any(), key).Return(testutil.ToUnstructured(t, readyPod("pod")), true, nil)
+			},
+			expected: resolvedTarget{podName: "pod", ports: []PortForwardPortSpec{{Remote: 8080}}},
+		},
+		{
+			name: "service translates ports",
+			request: CreateRequest{
+				Namespace: "namespace", APIVersion: "v1", Kind: "Service", Name: "service",
+				Ports: []PortForwardPortSpec{{Remote: 80, Local: 8000}, {Remote: 81}, {Remote: 3000}},
+			},
+			init: func(o *storeFake.MockStore) {
+				key := store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Service", Name: "service"}
+				o.EXPECT().Get(gomock.Any(), key).Return(testutil.ToUnstructured(t, service), true, nil)
+				o.EXPECT().List(gomock.Any(), podListKey).
+					Return(testutil.ToUnstructuredList(t, notReadyPod, readyPod("c-pod"), readyPod("b-pod")), false, nil)
+			},
+			expected: resolvedTarget{
+				podName: "b-pod",
+				ports:   []PortForwardPortSpec{{Remote: 8080, Local: 8000}, {Remote: 9090}, {Remote: 3000}},
+			},
+		},
+		{
+			name: "deployment",
+			request: CreateRequest{
+				Namespace: "namespace", APIVersion: "apps/v1", Kind: "Deployment", Name: "deployment",
+				Ports: []PortForwardPortSpec{{Remote: 8080}},
+			},
+			init: func(o *storeFake.MockStore) {
+				key := store.Key{Namespace: "namespace", APIVersion: "apps/v1", Kind: "Deployment", Name: "deployment"}
+				o.EXPECT().Get(gomock.Any(), key).Return(testutil.ToUnstructured(t, deployment), true, nil)
+				o.EXPECT().List(gomock.Any(), podListKey).
+					Return(testutil.ToUnstructuredList(t, readyPod("pod")), false, nil)
+			},
+			expected: resolvedTarget{podName: "pod", ports: []PortForwardPortSpec{{Remote: 8080}}},
+		},
+		{
+			name: "no ready pods",
+			request: CreateRequest{
+				Namespace: "namespace", APIVersion: "apps/v1", Kind: "Deployment", Name: "deployment",
+				Ports: []PortForwardPortSpec{{Remote: 8080}},
+			},
+			init: func(o *storeFake.MockStore) {
+				key := store.Key{Namespace: "namespace", APIVersion: "apps/v1", Kind: "Deployment", Name: "deployment"}
+				o.EXPECT().Get(gomock.Any(), key).Return(testutil.ToUnstructured(t, deployment), true, nil)
+				o.EXPECT().List(gomock.Any(), podListKey).
+					Return(testutil.ToUnstructuredList(t, notReadyPod), false, nil)
+			},
+			isErr: true,
+		},
+		{
+			name: "target not found",
+			request: CreateRequest{
+				Namespace: "namespace", APIVersion: "v1", Kind: "Service", Name: "service",
+				Ports: []PortForwardPortSpec{{Remote: 80}},
+			},
+			init: func(o *storeFake.MockStore) {
+				o.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, false, nil)
+			},
+			isErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			o := storeFake.NewMockStore(controller)
+			test.init(o)
+
+			s := New(context.Background(), ServiceOptions{ObjectStore: o}, log.NopLogger())
+
+			got, err := s.resolveTarget(context.Background(), test.request)
+			if test.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	"github.com/spf13/afero"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/rest"
@@ -34,6 +35,7 @@ type PortForwarder interface {
 	List(ctx context.Context) []State
 	Get(id string) (State, bool)
 	Create(ctx context.Context, gvk schema.GroupVersionKind, name string, namespace string, remotePort uint16) (CreateResponse, error)
+	CreateFromRequest(ctx context.Context, r CreateRequest) (CreateResponse, error)
 	Find(namespace string, gvk schema.GroupVersionKind, name string) (State, error)
 	Stop()
 	StopForwarder(id string)
@@ -55,12 +57,18 @@ type PortForwardSpec struct {
 	CreatedAt time.Time             `json:"createdAt"`
 }
 
+// CreateRequest is a request for a port forward. The target can be a pod
+// or an object with a pod selector. Local ports of zero are chosen at random.
 type CreateRequest struct {
 	Namespace  string                `json:"namespace"`
 	APIVersion string                `json:"apiVersion"`
 	Kind       string                `json:"kind"`
 	Name       string                `json:"name"`
 	Ports      []PortForwardPortSpec `json:"ports"`
+	// Alias is an optional user supplied name for the port forward.
+	Alias string `json:"alias,omitempty"`
+	// Persist restores the port forward when Lissio restarts.
+	Persist bool `json:"persist,omitempty"`
 }
 
 type CreateResponse PortForwardSpec
@@ -72,28 +80,57 @@ type Target struct {
 	Name      string
 }
 
+// Status is the connection status of a port forward.
+type Status string
+
+const (
+	// StatusConnecting means the port forward is resolving a pod and connecting to it.
+	StatusConnecting Status = "connecting"
+	// StatusActive means the port forward is forwarding traffic.
+	StatusActive Status = "active"
+	// StatusReconnecting means the port forward lost its connection and is
+	// waiting to retry.
+	StatusReconnecting Status = "reconnecting"
+)
+
 // State describes a single port-forward's runtime state
 type State struct {
 	ID        string
+	Alias     string
 	CreatedAt time.Time
 	Ports     []ForwardedPort
 	Target    Target
 	Pod       Target
-
-	cancel context.CancelFunc
+	Status    Status
+	// Error is the last error the port forward encountered.
+	Error string
+	// Restarts is the number of times the port forward reconnected.
+	Restarts int
+	Persist  bool
+
+	request CreateRequest
+	cancel  context.CancelFunc
 }
 
 // Clone clones a port forward state.
 func (pf *State) Clone() State {
 	pfCpy := State{
 		ID:        pf.ID,
+		Alias:     pf.Alias,
 		CreatedAt: pf.CreatedAt,
 		Ports:     make([]ForwardedPort, len(pf.Ports)),
 		Target:    pf.Target,
 		Pod:       pf.Pod,
+		Status:    pf.Status,
+		Error:     pf.Error,
+		Restarts:  pf.Restarts,
+		Persist:   pf.Persist,
+		request:   pf.request,
 		cancel:    pf.cancel,
 	}
 	copy(pfCpy.Ports, pf.Ports)
+	pfCpy.request.Ports = make([]PortForwardPortSpec, len(pf.request.Ports))
+	copy(pfCpy.request.Ports, pf.request.Ports)
 	return pfCpy
 }
 
@@ -109,8 +146,24 @@ type ServiceOptions struct {
 	Config        *restclient.Config
 	ObjectStore   store.Store
 	PortForwarder portForwarder
+	// DefinitionsPath is where persistent port forwards are saved. If it is
+	// blank, port forwards are not persisted.
+	DefinitionsPath string
+	// Fs is the filesystem definitions are saved to. Defaults to the OS filesystem.
+	Fs afero.Fs
+	// CheckInterval is how often an active port forward's pod is checked.
+	// Defaults to DefaultCheckInterval.
+	CheckInterval time.Duration
 }
 
+const (
+	// DefaultCheckInterval is how often an active port forward's pod is checked.
+	DefaultCheckInterval = 5 * time.Second
+
+	reconnectMinDelay = time.Second
+	reconnectMaxDelay = 30 * time.Second
+)
+
 type forwarderEvent struct {
 	ID  string
 	err error
@@ -118,12 +171,13 @@ type forwarderEvent struct {
 
 // Service is a port forwarding service.
 type Service struct {
-	logger   log.Logger
-	opts     ServiceOptions
-	ctx      context.Context
-	cancel   context.CancelFunc
-	notifyCh chan forwarderEvent
-	state    States
+	logger      log.Logger
+	opts        ServiceOptions
+	ctx         context.Context
+	cancel      context.CancelFunc
+	notifyCh    chan forwarderEvent
+	state       States
+	definitions *definitions
 }
 
 var _ PortForwarder = (*Service)(nil)
@@ -131,7 +185,11 @@ var _ PortForwarder = (*Service)(nil)
 // New creates an instance of Service.
 func New(ctx context.Context, opts ServiceOptions, logger log.Logger) *Service {
 	ctx, cancel := context.WithCancel(ctx)
-	return &Service{
+	if opts.CheckInterval == 0 {
+		opts.CheckInterval = DefaultCheckInterval
+	}
+
+	s := &Service{
 		logger:   logger,
 		opts:     opts,
 		notifyCh: make(chan forwarderEvent, 32),
@@ -141,6 +199,38 @@ func New(ctx context.Context, opts ServiceOptions, logger log.Logger) *Service {
 			portForwards: make(map[string]State),
 		},
 	}
+
+	if opts.DefinitionsPath != "" {
+		s.definitions = newDefinitions(opts.Fs, opts.DefinitionsPath)
+	}
+
+	return s
+}
+
+// Restore starts the port forwards saved by previous runs. Restored port
+// forwards keep trying to connect until their targets become available.
+func (s *Service) Restore() error {
+	if s.definitions == nil {
+		return nil
+	}
+
+	requests, err := s.definitions.load()
+	if err != nil {
+		return err
+	}
+
+	for _, r := range requests {
+		if err := s.validateCreateRequest(r); err != nil {
+			s.logger.WithErr(err).With("name", r.Name).Warnf("skipping saved port forward")
+			continue
+		}
+
+		if _, _, err := s.startForwarder(r); err != nil {
+			return errors.Wrapf(err, "restore port forward for %s %q", r.Kind, r.Name)
+		}
+	}
+
+	return nil
 }
 
 // Stop stops all forwarders. The portForwardService is invalid after calling stop.
@@ -159,40 +249,21 @@ func (s *Service) validateCreateRequest(r CreateRequest) error {
 		return errors.New("name field required")
 	}
 
-	if r.APIVersion != "v1" || r.Kind != "Pod" {
-		return errors.Errorf("port forwards only work with pods")
-	}
-
-	for _, p := range r.Ports {
-		if p.Remote < 1 || p.Remote > 65535 {
-			return errors.Errorf("remote port out of range: %v", p.Remote)
-		}
+	if !IsForwardable(r.APIVersion, r.Kind) {
+		return errors.Errorf("port forwards do not support %s %s", r.APIVersion, r.Kind)
 	}
 
-	return nil
-}
-
-// resolvePod attempts to resolve a port forward request into an active pod we can
-// forward to. Service/deployments selectors will be resolved into pods and a random
-// one will be chosen. A pod has to be active.
-// Returns: pod name or error.
-func (s *Service) resolvePod(ctx context.Context, r CreateRequest) (string, error) {
-	o := s.opts.ObjectStore
-	if o == nil {
-		return "", errors.New("nil objectstore")
+	if len(r.Ports) == 0 {
+		return errors.New("at least one port is required")
 	}
 
-	switch {
-	case r.APIVersion == "v1" && r.Kind == "Pod":
-		// Verify pod exists and status is running
-		if ok, err := s.verifyPod(ctx, r.Namespace, r.Name); !ok || err != nil {
-			return "", errors.Errorf("verifying pod %q: %v", r.Name, err)
+	for _, p := range r.Ports {
+		if p.Remote == 0 {
+			return errors.New("remote port must be greater than 0")
 		}
-		return r.Name, nil
-	default:
-		return "", errors.New("not implemented")
 	}
 
+	return nil
 }
 
 // verifyPod returns true if the specified pod can be found and is in the running phase.
@@ -229,112 +300,236 @@ func (s *Service) verifyPod(ctx context.Context, namespace, name string) (bool,
 	return true, nil
 }
 
-// createForwarder creates a port forwarder, forwards traffic, and blocks until
-// port state information is populated.
+// startForwarder registers state for a port forward and starts forwarding traffic
+// in the background. The returned channel receives the result of the first
+// connection attempt.
 // Returns forwarder id.
-func (s *Service) createForwarder(r CreateRequest) (string, error) {
-	logger := s.logger.With("context", "PortForwardService.createForwarder")
-
-	if s.opts.PortForwarder == nil {
-		return "", errors.New("portforwarder is nil")
-	}
-
+func (s *Service) startForwarder(r CreateRequest) (string, <-chan error, error) {
 	randomUUID, err := uuid.NewRandom()
 	if err != nil {
-		return "", errors.Wrap(err, "generating uuid")
+		return "", nil, errors.Wrap(err, "generating uuid")
 	}
 	forwarderID := randomUUID.String()
-	logger = logger.With("id", forwarderID)
-
-	var ports []string
-	for _, p := range r.Ports {
-		ports = append(ports, fmt.Sprintf("%d:%d", p.Local, p.Remote))
-	}
-
-	// Target coordinates to preserve in state
-	gv, err := schema.ParseGroupVersion(r.APIVersion)
-	if err != nil {
-		return "", errors.Wrap(err, "parsing APIVersion")
-	}
-	gvk := gv.WithKind(r.Kind)
 
 	// This child context will be cancelled if our parent context is cancelled
 	ctx, cancel := context.WithCancel(s.ctx)
 
-	// Spawns goroutine to update state as ports become available
-	portsChannel, portsReady := s.localPortsHandler(ctx, forwarderID)
+	// Target coordinates to preserve in state
+	gvk := schema.FromAPIVersionAndKind(r.APIVersion, r.Kind)
 
-	// TODO resolve request gvk/name to pod name
-	o := &s.opts
-	opts := Options{
-		Config:        o.Config,
-		RESTClient:    o.RESTClient,
-		Address:       []string{"localhost"},
-		Ports:         ports,
-		PortForwarder: o.PortForwarder,
-		StopChannel:   ctx.Done(),
-		ReadyChannel:  make(chan struct{}),
-		PortsChannel:  portsChannel,
-	}
+	ports := make([]PortForwardPortSpec, len(r.Ports))
+	copy(ports, r.Ports)
+	r.Ports = ports
 
-	// NOTE: ports will be updated in the state struct by
-	// localPortsHandler when they become available.
+	// NOTE: ports and the pod will be updated in the state struct
+	// when the forwarder connects.
 	forwardState := State{
 		ID:        forwarderID,
+		Alias:     r.Alias,
 		CreatedAt: time.Now(),
 		Target: Target{
 			GVK:       gvk,
 			Namespace: r.Namespace,
 			Name:      r.Name,
 		},
-		// TODO Target and Pod may be different
-		Pod: Target{
-			GVK:       gvk,
-			Namespace: r.Namespace,
-			Name:      r.Name,
-		},
-		cancel: cancel,
+		Status:  StatusConnecting,
+		Persist: r.Persist,
+		request: r,
+		cancel:  cancel,
 	}
 
 	s.state.Lock()
 	s.state.portForwards[forwarderID] = forwardState
 	s.state.Unlock()
 
-	req := o.RESTClient.Post().
-		Resource("pods").
-		Namespace(r.Namespace).
-		Name(r.Name).
-		SubResource("portforward")
+	firstAttempt := make(chan error, 1)
+	go s.runForwarder(ctx, forwarderID, firstAttempt)
 
-	go func() {
-		// Blocks until forwarder completes
-		logger.With("url", req.URL()).Debugf("starting port-forward")
-		err := s.opts.PortForwarder.ForwardPorts("POST", req.URL(), opts)
+	return forwarderID, firstAttempt, nil
+}
 
-		logger.Debugf("forwarding terminated: %v", err)
+// runForwarder forwards traffic until its context is cancelled. When the
+// connection is lost, the target is resolved to a ready pod again and the
+// forwarder reconnects using the same local ports.
+func (s *Service) runForwarder(ctx context.Context, id string, firstAttempt chan<- error) {
+	logger := s.logger.With("context", "PortForwardService.runForwarder", "id", id)
+
+	notified := false
+	notify := func(err error) {
+		if !notified {
+			notified = true
+			firstAttempt <- err
+		}
+	}
+
+	delay := reconnectMinDelay
+
+	for {
+		err := s.forwardOnce(ctx, id, func() {
+			delay = reconnectMinDelay
+			notify(nil)
+		})
+
+		if ctx.Err() != nil {
+			notify(errors.Errorf("portforward terminated: %v", id))
+			return
+		}
 
-		// Notify the main forwarder of the termination
-		event := forwarderEvent{
-			ID:  forwarderID,
-			err: err,
+		if err == nil {
+			err = errors.New("lost connection to pod")
 		}
+		notify(err)
+
+		logger.WithErr(err).Debugf("port forward disconnected, reconnecting in %s", delay)
+		s.updateState(id, func(state *State) {
+			state.Status = StatusReconnecting
+			state.Error = err.Error()
+			state.Restarts++
+		})
+
 		select {
-		case s.notifyCh <- event:
-		default:
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
 		}
 
-		// Cleanup state for terminated port-forward
-		s.StopForwarder(forwarderID)
+		delay *= 2
+		if delay > reconnectMaxDelay {
+			delay = reconnectMaxDelay
+		}
+	}
+}
+
+// forwardOnce resolves the port forward's request into a pod, forwards traffic,
+// and blocks until the connection is lost or the pod is no longer running.
+// onReady is called once ports are available. Local ports chosen by the
+// forwarder are saved to the request so reconnects use the same ones.
+func (s *Service) forwardOnce(ctx context.Context, id string, onReady func()) error {
+	logger := s.logger.With("context", "PortForwardService.forwardOnce", "id", id)
+
+	if s.opts.PortForwarder == nil {
+		return errors.New("portforwarder is nil")
+	}
+
+	state, ok := s.Get(id)
+	if !ok {
+		return errors.Errorf("port forward %s was stopped", id)
+	}
+	r := state.request
+
+	target, err := s.resolveTarget(ctx, r)
+	if err != nil {
+		return errors.Wrap(err, "resolving pod")
+	}
+	logger.Debugf("resolved to pod %q", target.podName)
+
+	s.updateState(id, func(state *State) {
+		state.Status = StatusConnecting
+		state.Pod = Target{
+			GVK:       schema.GroupVersionKind{Version: "v1", Kind: "Pod"},
+			Namespace: r.Namespace,
+			Name:      target.podName,
+		}
+	})
+
+	var ports []string
+	for _, p := range target.ports {
+		ports = append(ports, fmt.Sprintf("%d:%d", p.Local, p.Remote))
+	}
+
+	attemptCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	portsChannel := make(chan []ForwardedPort, 1)
+	o := &s.opts
+	opts := Options{
+		Config:        o.Config,
+		RESTClient:    o.RESTClient,
+		Address:       []string{"localhost"},
+		Ports:         ports,
+		PortForwarder: o.PortForwarder,
+		StopChannel:   attemptCtx.Done(),
+		ReadyChannel:  make(chan struct{}),
+		PortsChannel:  portsChannel,
+	}
+
+	// Spawns goroutine to update state as ports become available and to
+	// stop forwarding if the pod goes away.
+	go func() {
+		select {
+		case p := <-portsChannel:
+			// Report the requested remote ports rather than the translated pod ports.
+			for i := range p {
+				if i < len(r.Ports) {
+					p[i].Remote = r.Ports[i].Remote
+				}
+			}
+			logger.With("ports", p).Debugf("received ports for port-forward")
+			s.updateState(id, func(state *State) {
+				state.Ports = p
+				state.Status = StatusActive
+				state.Error = ""
+				for i := range p {
+					if i < len(state.request.Ports) {
+						state.request.Ports[i].Local = p[i].Local
+					}
+				}
+			})
+			onReady()
+			s.savePersistent()
+		case <-attemptCtx.Done():
+			return
+		}
+
+		s.watchPod(attemptCtx, cancel, r.Namespace, target.podName)
 	}()
 
-	// Block until ports state is ready
+	req := o.RESTClient.Post().
+		Resource("pods").
+		Namespace(r.Namespace).
+		Name(target.podName).
+		SubResource("portforward")
+
+	// Blocks until forwarder completes
+	logger.With("url", req.URL()).Debugf("starting port-forward")
+	err = s.opts.PortForwarder.ForwardPorts("POST", req.URL(), opts)
+	logger.Debugf("forwarding terminated: %v", err)
+
+	// Notify the main forwarder of the termination
+	event := forwarderEvent{
+		ID:  id,
+		err: err,
+	}
 	select {
-	case <-ctx.Done():
-		return "", errors.Errorf("portforward terminated due to parent context: %v", forwarderID)
-	case <-portsReady:
+	case s.notifyCh <- event:
+	default:
 	}
 
-	return forwarderID, nil
+	if err == nil && ctx.Err() == nil {
+		if ok, verifyErr := s.verifyPod(ctx, r.Namespace, target.podName); !ok {
+			err = errors.Errorf("pod %q is no longer running: %v", target.podName, verifyErr)
+		}
+	}
+
+	return err
+}
+
+// watchPod periodically verifies a pod is running and calls stop if it is not.
+func (s *Service) watchPod(ctx context.Context, stop context.CancelFunc, namespace, name string) {
+	ticker := time.NewTicker(s.opts.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if ok, _ := s.verifyPod(ctx, namespace, name); !ok {
+				stop()
+				return
+			}
+		}
+	}
 }
 
 // responseForCreate creates a create response based on the state for the specified forward (by id)
@@ -357,44 +552,45 @@ func (s *Service) responseForCreate(id string) (CreateResponse, error) {
 	}
 	response.Ports = rp
 	response.Status = "ok"
+	response.Message = string(state.Status)
 	return response, nil
 }
 
-func (s *Service) localPortsHandler(ctx context.Context, id string) (portsChan chan []ForwardedPort, portsReady <-chan struct{}) {
-	logger := s.logger.With("context", "PortForwardService.localPortsHandler", "id", id)
-	portsChan = make(chan []ForwardedPort, 1)
-	readyChan := make(chan struct{})
-	portsReady = readyChan
-
-	go func() {
-		select {
-		case p := <-portsChan:
-			logger.With("ports", p).Debugf("received ports for port-forward")
-			if err := s.updatePorts(id, p); err != nil {
-				logger.Warnf("%s", err.Error())
-			}
-
-			close(readyChan)
-
-		case <-ctx.Done():
-			logger.Debugf("terminated")
-		}
-	}()
-
-	return
-}
-
-// updatePorts updates the ports list for an existing port forward, specified by id
-func (s *Service) updatePorts(id string, ports []ForwardedPort) error {
+// updateState updates the state for an existing port forward, specified by id
+func (s *Service) updateState(id string, fn func(state *State)) {
 	s.state.Lock()
 	defer s.state.Unlock()
 	state, ok := s.state.portForwards[id]
 	if !ok {
-		return errors.New("updating ports for terminated port-forward")
+		return
 	}
-	state.Ports = ports
+	fn(&state)
 	s.state.portForwards[id] = state
-	return nil
+}
+
+// savePersistent saves the definitions of port forwards which should be
+// restored when Lissio restarts.
+func (s *Service) savePersistent() {
+	if s.definitions == nil {
+		return
+	}
+
+	s.state.Lock()
+	var requests []CreateRequest
+	for _, pf := range s.state.portForwards {
+		if pf.Persist {
+			requests = append(requests, pf.Clone().request)
+		}
+	}
+	s.state.Unlock()
+
+	sort.Slice(requests, func(i, j int) bool {
+		return requests[i].Namespace+requests[i].Name < requests[j].Namespace+requests[j].Name
+	})
+
+	if err := s.definitions.save(requests); err != nil {
+		s.logger.WithErr(err).Errorf("saving port forward definitions")
+	}
 }
 
 // List lists port forwards
@@ -403,12 +599,7 @@ func (s *Service) List(ctx context.Context) []State {
 	defer s.state.Unlock()
 
 	result := make([]State, 0, len(s.state.portForwards))
-	for i, pf := range s.state.portForwards {
-		targetPod := &pf.Pod
-		if verified, err := s.verifyPod(ctx, targetPod.Namespace, targetPod.Name); !verified || err != nil {
-			delete(s.state.portForwards, i)
-			continue
-		}
+	for _, pf := range s.state.portForwards {
 		result = append(result, pf.Clone())
 	}
 
@@ -433,33 +624,44 @@ func (s *Service) Get(id string) (State, bool) {
 // Create creates a new port forward for the specified object and remote port.
 // Implements PortForwardInterface.
 func (s *Service) Create(ctx context.Context, gvk schema.GroupVersionKind, name string, namespace string, remotePort uint16) (CreateResponse, error) {
-	logger := s.logger.With("context", "PortForwardService.Create")
-	req := newForwardRequest(gvk, name, namespace, remotePort)
+	return s.CreateFromRequest(ctx, newForwardRequest(gvk, name, namespace, remotePort))
+}
+
+// CreateFromRequest creates a new port forward from a request. It blocks until
+// the port forward has connected. If the first connection attempt fails, the
+// port forward is stopped.
+// Implements PortForwardInterface.
+func (s *Service) CreateFromRequest(ctx context.Context, req CreateRequest) (CreateResponse, error) {
+	logger := s.logger.With("context", "PortForwardService.CreateFromRequest")
 
 	if err := s.validateCreateRequest(req); err != nil {
 		return emptyPortForwardResponse, errors.Wrap(err, "invalid request")
 	}
 
-	// Resolve the request into a pod, update the request
 	logger.With(
 		"apiVersion", req.APIVersion,
 		"kind", req.Kind,
 		"name", req.Name,
 		"namespace", req.Namespace,
-	).Debugf("resolving pod from object")
-	podName, err := s.resolvePod(ctx, req)
-	if err != nil {
-		return emptyPortForwardResponse, errors.Wrap(err, "resolving pod")
-	}
-	logger.Debugf("resolved to pod %q", podName)
-	podReq := req
-	podReq.Name = podName
+	).Debugf("creating port forward")
 
-	id, err := s.createForwarder(req)
+	id, firstAttempt, err := s.startForwarder(req)
 	if err != nil {
 		return emptyPortForwardResponse, errors.Wrap(err, "creating forwarder")
 	}
 
+	// Block until ports state is ready
+	select {
+	case <-ctx.Done():
+		s.StopForwarder(id)
+		return emptyPortForwardResponse, errors.Errorf("portforward terminated due to parent context: %v", id)
+	case err := <-firstAttempt:
+		if err != nil {
+			s.StopForwarder(id)
+			return emptyPortForwardResponse, errors.Wrap(err, "creating forwarder")
+		}
+	}
+
 	// Compose response based on forwarder state
 	response, err := s.responseForCreate(id)
 	if err != nil {
@@ -473,10 +675,9 @@ func (s *Service) Create(ctx context.Context, gvk schema.GroupVersionKind, name
 // Implements PortForwardInterface.
 func (s *Service) StopForwarder(id string) {
 	s.state.Lock()
-	defer s.state.Unlock()
-
 	pf, ok := s.state.portForwards[id]
 	if !ok {
+		s.state.Unlock()
 		return
 	}
 	if pf.cancel != nil {
@@ -484,6 +685,11 @@ func (s *Service) StopForwarder(id string) {
 		pf.cancel()
 	}
 	delete(s.state.portForwards, id)
+	s.state.Unlock()
+
+	if pf.Persist {
+		s.savePersistent()
+	}
 }
 
 type notFound struct{}
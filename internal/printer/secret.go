@@ -8,16 +8,21 @@ package printer
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 
+	"github.com/kubenext/lissio/internal/controllers"
+	"github.com/kubenext/lissio/internal/secrets"
+	"github.com/kubenext/lissio/pkg/action"
 	"github.com/kubenext/lissio/pkg/view/component"
 )
 
 var (
 	secretTableCols = component.NewTableCols("Name", "Labels", "Type", "Data", "Age")
-	secretDataCols  = component.NewTableCols("Key")
+	secretDataCols  = component.NewTableCols("Key", "Size", "Value", "Actions")
 )
 
 // SecretListHandler is a printFunc that lists secrets.
@@ -61,7 +66,7 @@ func SecretHandler(ctx context.Context, secret *corev1.Secret, options Options)
 		return nil, errors.Wrap(err, "print secret configuration")
 	}
 
-	if err := sh.Data(options); err != nil {
+	if err := sh.Data(ctx, options); err != nil {
 		return nil, errors.Wrap(err, "print secret data")
 	}
 
@@ -98,12 +103,22 @@ func (s *SecretConfiguration) Create(options Options) (*component.Summary, error
 	return summary, nil
 }
 
-func describeSecretData(secret corev1.Secret) (*component.Table, error) {
+// describeSecretData describes the values in a secret. Values are hidden
+// unless clientID revealed them.
+func describeSecretData(secret corev1.Secret, reveals *secrets.Reveals, clientID string) (*component.Table, error) {
 	table := component.NewTable("Data", "This secret has no data!", secretDataCols)
 
-	for key := range secret.Data {
+	for key, data := range secret.Data {
+		revealed := reveals.IsRevealed(clientID, secret.Namespace, secret.Name, key)
+
 		row := component.TableRow{}
 		row["Key"] = component.NewText(key)
+		row["Size"] = component.NewText(fmt.Sprintf("%d bytes", len(data)))
+		row["Value"] = component.NewText("(hidden)")
+		if revealed {
+			row["Value"] = describeSecretValue(secrets.Decode(key, data))
+		}
+		row["Actions"] = describeSecretDataActions(secret, key, revealed)
 
 		table.Add(row)
 	}
@@ -113,15 +128,75 @@ func describeSecretData(secret corev1.Secret) (*component.Table, error) {
 	return table, nil
 }
 
+// describeSecretValue describes a decoded secret value.
+func describeSecretValue(value secrets.Value) component.Component {
+	switch value.Format {
+	case secrets.FormatJSON:
+		return component.NewMarkdownText(fmt.Sprintf("```json\n%s\n```", value.Text))
+	case secrets.FormatPEM:
+		var sb strings.Builder
+		for i, cert := range value.Certificates {
+			if i > 0 {
+				sb.WriteString("\n\n")
+			}
+			fmt.Fprintf(&sb, "**Subject:** %s  \n", cert.Subject)
+			fmt.Fprintf(&sb, "**Issuer:** %s  \n", cert.Issuer)
+			if sans := cert.SANs(); len(sans) > 0 {
+				fmt.Fprintf(&sb, "**SANs:** %s  \n", strings.Join(sans, ", "))
+			}
+			fmt.Fprintf(&sb, "**Expires:** %s (%d days)",
+				cert.NotAfter.UTC().Format(time.RFC3339), cert.DaysUntilExpiry(time.Now()))
+		}
+		return component.NewMarkdownText(sb.String())
+	case secrets.FormatDockerConfig:
+		var lines []string
+		for _, credential := range value.DockerCredentials {
+			lines = append(lines, fmt.Sprintf("**%s:** %s / %s", credential.Registry, credential.Username, credential.Password))
+		}
+		return component.NewMarkdownText(strings.Join(lines, "  \n"))
+	case secrets.FormatBinary:
+		return component.NewText(fmt.Sprintf("base64:%s", value.Text))
+	default:
+		return component.NewText(value.Text)
+	}
+}
+
+func describeSecretDataActions(secret corev1.Secret, key string, revealed bool) *component.ButtonGroup {
+	fields := map[string]interface{}{
+		"namespace": secret.Namespace,
+		"name":      secret.Name,
+		"key":       key,
+	}
+
+	buttonGroup := component.NewButtonGroup()
+
+	if revealed {
+		fields["hide"] = true
+		buttonGroup.AddButton(
+			component.NewButton("Hide", action.CreatePayload(controllers.ActionRevealSecret, fields)))
+		return buttonGroup
+	}
+
+	buttonGroup.AddButton(
+		component.NewButton("Reveal",
+			action.CreatePayload(controllers.ActionRevealSecret, fields),
+			component.WithButtonConfirmation(
+				"Reveal Secret Value",
+				fmt.Sprintf("Are you sure you want to reveal **%s** in Secret **%s**? Reveals are logged.", key, secret.Name),
+			)))
+
+	return buttonGroup
+}
+
 type secretObject interface {
 	Config(options Options) error
-	Data(options Options) error
+	Data(ctx context.Context, options Options) error
 }
 
 type secretHandler struct {
 	secret     *corev1.Secret
 	configFunc func(*corev1.Secret, Options) (*component.Summary, error)
-	dataFunc   func(*corev1.Secret, Options) (*component.Table, error)
+	dataFunc   func(context.Context, *corev1.Secret, Options) (*component.Table, error)
 	object     *Object
 }
 
@@ -157,7 +232,7 @@ func defaultSecretConfig(secret *corev1.Secret, options Options) (*component.Sum
 	return NewSecretConfiguration(secret).Create(options)
 }
 
-func (s *secretHandler) Data(options Options) error {
+func (s *secretHandler) Data(ctx context.Context, options Options) error {
 	if s.secret == nil {
 		return errors.New("can't display data for nil secret")
 	}
@@ -165,12 +240,17 @@ func (s *secretHandler) Data(options Options) error {
 	s.object.RegisterItems(ItemDescriptor{
 		Width: component.WidthFull,
 		Func: func() (component.Component, error) {
-			return s.dataFunc(s.secret, options)
+			return s.dataFunc(ctx, s.secret, options)
 		},
 	})
 	return nil
 }
 
-func defaultSecretData(secret *corev1.Secret, options Options) (*component.Table, error) {
-	return describeSecretData(*secret)
+func defaultSecretData(ctx context.Context, secret *corev1.Secret, options Options) (*component.Table, error) {
+	var reveals *secrets.Reveals
+	if options.DashConfig != nil {
+		reveals = options.DashConfig.SecretReveals()
+	}
+
+	return describeSecretData(*secret, reveals, controllers.ClientIDFrom(ctx))
 }
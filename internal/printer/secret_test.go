@@ -14,6 +14,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/kubenext/lissio/internal/secrets"
 	"github.com/kubenext/lissio/internal/testutil"
 	"github.com/kubenext/lissio/pkg/view/component"
 )
@@ -125,14 +126,28 @@ func Test_describeSecretData(t *testing.T) {
 		"bar": {0, 1, 2, 3},
 	}
 
-	got, err := describeSecretData(*secret)
+	reveals := secrets.NewReveals(secrets.DefaultRevealDuration)
+	reveals.Reveal("client", secret.Namespace, secret.Name, "bar")
+	reveals.Reveal("other-client", secret.Namespace, secret.Name, "foo")
+
+	got, err := describeSecretData(*secret, reveals, "client")
 	require.NoError(t, err)
 
-	cols := component.NewTableCols("Key")
+	cols := component.NewTableCols("Key", "Size", "Value", "Actions")
 	expected := component.NewTable("Data", "This secret has no data!", cols)
 	expected.Add([]component.TableRow{
-		{"Key": component.NewText("bar")},
-		{"Key": component.NewText("foo")},
+		{
+			"Key":     component.NewText("bar"),
+			"Size":    component.NewText("4 bytes"),
+			"Value":   component.NewText("base64:AAECAw=="),
+			"Actions": describeSecretDataActions(*secret, "bar", true),
+		},
+		{
+			"Key":     component.NewText("foo"),
+			"Size":    component.NewText("4 bytes"),
+			"Value":   component.NewText("(hidden)"),
+			"Actions": describeSecretDataActions(*secret, "foo", false),
+		},
 	}...)
 
 	component.AssertEqual(t, expected, got)
@@ -44,6 +44,7 @@ import (
 type Queryer interface {
 	Children(ctx context.Context, object *unstructured.Unstructured) (*unstructured.UnstructuredList, error)
 	Events(ctx context.Context, object metav1.Object) ([]*corev1.Event, error)
+	IngressesForSecret(ctx context.Context, secret *corev1.Secret) ([]*extv1beta1.Ingress, error)
 	IngressesForService(ctx context.Context, service *corev1.Service) ([]*extv1beta1.Ingress, error)
 	OwnerReference(ctx context.Context, object *unstructured.Unstructured) (bool, *unstructured.Unstructured, error)
 	PodsForService(ctx context.Context, service *corev1.Service) ([]*corev1.Pod, error)
@@ -352,6 +353,44 @@ func (osq *ObjectStoreQueryer) Events(ctx context.Context, object metav1.Object)
 	return events, nil
 }
 
+// IngressesForSecret returns the ingresses which use a secret for TLS.
+func (osq *ObjectStoreQueryer) IngressesForSecret(ctx context.Context, secret *corev1.Secret) ([]*v1beta1.Ingress, error) {
+	if secret == nil {
+		return nil, errors.New("nil secret")
+	}
+
+	key := store.Key{
+		Namespace:  secret.Namespace,
+		APIVersion: "extensions/v1beta1",
+		Kind:       "Ingress",
+	}
+	ul, _, err := osq.objectStore.List(ctx, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "retrieving ingresses")
+	}
+
+	var results []*v1beta1.Ingress
+
+	for i := range ul.Items {
+		ingress := &v1beta1.Ingress{}
+		err := runtime.DefaultUnstructuredConverter.FromUnstructured(ul.Items[i].Object, ingress)
+		if err != nil {
+			return nil, errors.Wrap(err, "converting unstructured ingress")
+		}
+		if err = copyObjectMeta(ingress, &ul.Items[i]); err != nil {
+			return nil, errors.Wrap(err, "copying object metadata")
+		}
+
+		for _, tls := range ingress.Spec.TLS {
+			if tls.SecretName == secret.Name {
+				results = append(results, ingress)
+				break
+			}
+		}
+	}
+	return results, nil
+}
+
 func (osq *ObjectStoreQueryer) IngressesForService(ctx context.Context, service *corev1.Service) ([]*v1beta1.Ingress, error) {
 	if service == nil {
 		return nil, errors.New("nil service")
@@ -383,6 +383,102 @@ func TestCacheQueryer_IngressesForService(t *testing.T) {
 	}
 }
 
+func TestCacheQueryer_IngressesForSecret(t *testing.T) {
+	secret := &corev1.Secret{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: metav1.ObjectMeta{Name: "secret", Namespace: "default"},
+	}
+
+	ingress1 := &extv1beta1.Ingress{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "extensions/v1beta1", Kind: "Ingress"},
+		ObjectMeta: metav1.ObjectMeta{Name: "ingress1", Namespace: "default"},
+		Spec: extv1beta1.IngressSpec{
+			TLS: []extv1beta1.IngressTLS{
+				{SecretName: "other"},
+				{SecretName: "secret"},
+			},
+		},
+	}
+
+	ingress2 := &extv1beta1.Ingress{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "extensions/v1beta1", Kind: "Ingress"},
+		ObjectMeta: metav1.ObjectMeta{Name: "ingress2", Namespace: "default"},
+		Spec: extv1beta1.IngressSpec{
+			TLS: []extv1beta1.IngressTLS{
+				{SecretName: "other"},
+			},
+		},
+	}
+
+	ingressesKey := store.Key{
+		Namespace:  "default",
+		APIVersion: "extensions/v1beta1",
+		Kind:       "Ingress",
+	}
+
+	cases := []struct {
+		name     string
+		secret   *corev1.Secret
+		setup    func(t *testing.T, o *storeFake.MockStore)
+		expected []*extv1beta1.Ingress
+		isErr    bool
+	}{
+		{
+			name:   "in general",
+			secret: secret,
+			setup: func(t *testing.T, o *storeFake.MockStore) {
+				o.EXPECT().
+					List(gomock.Any(), gomock.Eq(ingressesKey)).
+					Return(testutil.ToUnstructuredList(t, ingress1, ingress2), false, nil)
+			},
+			expected: []*extv1beta1.Ingress{
+				ingress1,
+			},
+		},
+		{
+			name:   "secret is nil",
+			secret: nil,
+			isErr:  true,
+		},
+		{
+			name:   "ingress list failure",
+			secret: secret,
+			setup: func(t *testing.T, o *storeFake.MockStore) {
+				o.EXPECT().
+					List(gomock.Any(), gomock.Eq(ingressesKey)).
+					Return(nil, false, errors.New("failed"))
+			},
+			isErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			o := storeFake.NewMockStore(controller)
+			discovery := queryerFake.NewMockDiscoveryInterface(controller)
+
+			if tc.setup != nil {
+				tc.setup(t, o)
+			}
+
+			oq := New(o, discovery)
+
+			ctx := context.Background()
+			got, err := oq.IngressesForSecret(ctx, tc.secret)
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
 func TestCacheQueryer_OwnerReference(t *testing.T) {
 	deployment := testutil.ToUnstructured(t, testutil.CreateDeployment("deployment"))
 	replicaSet := testutil.ToUnstructured(t, testutil.CreateAppReplicaSet("replica-set"))
@@ -0,0 +1,248 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package search
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kcache "k8s.io/client-go/tools/cache"
+
+	"github.com/kubenext/lissio/pkg/store"
+)
+
+// DefaultLimit is the maximum number of results returned when a search
+// doesn't specify a limit.
+const DefaultLimit = 50
+
+// DefaultFields are the fields indexed for a kind in addition to name,
+// namespace, labels and annotations.
+var DefaultFields = map[string][]string{
+	"Pod":                   {"spec.nodeName", "spec.serviceAccountName", "status.podIP", "status.phase"},
+	"Service":               {"spec.type", "spec.clusterIP", "spec.externalName"},
+	"Node":                  {"spec.podCIDR", "spec.providerID"},
+	"PersistentVolume":      {"spec.storageClassName"},
+	"PersistentVolumeClaim": {"spec.storageClassName", "spec.volumeName"},
+	"Secret":                {"type"},
+}
+
+// lastAppliedAnnotation is the annotation kubectl stores the applied
+// configuration in. It isn't indexed because it can hold Secret data.
+const lastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// Document is an indexed object.
+type Document struct {
+	Key         store.Key
+	Labels      map[string]string
+	Annotations map[string]string
+	Fields      map[string]string
+}
+
+// Option is an option for configuring Index.
+type Option func(index *Index)
+
+// WithFields sets the fields indexed for a kind.
+func WithFields(kind string, paths ...string) Option {
+	return func(index *Index) {
+		index.fields[kind] = paths
+	}
+}
+
+// Index is a search index of objects. It implements
+// kcache.ResourceEventHandler so it can be fed by informers.
+type Index struct {
+	fields    map[string][]string
+	documents map[store.Key]Document
+
+	mu sync.RWMutex
+}
+
+var _ kcache.ResourceEventHandler = (*Index)(nil)
+
+// NewIndex creates an instance of Index.
+func NewIndex(options ...Option) *Index {
+	index := &Index{
+		fields:    make(map[string][]string),
+		documents: make(map[store.Key]Document),
+	}
+
+	for kind, paths := range DefaultFields {
+		index.fields[kind] = paths
+	}
+
+	for _, option := range options {
+		option(index)
+	}
+
+	return index
+}
+
+// Add adds an object to the index. Objects which are already in the
+// index are replaced.
+func (i *Index) Add(object *unstructured.Unstructured) {
+	if object == nil {
+		return
+	}
+
+	document := Document{
+		Key:         documentKey(object),
+		Labels:      object.GetLabels(),
+		Annotations: indexedAnnotations(object),
+		Fields:      make(map[string]string),
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	for _, path := range i.fields[object.GetKind()] {
+		value, found, err := unstructured.NestedFieldNoCopy(object.Object, strings.Split(path, ".")...)
+		if err != nil || !found {
+			continue
+		}
+
+		switch value.(type) {
+		case string, bool, int64, float64:
+			if s := fmt.Sprint(value); s != "" {
+				document.Fields[path] = s
+			}
+		}
+	}
+
+	i.documents[document.Key] = document
+}
+
+// Remove removes an object from the index.
+func (i *Index) Remove(object *unstructured.Unstructured) {
+	if object == nil {
+		return
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	delete(i.documents, documentKey(object))
+}
+
+// RemoveGroupKind removes all objects with a group and kind from the index.
+func (i *Index) RemoveGroupKind(groupKind schema.GroupKind) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	for key := range i.documents {
+		if key.GroupVersionKind().GroupKind() == groupKind {
+			delete(i.documents, key)
+		}
+	}
+}
+
+// Reset removes all objects from the index.
+func (i *Index) Reset() {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.documents = make(map[store.Key]Document)
+}
+
+// Len returns the number of objects in the index.
+func (i *Index) Len() int {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	return len(i.documents)
+}
+
+// OnAdd adds an object from an informer.
+func (i *Index) OnAdd(obj interface{}) {
+	if object, ok := obj.(*unstructured.Unstructured); ok {
+		i.Add(object)
+	}
+}
+
+// OnUpdate updates an object from an informer.
+func (i *Index) OnUpdate(oldObj, newObj interface{}) {
+	i.OnAdd(newObj)
+}
+
+// OnDelete removes an object from an informer.
+func (i *Index) OnDelete(obj interface{}) {
+	if tombstone, ok := obj.(kcache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+
+	if object, ok := obj.(*unstructured.Unstructured); ok {
+		i.Remove(object)
+	}
+}
+
+// Search searches the index. Results are ordered by score with the best
+// match first. A limit less than one uses DefaultLimit.
+func (i *Index) Search(query string, limit int) []Result {
+	q := ParseQuery(query)
+	if q.IsEmpty() {
+		return nil
+	}
+
+	if limit < 1 {
+		limit = DefaultLimit
+	}
+
+	i.mu.RLock()
+	var results []Result
+	for _, document := range i.documents {
+		if result, ok := q.Match(document); ok {
+			results = append(results, result)
+		}
+	}
+	i.mu.RUnlock()
+
+	sort.Slice(results, func(a, b int) bool {
+		if results[a].Score != results[b].Score {
+			return results[a].Score > results[b].Score
+		}
+
+		ka, kb := results[a].Document.Key, results[b].Document.Key
+		if ka.Name != kb.Name {
+			return ka.Name < kb.Name
+		}
+		if ka.Namespace != kb.Namespace {
+			return ka.Namespace < kb.Namespace
+		}
+		return ka.Kind < kb.Kind
+	})
+
+	if len(results) > limit {
+		results = results[:limit]
+	}
+
+	return results
+}
+
+// indexedAnnotations returns the annotations indexed for an object.
+// Secret annotations aren't indexed because tools copy secret values into
+// them.
+func indexedAnnotations(object *unstructured.Unstructured) map[string]string {
+	if object.GetKind() == "Secret" {
+		return nil
+	}
+
+	annotations := object.GetAnnotations()
+	delete(annotations, lastAppliedAnnotation)
+
+	return annotations
+}
+
+func documentKey(object *unstructured.Unstructured) store.Key {
+	return store.Key{
+		Namespace:  object.GetNamespace(),
+		APIVersion: object.GetAPIVersion(),
+		Kind:       object.GetKind(),
+		Name:       object.GetName(),
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kcache "k8s.io/client-go/tools/cache"
+
+	"github.com/kubenext/lissio/internal/testutil"
+)
+
+func TestIndex_Search(t *testing.T) {
+	pod := testutil.CreatePod("nginx-6db489d4b7-x2j5k")
+	pod.Labels = map[string]string{"app": "nginx"}
+	pod.Spec.NodeName = "worker-1"
+	pod.Status.PodIP = "10.1.2.3"
+
+	service := testutil.CreateService("frontend")
+	service.Namespace = "web"
+	service.Labels = map[string]string{"app": "nginx"}
+	service.Spec.Type = corev1.ServiceTypeNodePort
+
+	deployment := testutil.CreateDeployment("backend")
+	deployment.Annotations = map[string]string{"owner": "payments-team"}
+
+	index := NewIndex()
+	index.OnAdd(testutil.ToUnstructured(t, pod))
+	index.OnAdd(testutil.ToUnstructured(t, service))
+	index.OnAdd(testutil.ToUnstructured(t, deployment))
+	require.Equal(t, 3, index.Len())
+
+	tests := []struct {
+		name     string
+		query    string
+		expected []string
+	}{
+		{name: "name prefix", query: "nginx", expected: []string{"nginx-6db489d4b7-x2j5k", "frontend"}},
+		{name: "label", query: "app=nginx", expected: []string{"frontend", "nginx-6db489d4b7-x2j5k"}},
+		{name: "field", query: "10.1.2.3", expected: []string{"nginx-6db489d4b7-x2j5k"}},
+		{name: "node", query: "worker-1", expected: []string{"nginx-6db489d4b7-x2j5k"}},
+		{name: "service type", query: "nodeport", expected: []string{"frontend"}},
+		{name: "annotation", query: "payments", expected: []string{"backend"}},
+		{name: "fuzzy", query: "bknd", expected: []string{"backend"}},
+		{name: "kind qualifier", query: "kind:service", expected: []string{"frontend"}},
+		{name: "namespace qualifier", query: "nginx ns:web", expected: []string{"frontend"}},
+		{name: "all terms match", query: "nginx payments", expected: nil},
+		{name: "empty", query: "  ", expected: nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var got []string
+			for _, result := range index.Search(test.query, 0) {
+				got = append(got, result.Document.Key.Name)
+			}
+
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func TestIndex_Search_annotations(t *testing.T) {
+	pod := testutil.CreatePod("pod")
+	pod.Annotations = map[string]string{
+		"owner":               "payments-team",
+		lastAppliedAnnotation: `{"kind":"Secret","data":{"password":"aHVudGVyMg=="}}`,
+	}
+
+	secret := testutil.CreateSecret("secret")
+	secret.Annotations = map[string]string{"owner": "payments-team"}
+
+	index := NewIndex()
+	index.Add(testutil.ToUnstructured(t, pod))
+	index.Add(testutil.ToUnstructured(t, secret))
+
+	results := index.Search("payments", 0)
+	require.Len(t, results, 1)
+	assert.Equal(t, "pod", results[0].Document.Key.Name)
+	assert.Equal(t, "annotation owner", results[0].Match)
+
+	assert.Empty(t, index.Search("aHVudGVyMg", 0))
+}
+
+func TestIndex_Search_limit(t *testing.T) {
+	index := NewIndex()
+	for _, name := range []string{"pod-a", "pod-b", "pod-c"} {
+		index.Add(testutil.ToUnstructured(t, testutil.CreatePod(name)))
+	}
+
+	results := index.Search("pod", 2)
+	require.Len(t, results, 2)
+	assert.Equal(t, "pod-a", results[0].Document.Key.Name)
+	assert.Equal(t, "pod-b", results[1].Document.Key.Name)
+}
+
+func TestIndex_updates(t *testing.T) {
+	pod := testutil.ToUnstructured(t, testutil.CreatePod("pod"))
+
+	index := NewIndex(WithFields("Pod", "spec.nodeName"))
+	index.OnAdd(pod)
+
+	updated := pod.DeepCopy()
+	require.NoError(t, unstructured.SetNestedField(updated.Object, "worker-2", "spec", "nodeName"))
+	index.OnUpdate(pod, updated)
+
+	results := index.Search("worker-2", 0)
+	require.Len(t, results, 1)
+	assert.Equal(t, "field spec.nodeName", results[0].Match)
+
+	index.OnDelete(kcache.DeletedFinalStateUnknown{Key: "namespace/pod", Obj: updated})
+	assert.Equal(t, 0, index.Len())
+
+	index.Add(pod)
+	index.RemoveGroupKind(schema.GroupKind{Kind: "Deployment", Group: "apps"})
+	assert.Equal(t, 1, index.Len())
+
+	index.RemoveGroupKind(schema.GroupKind{Kind: "Pod"})
+	assert.Equal(t, 0, index.Len())
+
+	index.Add(pod)
+	index.Reset()
+	assert.Equal(t, 0, index.Len())
+}
+
+func TestFuzzyScore(t *testing.T) {
+	assert.Equal(t, 100, FuzzyScore("nginx", "NGINX"))
+	assert.Equal(t, 80, FuzzyScore("ngi", "nginx"))
+	assert.Equal(t, 60, FuzzyScore("gin", "nginx"))
+	assert.Equal(t, 0, FuzzyScore("xyz", "nginx"))
+	assert.Equal(t, 0, FuzzyScore("", "nginx"))
+
+	subsequence := FuzzyScore("ngx", "nginx")
+	assert.True(t, subsequence > 0 && subsequence < 60, "subsequence score %d", subsequence)
+	assert.True(t, FuzzyScore("ngix", "nginx") > FuzzyScore("ngix", "nginx-ingress-controller"))
+}
@@ -0,0 +1,205 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package search
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Field weights. Matches on names are more relevant than matches on
+// labels or fields, which are more relevant than annotations.
+const (
+	weightName       = 4
+	weightLabel      = 2
+	weightField      = 2
+	weightKind       = 1
+	weightNamespace  = 1
+	weightAnnotation = 1
+)
+
+// Result is a search result.
+type Result struct {
+	Document Document
+	// Score is the relevance of the result. Higher is better.
+	Score int
+	// Match describes where the best match for the query was found, e.g.
+	// `label app`. It names the field or key, never the value.
+	Match string
+}
+
+// Query is a parsed search query. Terms are matched fuzzily against
+// a document. `kind:` and `namespace:` (or `ns:`) qualifiers restrict
+// results to a kind or namespace.
+type Query struct {
+	Terms     []string
+	Kind      string
+	Namespace string
+}
+
+// ParseQuery parses a search query.
+func ParseQuery(s string) Query {
+	var q Query
+
+	for _, token := range strings.Fields(strings.ToLower(s)) {
+		parts := strings.SplitN(token, ":", 2)
+		if len(parts) == 2 && parts[1] != "" {
+			switch parts[0] {
+			case "kind":
+				q.Kind = parts[1]
+				continue
+			case "namespace", "ns":
+				q.Namespace = parts[1]
+				continue
+			}
+		}
+
+		q.Terms = append(q.Terms, token)
+	}
+
+	return q
+}
+
+// IsEmpty returns true if the query has no terms or qualifiers.
+func (q Query) IsEmpty() bool {
+	return len(q.Terms) == 0 && q.Kind == "" && q.Namespace == ""
+}
+
+// Match matches a document against the query. Every term has to match
+// the document for it to be a result.
+func (q Query) Match(document Document) (Result, bool) {
+	key := document.Key
+
+	if q.Kind != "" && strings.ToLower(key.Kind) != q.Kind {
+		return Result{}, false
+	}
+	if q.Namespace != "" && strings.ToLower(key.Namespace) != q.Namespace {
+		return Result{}, false
+	}
+
+	result := Result{Document: document, Score: 1}
+	bestTermScore := 0
+
+	for _, term := range q.Terms {
+		score, match := matchTerm(term, document)
+		if score == 0 {
+			return Result{}, false
+		}
+
+		result.Score += score
+		if score > bestTermScore {
+			bestTermScore = score
+			result.Match = match
+		}
+	}
+
+	return result, true
+}
+
+type candidate struct {
+	value  string
+	weight int
+	match  string
+}
+
+func matchTerm(term string, document Document) (int, string) {
+	key := document.Key
+
+	candidates := []candidate{
+		{value: key.Name, weight: weightName, match: "name"},
+		{value: key.Kind, weight: weightKind, match: "kind"},
+		{value: key.Namespace, weight: weightNamespace, match: "namespace"},
+	}
+
+	candidates = append(candidates, mapCandidates(document.Labels, weightLabel, "label")...)
+	candidates = append(candidates, mapCandidates(document.Fields, weightField, "field")...)
+	candidates = append(candidates, mapCandidates(document.Annotations, weightAnnotation, "annotation")...)
+
+	best, bestMatch := 0, ""
+	for _, c := range candidates {
+		if score := FuzzyScore(term, c.value) * c.weight; score > best {
+			best, bestMatch = score, c.match
+		}
+	}
+
+	return best, bestMatch
+}
+
+// mapCandidates creates candidates for both `key=value` and the value on
+// its own so queries like `app=nginx` and `nginx` both match. Matches only
+// name the key so values aren't sent to clients. Keys are sorted so
+// results are stable.
+func mapCandidates(m map[string]string, weight int, prefix string) []candidate {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var list []candidate
+	for _, k := range keys {
+		match := fmt.Sprintf("%s %s", prefix, k)
+		list = append(list,
+			candidate{value: fmt.Sprintf("%s=%s", k, m[k]), weight: weight, match: match},
+			candidate{value: m[k], weight: weight, match: match})
+	}
+
+	return list
+}
+
+// FuzzyScore scores how well a term matches a value. Exact matches score
+// highest, followed by prefix matches, substring matches and finally
+// values which contain the term's characters in order. A score of zero
+// means the term didn't match. Terms are expected to be lower case.
+func FuzzyScore(term, value string) int {
+	if term == "" || value == "" {
+		return 0
+	}
+
+	value = strings.ToLower(value)
+
+	switch {
+	case value == term:
+		return 100
+	case strings.HasPrefix(value, term):
+		return 80
+	case strings.Contains(value, term):
+		return 60
+	}
+
+	// Subsequence match. Runs of consecutive characters score higher.
+	runes := []rune(value)
+	score := 0
+	run := 0
+	vi := 0
+	for _, r := range term {
+		found := false
+		for vi < len(runes) {
+			c := runes[vi]
+			vi++
+			if c == r {
+				found = true
+				break
+			}
+			run = 0
+		}
+		if !found {
+			return 0
+		}
+
+		run++
+		score += run
+	}
+
+	// Keep subsequence matches below substring matches.
+	score = 10 + score*20/(len(runes)+1)
+	if score > 50 {
+		score = 50
+	}
+
+	return score
+}
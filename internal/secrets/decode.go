@@ -0,0 +1,242 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package secrets
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Format is the detected format of a secret value.
+type Format string
+
+const (
+	// FormatText is plain text.
+	FormatText Format = "text"
+	// FormatJSON is a JSON document.
+	FormatJSON Format = "json"
+	// FormatPEM is one or more PEM encoded certificates.
+	FormatPEM Format = "pem"
+	// FormatDockerConfig is a docker config file.
+	FormatDockerConfig Format = "dockerconfig"
+	// FormatBinary is data which isn't printable text.
+	FormatBinary Format = "binary"
+)
+
+// Certificate describes a x509 certificate.
+type Certificate struct {
+	Subject     string
+	Issuer      string
+	DNSNames    []string
+	IPAddresses []string
+	NotBefore   time.Time
+	NotAfter    time.Time
+	IsCA        bool
+}
+
+// DaysUntilExpiry returns the number of whole days until the certificate
+// expires. It is negative if the certificate has expired.
+func (c Certificate) DaysUntilExpiry(now time.Time) int {
+	d := c.NotAfter.Sub(now)
+	days := int(d / (24 * time.Hour))
+	if d < 0 && d%(24*time.Hour) != 0 {
+		days--
+	}
+	return days
+}
+
+// SANs returns the certificate's subject alternative names.
+func (c Certificate) SANs() []string {
+	return append(append([]string{}, c.DNSNames...), c.IPAddresses...)
+}
+
+// DockerCredential is a registry credential from a docker config.
+type DockerCredential struct {
+	Registry string
+	Username string
+	Password string
+	Email    string
+}
+
+// Value is a decoded secret value.
+type Value struct {
+	Format Format
+	// Text is the value as text. JSON values are indented and binary
+	// values are base64 encoded.
+	Text              string
+	Certificates      []Certificate
+	DockerCredentials []DockerCredential
+}
+
+// Decode decodes a secret value, detecting its format from its key and contents.
+func Decode(key string, data []byte) Value {
+	if isBinary(data) {
+		return Value{
+			Format: FormatBinary,
+			Text:   base64.StdEncoding.EncodeToString(data),
+		}
+	}
+
+	value := Value{
+		Format: FormatText,
+		Text:   string(data),
+	}
+
+	if key == corev1.DockerConfigJsonKey || key == corev1.DockerConfigKey {
+		if credentials, err := ParseDockerConfig(data); err == nil {
+			value.Format = FormatDockerConfig
+			value.DockerCredentials = credentials
+			value.Text = indentJSON(data)
+			return value
+		}
+	}
+
+	if bytes.Contains(data, []byte("-----BEGIN CERTIFICATE-----")) {
+		if certificates, err := ParseCertificates(data); err == nil && len(certificates) > 0 {
+			value.Format = FormatPEM
+			value.Certificates = certificates
+			return value
+		}
+	}
+
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[') && json.Valid(trimmed) {
+		value.Format = FormatJSON
+		value.Text = indentJSON(trimmed)
+	}
+
+	return value
+}
+
+// ParseCertificates parses the PEM encoded certificates in data. Blocks
+// which aren't certificates (e.g. private keys) are skipped.
+func ParseCertificates(data []byte) ([]Certificate, error) {
+	var certificates []Certificate
+
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, errors.Wrap(err, "parse certificate")
+		}
+
+		var ips []string
+		for _, ip := range cert.IPAddresses {
+			ips = append(ips, ip.String())
+		}
+
+		certificates = append(certificates, Certificate{
+			Subject:     cert.Subject.String(),
+			Issuer:      cert.Issuer.String(),
+			DNSNames:    cert.DNSNames,
+			IPAddresses: ips,
+			NotBefore:   cert.NotBefore,
+			NotAfter:    cert.NotAfter,
+			IsCA:        cert.IsCA,
+		})
+	}
+
+	return certificates, nil
+}
+
+// ParseDockerConfig parses registry credentials from a docker config. Both the
+// .dockerconfigjson and legacy .dockercfg formats are supported.
+func ParseDockerConfig(data []byte) ([]DockerCredential, error) {
+	type entry struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+		Email    string `json:"email"`
+		Auth     string `json:"auth"`
+	}
+
+	var config struct {
+		Auths map[string]entry `json:"auths"`
+	}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, errors.Wrap(err, "decode docker config")
+	}
+
+	auths := config.Auths
+	if auths == nil {
+		// legacy .dockercfg is the auths map itself
+		if err := json.Unmarshal(data, &auths); err != nil {
+			return nil, errors.Wrap(err, "decode docker config")
+		}
+	}
+
+	var credentials []DockerCredential
+	for registry, e := range auths {
+		credential := DockerCredential{
+			Registry: registry,
+			Username: e.Username,
+			Password: e.Password,
+			Email:    e.Email,
+		}
+
+		if e.Auth != "" && (credential.Username == "" || credential.Password == "") {
+			decoded, err := base64.StdEncoding.DecodeString(e.Auth)
+			if err != nil {
+				return nil, errors.Wrapf(err, "decode auth for %s", registry)
+			}
+			parts := strings.SplitN(string(decoded), ":", 2)
+			credential.Username = parts[0]
+			if len(parts) == 2 {
+				credential.Password = parts[1]
+			}
+		}
+
+		credentials = append(credentials, credential)
+	}
+
+	sort.Slice(credentials, func(i, j int) bool {
+		return credentials[i].Registry < credentials[j].Registry
+	})
+
+	return credentials, nil
+}
+
+// isBinary returns true if data isn't valid UTF-8 or contains control characters.
+func isBinary(data []byte) bool {
+	if !utf8.Valid(data) {
+		return true
+	}
+
+	for _, r := range string(data) {
+		if unicode.IsControl(r) && !unicode.IsSpace(r) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func indentJSON(data []byte) string {
+	var out bytes.Buffer
+	if err := json.Indent(&out, data, "", "  "); err != nil {
+		return string(data)
+	}
+	return out.String()
+}
@@ -0,0 +1,155 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package secrets
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestDecode(t *testing.T) {
+	notAfter := time.Date(2030, 1, 2, 0, 0, 0, 0, time.UTC)
+	certPEM := createCertificate(t, notAfter)
+
+	tests := []struct {
+		name     string
+		key      string
+		data     []byte
+		expected Value
+	}{
+		{
+			name:     "text",
+			key:      "password",
+			data:     []byte("hunter2"),
+			expected: Value{Format: FormatText, Text: "hunter2"},
+		},
+		{
+			name:     "json",
+			key:      "config",
+			data:     []byte(`{"a":1}`),
+			expected: Value{Format: FormatJSON, Text: "{\n  \"a\": 1\n}"},
+		},
+		{
+			name:     "invalid json",
+			key:      "config",
+			data:     []byte(`{"a":`),
+			expected: Value{Format: FormatText, Text: `{"a":`},
+		},
+		{
+			name:     "binary",
+			key:      "keystore",
+			data:     []byte{0xff, 0xfe},
+			expected: Value{Format: FormatBinary, Text: "//4="},
+		},
+		{
+			name: "docker config",
+			key:  corev1.DockerConfigJsonKey,
+			data: []byte(`{"auths":{"registry.example.com":{"auth":"dXNlcjpwYXNz"}}}`),
+			expected: Value{
+				Format: FormatDockerConfig,
+				Text:   "{\n  \"auths\": {\n    \"registry.example.com\": {\n      \"auth\": \"dXNlcjpwYXNz\"\n    }\n  }\n}",
+				DockerCredentials: []DockerCredential{
+					{Registry: "registry.example.com", Username: "user", Password: "pass"},
+				},
+			},
+		},
+		{
+			name: "pem",
+			key:  corev1.TLSCertKey,
+			data: certPEM,
+			expected: Value{
+				Format: FormatPEM,
+				Text:   string(certPEM),
+				Certificates: []Certificate{
+					{
+						Subject:     "CN=example.com,O=Lissio",
+						Issuer:      "CN=example.com,O=Lissio",
+						DNSNames:    []string{"example.com", "www.example.com"},
+						IPAddresses: []string{"10.0.0.1"},
+						NotBefore:   notAfter.Add(-365 * 24 * time.Hour),
+						NotAfter:    notAfter,
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := Decode(test.key, test.data)
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func TestParseDockerConfig_legacy(t *testing.T) {
+	data := []byte(`{"b.example.com":{"username":"b","password":"bp"},"a.example.com":{"auth":"YTphcA==","email":"a@example.com"}}`)
+
+	got, err := ParseDockerConfig(data)
+	require.NoError(t, err)
+
+	expected := []DockerCredential{
+		{Registry: "a.example.com", Username: "a", Password: "ap", Email: "a@example.com"},
+		{Registry: "b.example.com", Username: "b", Password: "bp"},
+	}
+	assert.Equal(t, expected, got)
+}
+
+func TestCertificate_DaysUntilExpiry(t *testing.T) {
+	now := time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		notAfter time.Time
+		expected int
+	}{
+		{name: "future", notAfter: now.Add(36 * time.Hour), expected: 1},
+		{name: "today", notAfter: now.Add(time.Hour), expected: 0},
+		{name: "expired", notAfter: now.Add(-time.Hour), expected: -1},
+		{name: "expired days ago", notAfter: now.Add(-48 * time.Hour), expected: -2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := Certificate{NotAfter: test.notAfter}
+			assert.Equal(t, test.expected, c.DaysUntilExpiry(now))
+		})
+	}
+}
+
+func createCertificate(t *testing.T, notAfter time.Time) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject: pkix.Name{
+			CommonName:   "example.com",
+			Organization: []string{"Lissio"},
+		},
+		DNSNames:    []string{"example.com", "www.example.com"},
+		IPAddresses: []net.IP{net.ParseIP("10.0.0.1")},
+		NotBefore:   notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:    notAfter,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
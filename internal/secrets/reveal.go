@@ -0,0 +1,114 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package secrets
+
+import (
+	"sync"
+	"time"
+)
+
+// DefaultRevealDuration is how long a secret value stays revealed.
+const DefaultRevealDuration = 2 * time.Minute
+
+type revealKey struct {
+	clientID  string
+	namespace string
+	name      string
+	key       string
+}
+
+// Reveals tracks which secret values have been revealed to which
+// dashboard client. A value is only shown to the client which revealed it,
+// and reveals expire so secret values aren't left on screen.
+type Reveals struct {
+	duration time.Duration
+	revealed map[revealKey]time.Time
+	nowFn    func() time.Time
+
+	mu sync.Mutex
+}
+
+// NewReveals creates an instance of Reveals.
+func NewReveals(duration time.Duration) *Reveals {
+	return &Reveals{
+		duration: duration,
+		revealed: make(map[revealKey]time.Time),
+		nowFn:    time.Now,
+	}
+}
+
+// Reveal reveals a value in a secret to a client. Values can't be
+// revealed without a client.
+func (r *Reveals) Reveal(clientID, namespace, name, key string) {
+	if clientID == "" {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.nowFn()
+	for k, expiresAt := range r.revealed {
+		if now.After(expiresAt) {
+			delete(r.revealed, k)
+		}
+	}
+
+	r.revealed[revealKey{clientID: clientID, namespace: namespace, name: name, key: key}] = now.Add(r.duration)
+}
+
+// Hide hides a value in a secret from a client.
+func (r *Reveals) Hide(clientID, namespace, name, key string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.revealed, revealKey{clientID: clientID, namespace: namespace, name: name, key: key})
+}
+
+// Forget hides every value revealed to a client. It is called when the
+// client disconnects.
+func (r *Reveals) Forget(clientID string) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for k := range r.revealed {
+		if k.clientID == clientID {
+			delete(r.revealed, k)
+		}
+	}
+}
+
+// IsRevealed returns true if a value in a secret is revealed to a client.
+func (r *Reveals) IsRevealed(clientID, namespace, name, key string) bool {
+	if r == nil || clientID == "" {
+		return false
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	k := revealKey{clientID: clientID, namespace: namespace, name: name, key: key}
+	expiresAt, ok := r.revealed[k]
+	if !ok {
+		return false
+	}
+
+	if r.nowFn().After(expiresAt) {
+		delete(r.revealed, k)
+		return false
+	}
+
+	return true
+}
+
+// Duration returns how long a value stays revealed.
+func (r *Reveals) Duration() time.Duration {
+	return r.duration
+}
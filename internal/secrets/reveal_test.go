@@ -0,0 +1,75 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package secrets
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReveals(t *testing.T) {
+	now := time.Now()
+
+	reveals := NewReveals(time.Minute)
+	reveals.nowFn = func() time.Time { return now }
+
+	assert.False(t, reveals.IsRevealed("client", "default", "secret", "key"))
+
+	reveals.Reveal("client", "default", "secret", "key")
+	assert.True(t, reveals.IsRevealed("client", "default", "secret", "key"))
+	assert.False(t, reveals.IsRevealed("client", "default", "secret", "other"))
+	assert.False(t, reveals.IsRevealed("other-client", "default", "secret", "key"))
+	assert.False(t, reveals.IsRevealed("", "default", "secret", "key"))
+
+	now = now.Add(2 * time.Minute)
+	assert.False(t, reveals.IsRevealed("client", "default", "secret", "key"))
+
+	reveals.Reveal("client", "default", "secret", "key")
+	reveals.Hide("client", "default", "secret", "key")
+	assert.False(t, reveals.IsRevealed("client", "default", "secret", "key"))
+}
+
+func TestReveals_Forget(t *testing.T) {
+	reveals := NewReveals(time.Minute)
+
+	reveals.Reveal("client", "default", "secret", "key")
+	reveals.Reveal("client", "default", "secret", "other")
+	reveals.Reveal("other-client", "default", "secret", "key")
+
+	reveals.Forget("client")
+	assert.False(t, reveals.IsRevealed("client", "default", "secret", "key"))
+	assert.False(t, reveals.IsRevealed("client", "default", "secret", "other"))
+	assert.True(t, reveals.IsRevealed("other-client", "default", "secret", "key"))
+}
+
+func TestReveals_expired_are_removed(t *testing.T) {
+	now := time.Now()
+
+	reveals := NewReveals(time.Minute)
+	reveals.nowFn = func() time.Time { return now }
+
+	reveals.Reveal("client", "default", "secret", "key")
+
+	now = now.Add(2 * time.Minute)
+	reveals.Reveal("other-client", "default", "secret", "key")
+
+	assert.Len(t, reveals.revealed, 1)
+}
+
+func TestReveals_without_client(t *testing.T) {
+	reveals := NewReveals(time.Minute)
+
+	reveals.Reveal("", "default", "secret", "key")
+	assert.False(t, reveals.IsRevealed("", "default", "secret", "key"))
+}
+
+func TestReveals_nil(t *testing.T) {
+	var reveals *Reveals
+	assert.False(t, reveals.IsRevealed("client", "default", "secret", "key"))
+	reveals.Forget("client")
+}
@@ -0,0 +1,199 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package snapshot
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"github.com/kubenext/lissio/internal/cluster"
+	"github.com/kubenext/lissio/internal/gvk"
+)
+
+// ErrNoCluster is returned when a snapshot is asked for a client which
+// talks to a cluster.
+var ErrNoCluster = errors.New("snapshots don't have a cluster")
+
+// Client is a cluster.ClientInterface for a snapshot. Namespaces and
+// discovery are based on the objects in the snapshot. Clients which talk
+// to the cluster aren't available.
+type Client struct {
+	name       string
+	version    string
+	namespaces []string
+	resources  map[schema.GroupVersion][]metav1.APIResource
+}
+
+var _ cluster.ClientInterface = (*Client)(nil)
+
+// NewClient creates an instance of Client.
+func NewClient(name string, objects []*unstructured.Unstructured) *Client {
+	return &Client{
+		name:       name,
+		namespaces: namespaceNames(objects),
+		resources:  apiResources(objects),
+	}
+}
+
+// DefaultNamespace returns default if the snapshot has it, otherwise the
+// first namespace.
+func (c *Client) DefaultNamespace() string {
+	for _, namespace := range c.namespaces {
+		if namespace == metav1.NamespaceDefault {
+			return namespace
+		}
+	}
+
+	if len(c.namespaces) > 0 {
+		return c.namespaces[0]
+	}
+
+	return metav1.NamespaceDefault
+}
+
+// ResourceExists returns true if the snapshot has a resource.
+func (c *Client) ResourceExists(gvr schema.GroupVersionResource) bool {
+	for _, resource := range c.resources[gvr.GroupVersion()] {
+		if resource.Name == gvr.Resource {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Resource returns the resource for a group kind in the snapshot.
+func (c *Client) Resource(gk schema.GroupKind) (schema.GroupVersionResource, error) {
+	for _, groupVersion := range sortedGroupVersions(c.resources) {
+		if groupVersion.Group != gk.Group {
+			continue
+		}
+
+		for _, resource := range c.resources[groupVersion] {
+			if resource.Kind == gk.Kind {
+				return groupVersion.WithResource(resource.Name), nil
+			}
+		}
+	}
+
+	return schema.GroupVersionResource{}, &meta.NoKindMatchError{GroupKind: gk}
+}
+
+// KubernetesClient returns ErrNoCluster.
+func (c *Client) KubernetesClient() (kubernetes.Interface, error) {
+	return nil, ErrNoCluster
+}
+
+// DynamicClient returns ErrNoCluster.
+func (c *Client) DynamicClient() (dynamic.Interface, error) {
+	return nil, ErrNoCluster
+}
+
+// DiscoveryClient returns a discovery client for the resources in the snapshot.
+func (c *Client) DiscoveryClient() (discovery.DiscoveryInterface, error) {
+	return &discoveryClient{resources: c.resources, version: c.version}, nil
+}
+
+// Version returns the version of the cluster the snapshot was exported
+// from. It is blank if the snapshot doesn't record it.
+func (c *Client) Version() (string, error) {
+	return c.version, nil
+}
+
+// NamespaceClient returns a namespace client for the namespaces in the snapshot.
+func (c *Client) NamespaceClient() (cluster.NamespaceInterface, error) {
+	return &namespaceClient{
+		names:            c.namespaces,
+		initialNamespace: c.DefaultNamespace(),
+	}, nil
+}
+
+// InfoClient returns an info client which describes the snapshot.
+func (c *Client) InfoClient() (cluster.InfoInterface, error) {
+	return &info{name: c.name}, nil
+}
+
+// Close does nothing.
+func (c *Client) Close() {
+}
+
+// RESTClient returns ErrNoCluster.
+func (c *Client) RESTClient() (rest.Interface, error) {
+	return nil, ErrNoCluster
+}
+
+// RESTConfig returns an empty config.
+func (c *Client) RESTConfig() *rest.Config {
+	return &rest.Config{}
+}
+
+type namespaceClient struct {
+	names            []string
+	initialNamespace string
+}
+
+var _ cluster.NamespaceInterface = (*namespaceClient)(nil)
+
+func (n *namespaceClient) Names() ([]string, error) {
+	return n.names, nil
+}
+
+func (n *namespaceClient) InitialNamespace() string {
+	return n.initialNamespace
+}
+
+type info struct {
+	name string
+}
+
+var _ cluster.InfoInterface = (*info)(nil)
+
+func (i *info) Context() string {
+	return i.name
+}
+
+func (i *info) Cluster() string {
+	return i.name
+}
+
+func (i *info) Server() string {
+	return ""
+}
+
+func (i *info) User() string {
+	return ""
+}
+
+// namespaceNames returns the names of the namespaces in a snapshot and
+// the namespaces objects are in, sorted.
+func namespaceNames(objects []*unstructured.Unstructured) []string {
+	seen := make(map[string]bool)
+	for _, object := range objects {
+		if object.GroupVersionKind() == gvk.Namespace {
+			seen[object.GetName()] = true
+		}
+		if object.GetNamespace() != "" {
+			seen[object.GetNamespace()] = true
+		}
+	}
+
+	var names []string
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
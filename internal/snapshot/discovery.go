@@ -0,0 +1,230 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package snapshot
+
+import (
+	"sort"
+	"strings"
+
+	openapi_v2 "github.com/googleapis/gnostic/OpenAPIv2"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+
+	"github.com/kubenext/lissio/internal/gvk"
+)
+
+// discoveryClient discovers the resources in a snapshot. A snapshot
+// doesn't record which version of a group is preferred, so every version
+// is preferred.
+type discoveryClient struct {
+	resources map[schema.GroupVersion][]metav1.APIResource
+	version   string
+}
+
+var _ discovery.DiscoveryInterface = (*discoveryClient)(nil)
+
+func (d *discoveryClient) RESTClient() rest.Interface {
+	return nil
+}
+
+func (d *discoveryClient) ServerGroups() (*metav1.APIGroupList, error) {
+	groups, _ := d.groupsAndResources()
+
+	list := &metav1.APIGroupList{}
+	for _, group := range groups {
+		list.Groups = append(list.Groups, *group)
+	}
+
+	return list, nil
+}
+
+func (d *discoveryClient) ServerResourcesForGroupVersion(groupVersion string) (*metav1.APIResourceList, error) {
+	gv, err := schema.ParseGroupVersion(groupVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.resourceList(gv, false), nil
+}
+
+func (d *discoveryClient) ServerResources() ([]*metav1.APIResourceList, error) {
+	_, lists := d.groupsAndResources()
+	return lists, nil
+}
+
+func (d *discoveryClient) ServerGroupsAndResources() ([]*metav1.APIGroup, []*metav1.APIResourceList, error) {
+	groups, lists := d.groupsAndResources()
+	return groups, lists, nil
+}
+
+func (d *discoveryClient) ServerPreferredResources() ([]*metav1.APIResourceList, error) {
+	_, lists := d.groupsAndResources()
+	return lists, nil
+}
+
+func (d *discoveryClient) ServerPreferredNamespacedResources() ([]*metav1.APIResourceList, error) {
+	var lists []*metav1.APIResourceList
+	for _, groupVersion := range sortedGroupVersions(d.resources) {
+		list := d.resourceList(groupVersion, true)
+		if len(list.APIResources) > 0 {
+			lists = append(lists, list)
+		}
+	}
+
+	return lists, nil
+}
+
+func (d *discoveryClient) ServerVersion() (*version.Info, error) {
+	return &version.Info{GitVersion: d.version}, nil
+}
+
+func (d *discoveryClient) OpenAPISchema() (*openapi_v2.Document, error) {
+	return nil, errors.New("snapshots don't have an OpenAPI schema")
+}
+
+func (d *discoveryClient) groupsAndResources() ([]*metav1.APIGroup, []*metav1.APIResourceList) {
+	var groups []*metav1.APIGroup
+	var lists []*metav1.APIResourceList
+
+	byName := make(map[string]*metav1.APIGroup)
+	for _, groupVersion := range sortedGroupVersions(d.resources) {
+		group, ok := byName[groupVersion.Group]
+		if !ok {
+			group = &metav1.APIGroup{Name: groupVersion.Group}
+			byName[groupVersion.Group] = group
+			groups = append(groups, group)
+		}
+
+		versionForDiscovery := metav1.GroupVersionForDiscovery{
+			GroupVersion: groupVersion.String(),
+			Version:      groupVersion.Version,
+		}
+		group.Versions = append(group.Versions, versionForDiscovery)
+		group.PreferredVersion = versionForDiscovery
+
+		lists = append(lists, d.resourceList(groupVersion, false))
+	}
+
+	return groups, lists
+}
+
+func (d *discoveryClient) resourceList(groupVersion schema.GroupVersion, namespacedOnly bool) *metav1.APIResourceList {
+	list := &metav1.APIResourceList{
+		GroupVersion: groupVersion.String(),
+		APIResources: []metav1.APIResource{},
+	}
+
+	for _, resource := range d.resources[groupVersion] {
+		if namespacedOnly && !resource.Namespaced {
+			continue
+		}
+		list.APIResources = append(list.APIResources, resource)
+	}
+
+	return list
+}
+
+// apiResources returns the resources for the kinds of objects in a
+// snapshot and the kinds defined by its custom resource definitions.
+// Kinds are namespaced if their objects are in namespaces.
+func apiResources(objects []*unstructured.Unstructured) map[schema.GroupVersion][]metav1.APIResource {
+	byKind := make(map[schema.GroupVersionKind]*metav1.APIResource)
+
+	add := func(groupVersionKind schema.GroupVersionKind, name string, namespaced bool) {
+		if resource, ok := byKind[groupVersionKind]; ok {
+			resource.Namespaced = resource.Namespaced || namespaced
+			return
+		}
+
+		if name == "" {
+			plural, _ := meta.UnsafeGuessKindToResource(groupVersionKind)
+			name = plural.Resource
+		}
+
+		byKind[groupVersionKind] = &metav1.APIResource{
+			Name:         name,
+			SingularName: strings.ToLower(groupVersionKind.Kind),
+			Namespaced:   namespaced,
+			Kind:         groupVersionKind.Kind,
+			Verbs:        metav1.Verbs{"get", "list", "watch"},
+		}
+	}
+
+	for _, object := range objects {
+		if object.GroupVersionKind().GroupKind() == gvk.CustomResourceDefinition.GroupKind() {
+			addCustomResources(object, add)
+		}
+	}
+
+	for _, object := range objects {
+		add(object.GroupVersionKind(), "", object.GetNamespace() != "")
+	}
+
+	resources := make(map[schema.GroupVersion][]metav1.APIResource)
+	for groupVersionKind, resource := range byKind {
+		groupVersion := groupVersionKind.GroupVersion()
+		resources[groupVersion] = append(resources[groupVersion], *resource)
+	}
+
+	for groupVersion := range resources {
+		list := resources[groupVersion]
+		sort.Slice(list, func(i, j int) bool {
+			return list[i].Name < list[j].Name
+		})
+	}
+
+	return resources
+}
+
+// addCustomResources adds the kinds defined by a custom resource definition.
+func addCustomResources(crd *unstructured.Unstructured, add func(schema.GroupVersionKind, string, bool)) {
+	group, _, _ := unstructured.NestedString(crd.Object, "spec", "group")
+	kind, _, _ := unstructured.NestedString(crd.Object, "spec", "names", "kind")
+	plural, _, _ := unstructured.NestedString(crd.Object, "spec", "names", "plural")
+	scope, _, _ := unstructured.NestedString(crd.Object, "spec", "scope")
+
+	var versions []string
+	if v, _, _ := unstructured.NestedString(crd.Object, "spec", "version"); v != "" {
+		versions = append(versions, v)
+	}
+	list, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+	for i := range list {
+		m, ok := list[i].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if name, _, _ := unstructured.NestedString(m, "name"); name != "" {
+			versions = append(versions, name)
+		}
+	}
+
+	if group == "" || kind == "" {
+		return
+	}
+
+	for _, v := range versions {
+		add(schema.GroupVersionKind{Group: group, Version: v, Kind: kind}, plural, scope == "Namespaced")
+	}
+}
+
+func sortedGroupVersions(resources map[schema.GroupVersion][]metav1.APIResource) []schema.GroupVersion {
+	var list []schema.GroupVersion
+	for groupVersion := range resources {
+		list = append(list, groupVersion)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].String() < list[j].String()
+	})
+
+	return list
+}
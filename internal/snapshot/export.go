@@ -0,0 +1,338 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package snapshot
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kubenext/lissio/internal/cluster"
+	"github.com/kubenext/lissio/internal/gvk"
+	"github.com/kubenext/lissio/internal/objectstore"
+	"github.com/kubenext/lissio/pkg/store"
+)
+
+const (
+	// ManifestName is the name of the manifest in an exported snapshot.
+	ManifestName = "manifest.json"
+
+	// clusterScopedDir is the directory cluster scoped objects are
+	// exported to.
+	clusterScopedDir = "_cluster"
+)
+
+// Manifest describes where and when a snapshot was exported.
+type Manifest struct {
+	Context        string    `json:"context"`
+	Cluster        string    `json:"cluster,omitempty"`
+	Server         string    `json:"server,omitempty"`
+	ClusterVersion string    `json:"clusterVersion,omitempty"`
+	CreatedAt      time.Time `json:"createdAt"`
+	// Namespaces are the namespaces exported. Objects in all namespaces
+	// are exported if it is empty.
+	Namespaces []string `json:"namespaces,omitempty"`
+	// Kinds are the api versions and kinds exported.
+	Kinds []string `json:"kinds"`
+	// Objects is the number of objects exported.
+	Objects int `json:"objects"`
+	// SecretsRedacted is true if secret values were removed.
+	SecretsRedacted bool `json:"secretsRedacted"`
+}
+
+// ExportOptions select the objects to export.
+type ExportOptions struct {
+	// Namespaces limits namespaced objects to these namespaces. Cluster
+	// scoped objects are always exported.
+	Namespaces []string
+	// GroupVersionKinds limits objects to these kinds.
+	GroupVersionKinds []schema.GroupVersionKind
+	// RevealSecrets exports secret values. They are redacted by default.
+	RevealSecrets bool
+}
+
+// versioner is implemented by clients which know the cluster's version.
+type versioner interface {
+	Version() (string, error)
+}
+
+// Export writes the objects an object store has cached to w as a gzipped
+// tarball. It contains a manifest describing the cluster and a YAML list
+// for each kind of object in each namespace. The tarball can be opened
+// with Open.
+func Export(w io.Writer, lister objectstore.CachedObjectLister, client cluster.ClientInterface, options ExportOptions) (*Manifest, error) {
+	manifest, err := newManifest(client, options)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string][]interface{})
+	for _, object := range lister.CachedObjects() {
+		if !options.selects(object) {
+			continue
+		}
+
+		if object.GroupVersionKind() == gvk.Secret && !options.RevealSecrets {
+			redactSecret(object)
+		}
+
+		name := exportPath(object)
+		files[name] = append(files[name], object.Object)
+		manifest.Objects++
+
+		apiVersionKind := object.GetAPIVersion() + "/" + object.GetKind()
+		if !containsString(manifest.Kinds, apiVersionKind) {
+			manifest.Kinds = append(manifest.Kinds, apiVersionKind)
+		}
+	}
+
+	sort.Strings(manifest.Kinds)
+
+	gzipWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	manifestData, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "encode manifest")
+	}
+
+	if err := writeFile(tarWriter, ManifestName, manifestData, manifest.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		list := map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "List",
+			"items":      files[name],
+		}
+
+		data, err := yaml.Marshal(list)
+		if err != nil {
+			return nil, errors.Wrapf(err, "encode %s", name)
+		}
+
+		if err := writeFile(tarWriter, name, data, manifest.CreatedAt); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return nil, errors.Wrap(err, "close snapshot archive")
+	}
+
+	if err := gzipWriter.Close(); err != nil {
+		return nil, errors.Wrap(err, "close snapshot archive")
+	}
+
+	return manifest, nil
+}
+
+// newManifest creates a manifest describing the cluster the client talks to.
+func newManifest(client cluster.ClientInterface, options ExportOptions) (*Manifest, error) {
+	manifest := &Manifest{
+		CreatedAt:       time.Now().UTC().Truncate(time.Second),
+		Namespaces:      options.Namespaces,
+		Kinds:           []string{},
+		SecretsRedacted: !options.RevealSecrets,
+	}
+
+	infoClient, err := client.InfoClient()
+	if err != nil {
+		return nil, errors.Wrap(err, "get cluster info")
+	}
+
+	manifest.Context = infoClient.Context()
+	manifest.Cluster = infoClient.Cluster()
+	manifest.Server = infoClient.Server()
+
+	if v, ok := client.(versioner); ok {
+		// The version is informational. An unreachable cluster shouldn't
+		// prevent exporting what is cached.
+		if clusterVersion, err := v.Version(); err == nil {
+			manifest.ClusterVersion = clusterVersion
+		}
+	}
+
+	return manifest, nil
+}
+
+func (o ExportOptions) selects(object *unstructured.Unstructured) bool {
+	if len(o.GroupVersionKinds) > 0 {
+		found := false
+		for _, groupVersionKind := range o.GroupVersionKinds {
+			if object.GroupVersionKind() == groupVersionKind {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	if len(o.Namespaces) > 0 && object.GetNamespace() != "" {
+		return containsString(o.Namespaces, object.GetNamespace())
+	}
+
+	return true
+}
+
+// CheckSecretAccess returns an error unless the user can get the secrets
+// an export would reveal. Access is checked in each namespace the export
+// selects, or across the cluster if it doesn't select namespaces. It is the
+// same check made before a secret value is revealed in the dashboard.
+func CheckSecretAccess(ctx context.Context, access objectstore.ResourceAccess, options ExportOptions) error {
+	if !options.RevealSecrets {
+		return nil
+	}
+
+	if len(options.GroupVersionKinds) > 0 && !containsGroupVersionKind(options.GroupVersionKinds, gvk.Secret) {
+		return nil
+	}
+
+	namespaces := options.Namespaces
+	if len(namespaces) == 0 {
+		namespaces = []string{""}
+	}
+
+	for _, namespace := range namespaces {
+		key := store.Key{
+			Namespace:  namespace,
+			APIVersion: "v1",
+			Kind:       "Secret",
+		}
+
+		if err := access.HasAccess(ctx, key, "get"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func containsGroupVersionKind(list []schema.GroupVersionKind, groupVersionKind schema.GroupVersionKind) bool {
+	for _, item := range list {
+		if item == groupVersionKind {
+			return true
+		}
+	}
+
+	return false
+}
+
+// redactSecret removes the values in a secret. Keys are kept so the
+// secret can still be described. The last applied configuration is removed
+// as it can contain the values too.
+func redactSecret(object *unstructured.Unstructured) {
+	data, _, _ := unstructured.NestedMap(object.Object, "data")
+	for key := range data {
+		data[key] = ""
+	}
+	if data != nil {
+		_ = unstructured.SetNestedMap(object.Object, data, "data")
+	}
+
+	unstructured.RemoveNestedField(object.Object, "stringData")
+	objectstore.TrimObject(object)
+}
+
+// exportPath returns the path of the file an object is exported to, e.g.
+// default/deployment.v1.apps.yaml.
+func exportPath(object *unstructured.Unstructured) string {
+	dir := object.GetNamespace()
+	if dir == "" {
+		dir = clusterScopedDir
+	}
+
+	groupVersionKind := object.GroupVersionKind()
+	parts := []string{strings.ToLower(groupVersionKind.Kind), groupVersionKind.Version}
+	if groupVersionKind.Group != "" {
+		parts = append(parts, groupVersionKind.Group)
+	}
+
+	return path.Join(dir, fmt.Sprintf("%s.yaml", strings.Join(parts, ".")))
+}
+
+func writeFile(tarWriter *tar.Writer, name string, data []byte, modTime time.Time) error {
+	header := &tar.Header{
+		Name:    name,
+		Mode:    0644,
+		Size:    int64(len(data)),
+		ModTime: modTime,
+	}
+
+	if err := tarWriter.WriteHeader(header); err != nil {
+		return errors.Wrapf(err, "write %s", name)
+	}
+
+	if _, err := tarWriter.Write(data); err != nil {
+		return errors.Wrapf(err, "write %s", name)
+	}
+
+	return nil
+}
+
+func containsString(list []string, s string) bool {
+	for i := range list {
+		if list[i] == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FileName returns a file name for a snapshot described by a manifest,
+// e.g. snapshot-minikube-20191009T150405Z.tar.gz.
+func FileName(manifest *Manifest) string {
+	context := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == '.':
+			return r
+		default:
+			return '-'
+		}
+	}, manifest.Context)
+
+	parts := []string{"snapshot"}
+	if context != "" {
+		parts = append(parts, context)
+	}
+	parts = append(parts, manifest.CreatedAt.UTC().Format("20060102T150405Z"))
+
+	return strings.Join(parts, "-") + ".tar.gz"
+}
+
+// ParseKind parses an api version and kind as written in a manifest, e.g.
+// apps/v1/Deployment.
+func ParseKind(s string) (schema.GroupVersionKind, error) {
+	i := strings.LastIndex(s, "/")
+	if i < 1 || i == len(s)-1 {
+		return schema.GroupVersionKind{}, errors.Errorf("%q is not an api version and kind, e.g. apps/v1/Deployment", s)
+	}
+
+	return schema.FromAPIVersionAndKind(s[:i], s[i+1:]), nil
+}
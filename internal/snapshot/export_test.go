@@ -0,0 +1,257 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package snapshot
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kubenext/lissio/internal/gvk"
+	"github.com/kubenext/lissio/internal/objectstore"
+	"github.com/kubenext/lissio/pkg/store"
+)
+
+type objectList []*unstructured.Unstructured
+
+func (l objectList) CachedObjects() []*unstructured.Unstructured {
+	var objects []*unstructured.Unstructured
+	for _, object := range l {
+		objects = append(objects, object.DeepCopy())
+	}
+	return objects
+}
+
+func exportTestObjects() objectList {
+	secret := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata": map[string]interface{}{
+			"name":      "secret",
+			"namespace": "default",
+			"annotations": map[string]interface{}{
+				"kubectl.kubernetes.io/last-applied-configuration": `{"data":{"password":"aHVudGVyMg=="}}`,
+			},
+		},
+		"data": map[string]interface{}{
+			"password": "aHVudGVyMg==",
+		},
+	}}
+
+	pod := func(namespace, name string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": namespace,
+			},
+		}}
+	}
+
+	node := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Node",
+		"metadata": map[string]interface{}{
+			"name": "node",
+		},
+	}}
+
+	return objectList{secret, pod("default", "web"), pod("other", "db"), node}
+}
+
+func exportAndOpen(t *testing.T, options ExportOptions) (*Manifest, *Snapshot) {
+	dir, err := ioutil.TempDir("", "snapshot")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	client := NewClient("cluster", nil)
+	client.version = "v1.16.2"
+
+	path := filepath.Join(dir, "snapshot.tar.gz")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+
+	manifest, err := Export(f, exportTestObjects(), client, options)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	snap, err := Open(path)
+	require.NoError(t, err)
+
+	return manifest, snap
+}
+
+func TestExport(t *testing.T) {
+	manifest, snap := exportAndOpen(t, ExportOptions{})
+
+	assert.Equal(t, "cluster", manifest.Context)
+	assert.Equal(t, "v1.16.2", manifest.ClusterVersion)
+	assert.Equal(t, 4, manifest.Objects)
+	assert.Equal(t, []string{"v1/Node", "v1/Pod", "v1/Secret"}, manifest.Kinds)
+	assert.True(t, manifest.SecretsRedacted)
+
+	require.NotNil(t, snap.Manifest)
+	assert.Equal(t, manifest.Context, snap.Manifest.Context)
+	assert.Equal(t, "snapshot: cluster", snap.Name)
+	assert.Len(t, snap.Objects, 4)
+
+	clusterVersion, err := snap.Client.Version()
+	require.NoError(t, err)
+	assert.Equal(t, "v1.16.2", clusterVersion)
+
+	secret, found, err := snap.Store.Get(context.Background(), store.Key{Namespace: "default", APIVersion: "v1", Kind: "Secret", Name: "secret"})
+	require.NoError(t, err)
+	require.True(t, found)
+
+	password, _, err := unstructured.NestedString(secret.Object, "data", "password")
+	require.NoError(t, err)
+	assert.Equal(t, "", password)
+	assert.Empty(t, secret.GetAnnotations())
+}
+
+func TestExport_revealSecrets(t *testing.T) {
+	manifest, snap := exportAndOpen(t, ExportOptions{RevealSecrets: true})
+	assert.False(t, manifest.SecretsRedacted)
+
+	secret, found, err := snap.Store.Get(context.Background(), store.Key{Namespace: "default", APIVersion: "v1", Kind: "Secret", Name: "secret"})
+	require.NoError(t, err)
+	require.True(t, found)
+
+	password, _, err := unstructured.NestedString(secret.Object, "data", "password")
+	require.NoError(t, err)
+	assert.Equal(t, "aHVudGVyMg==", password)
+}
+
+func TestExport_selected(t *testing.T) {
+	manifest, snap := exportAndOpen(t, ExportOptions{
+		Namespaces:        []string{"default"},
+		GroupVersionKinds: []schema.GroupVersionKind{gvk.Pod, gvk.Node},
+	})
+
+	assert.Equal(t, []string{"default"}, manifest.Namespaces)
+	assert.Equal(t, []string{"v1/Node", "v1/Pod"}, manifest.Kinds)
+
+	var names []string
+	for _, object := range snap.Objects {
+		names = append(names, object.GetName())
+	}
+	assert.Equal(t, []string{"node", "web"}, names)
+}
+
+func TestFileName(t *testing.T) {
+	manifest := &Manifest{
+		Context:   "arn:aws:eks:us-west-2:1234:cluster/prod",
+		CreatedAt: time.Date(2019, 10, 9, 15, 4, 5, 0, time.UTC),
+	}
+
+	assert.Equal(t, "snapshot-arn-aws-eks-us-west-2-1234-cluster-prod-20191009T150405Z.tar.gz", FileName(manifest))
+}
+
+func TestParseKind(t *testing.T) {
+	got, err := ParseKind("apps/v1/Deployment")
+	require.NoError(t, err)
+	assert.Equal(t, gvk.Deployment, got)
+
+	got, err = ParseKind("v1/Pod")
+	require.NoError(t, err)
+	assert.Equal(t, gvk.Pod, got)
+
+	for _, s := range []string{"Pod", "/Pod", "v1/"} {
+		_, err = ParseKind(s)
+		assert.Error(t, err, s)
+	}
+}
+
+type fakeSecretAccess struct {
+	objectstore.ResourceAccess
+	denied     map[string]bool
+	namespaces []string
+}
+
+func (f *fakeSecretAccess) HasAccess(ctx context.Context, key store.Key, verb string) error {
+	if key.Kind != "Secret" || verb != "get" {
+		return errors.Errorf("unexpected access check for %s %s", verb, key)
+	}
+
+	f.namespaces = append(f.namespaces, key.Namespace)
+	if f.denied[key.Namespace] {
+		return errors.Errorf("access denied in %q", key.Namespace)
+	}
+	return nil
+}
+
+func TestCheckSecretAccess(t *testing.T) {
+	tests := []struct {
+		name       string
+		options    ExportOptions
+		denied     map[string]bool
+		namespaces []string
+		isErr      bool
+	}{
+		{
+			name:    "secrets redacted",
+			options: ExportOptions{Namespaces: []string{"default"}},
+			denied:  map[string]bool{"default": true},
+		},
+		{
+			name: "secrets not selected",
+			options: ExportOptions{
+				GroupVersionKinds: []schema.GroupVersionKind{gvk.Pod},
+				RevealSecrets:     true,
+			},
+			denied: map[string]bool{"": true},
+		},
+		{
+			name:       "cluster",
+			options:    ExportOptions{RevealSecrets: true},
+			namespaces: []string{""},
+		},
+		{
+			name: "namespaces",
+			options: ExportOptions{
+				Namespaces:        []string{"default", "kube-system"},
+				GroupVersionKinds: []schema.GroupVersionKind{gvk.Secret},
+				RevealSecrets:     true,
+			},
+			namespaces: []string{"default", "kube-system"},
+		},
+		{
+			name: "denied",
+			options: ExportOptions{
+				Namespaces:    []string{"default", "kube-system"},
+				RevealSecrets: true,
+			},
+			denied:     map[string]bool{"kube-system": true},
+			namespaces: []string{"default", "kube-system"},
+			isErr:      true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			access := &fakeSecretAccess{denied: test.denied}
+
+			err := CheckSecretAccess(context.Background(), access, test.options)
+			if test.isErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, test.namespaces, access.namespaces)
+		})
+	}
+}
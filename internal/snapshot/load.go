@@ -0,0 +1,212 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package snapshot
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utiljson "k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+const (
+	// decoderBufferSize is how far the decoder looks into a document to
+	// decide if it is YAML or JSON.
+	decoderBufferSize = 4096
+)
+
+// isArchive returns true if a path is a snapshot exported by Export.
+func isArchive(path string) bool {
+	lower := strings.ToLower(path)
+	return strings.HasSuffix(lower, ".tar.gz") || strings.HasSuffix(lower, ".tgz")
+}
+
+// loadArchive loads the objects and manifest in a snapshot exported by
+// Export.
+func loadArchive(path string) ([]*unstructured.Unstructured, *Manifest, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "open snapshot")
+	}
+	defer f.Close()
+
+	gzipReader, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "read %s", path)
+	}
+	defer gzipReader.Close()
+
+	var objects []*unstructured.Unstructured
+	var manifest *Manifest
+
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, nil, errors.Wrapf(err, "read %s", path)
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		if header.Name == ManifestName {
+			manifest = &Manifest{}
+			if err := json.NewDecoder(tarReader).Decode(manifest); err != nil {
+				return nil, nil, errors.Wrapf(err, "load %s", ManifestName)
+			}
+			continue
+		}
+
+		if !isManifest(header.Name) {
+			continue
+		}
+
+		loaded, err := decodeObjects(tarReader)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "load %s", header.Name)
+		}
+
+		objects = append(objects, loaded...)
+	}
+
+	return objects, manifest, nil
+}
+
+// loadObjects loads the objects in the YAML and JSON files in a directory
+// and its sub directories. Lists, e.g. the output of `kubectl get -o yaml`,
+// are expanded into their items.
+func loadObjects(dir string) ([]*unstructured.Unstructured, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "open snapshot")
+	}
+	if !info.IsDir() {
+		return nil, errors.Errorf("snapshot %s is not a directory", dir)
+	}
+
+	var objects []*unstructured.Unstructured
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() || !isManifest(path) {
+			return nil
+		}
+
+		loaded, err := loadFile(path)
+		if err != nil {
+			return errors.Wrapf(err, "load %s", path)
+		}
+
+		objects = append(objects, loaded...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return objects, nil
+}
+
+func isManifest(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml", ".json":
+		return true
+	default:
+		return false
+	}
+}
+
+// loadFile loads the objects in a file. A file can contain multiple YAML
+// documents.
+func loadFile(path string) ([]*unstructured.Unstructured, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return decodeObjects(f)
+}
+
+// decodeObjects decodes the objects in YAML or JSON documents.
+func decodeObjects(r io.Reader) ([]*unstructured.Unstructured, error) {
+	decoder := yaml.NewYAMLOrJSONDecoder(r, decoderBufferSize)
+
+	var objects []*unstructured.Unstructured
+	for {
+		var raw json.RawMessage
+		if err := decoder.Decode(&raw); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+
+		// The decoder would make numbers floats. Kubernetes' JSON package
+		// keeps integers as integers like objects from the API server.
+		var document map[string]interface{}
+		if err := utiljson.Unmarshal(raw, &document); err != nil {
+			return nil, err
+		}
+
+		if len(document) == 0 {
+			continue
+		}
+
+		objects = append(objects, expand(&unstructured.Unstructured{Object: document})...)
+	}
+
+	return objects, nil
+}
+
+// expand returns the items of a list or the object itself. Items without
+// a kind, which lists for a single kind may contain, are given the list's
+// kind. Documents which aren't Kubernetes objects are ignored.
+func expand(object *unstructured.Unstructured) []*unstructured.Unstructured {
+	if !object.IsList() {
+		if object.GetAPIVersion() == "" || object.GetKind() == "" {
+			return nil
+		}
+		return []*unstructured.Unstructured{object}
+	}
+
+	items, _, _ := unstructured.NestedSlice(object.Object, "items")
+	itemKind := strings.TrimSuffix(object.GetKind(), "List")
+
+	var objects []*unstructured.Unstructured
+	for i := range items {
+		m, ok := items[i].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		item := &unstructured.Unstructured{Object: m}
+		if item.GetKind() == "" && itemKind != "" {
+			item.SetKind(itemKind)
+		}
+		if item.GetAPIVersion() == "" {
+			item.SetAPIVersion(object.GetAPIVersion())
+		}
+
+		objects = append(objects, expand(item)...)
+	}
+
+	return objects
+}
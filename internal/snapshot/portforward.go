@@ -0,0 +1,57 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package snapshot
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kubenext/lissio/internal/portforward"
+)
+
+// portForwarder is a portforward.PortForwarder for a snapshot. There is
+// nothing to forward to, so it never has any port forwards.
+type portForwarder struct{}
+
+var _ portforward.PortForwarder = (*portForwarder)(nil)
+
+func (portForwarder) List(ctx context.Context) []portforward.State {
+	return nil
+}
+
+func (portForwarder) Get(id string) (portforward.State, bool) {
+	return portforward.State{}, false
+}
+
+func (portForwarder) Create(ctx context.Context, gvk schema.GroupVersionKind, name string, namespace string, remotePort uint16) (portforward.CreateResponse, error) {
+	return portforward.CreateResponse{}, errors.New("snapshots can't forward ports")
+}
+
+func (portForwarder) CreateFromRequest(ctx context.Context, r portforward.CreateRequest) (portforward.CreateResponse, error) {
+	return portforward.CreateResponse{}, errors.New("snapshots can't forward ports")
+}
+
+func (portForwarder) Find(namespace string, gvk schema.GroupVersionKind, name string) (portforward.State, error) {
+	return portforward.State{}, &notFound{}
+}
+
+func (portForwarder) Stop() {
+}
+
+func (portForwarder) StopForwarder(id string) {
+}
+
+type notFound struct{}
+
+func (e *notFound) Error() string {
+	return "port forward not found"
+}
+
+func (e *notFound) NotFound() bool {
+	return true
+}
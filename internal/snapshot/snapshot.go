@@ -0,0 +1,78 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package snapshot
+
+import (
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kubenext/lissio/internal/portforward"
+)
+
+// Snapshot is a read-only copy of a cluster's objects loaded from a
+// directory of YAML or JSON files, e.g. the output of `kubectl get -o yaml`
+// or `kubectl cluster-info dump`, or from a tarball created by Export.
+type Snapshot struct {
+	// Name describes the snapshot. It is used as the context name.
+	Name string
+	// Manifest describes the cluster the snapshot was exported from. It is
+	// nil unless the snapshot was created by Export.
+	Manifest *Manifest
+	// Objects are the objects in the snapshot.
+	Objects []*unstructured.Unstructured
+	// Store is a store for the objects.
+	Store *Store
+	// Client stands in for the cluster client.
+	Client *Client
+	// PortForwarder stands in for the port forwarder. It can't forward
+	// ports.
+	PortForwarder portforward.PortForwarder
+}
+
+// Open loads a snapshot from a directory or a tarball created by Export.
+func Open(path string) (*Snapshot, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "find snapshot")
+	}
+
+	var objects []*unstructured.Unstructured
+	var manifest *Manifest
+	if isArchive(abs) {
+		objects, manifest, err = loadArchive(abs)
+	} else {
+		objects, err = loadObjects(abs)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := NewStore(objects)
+	if err != nil {
+		return nil, errors.Wrap(err, "create snapshot store")
+	}
+
+	name := "snapshot: " + filepath.Base(abs)
+	if manifest != nil && manifest.Context != "" {
+		name = "snapshot: " + manifest.Context
+	}
+
+	client := NewClient(name, objects)
+	if manifest != nil {
+		client.version = manifest.ClusterVersion
+	}
+
+	return &Snapshot{
+		Name:          name,
+		Manifest:      manifest,
+		Objects:       objects,
+		Store:         s,
+		Client:        client,
+		PortForwarder: portForwarder{},
+	}, nil
+}
@@ -0,0 +1,120 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package snapshot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kubenext/lissio/internal/gvk"
+	"github.com/kubenext/lissio/pkg/store"
+)
+
+func TestOpen(t *testing.T) {
+	snap, err := Open("testdata/dump")
+	require.NoError(t, err)
+
+	assert.Equal(t, "snapshot: dump", snap.Name)
+	assert.Len(t, snap.Objects, 7)
+
+	ctx := context.Background()
+
+	pods, _, err := snap.Store.List(ctx, store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod"})
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 2)
+	assert.Equal(t, "web", pods.Items[0].GetName())
+	assert.Equal(t, "worker", pods.Items[1].GetName())
+
+	// Items in lists of a single kind don't have a kind.
+	service, found, err := snap.Store.Get(ctx, store.Key{Namespace: "default", APIVersion: "v1", Kind: "Service", Name: "web"})
+	require.NoError(t, err)
+	require.True(t, found)
+
+	ports, _, err := unstructured.NestedSlice(service.Object, "spec", "ports")
+	require.NoError(t, err)
+	require.Len(t, ports, 1)
+	port, _, err := unstructured.NestedInt64(ports[0].(map[string]interface{}), "port")
+	require.NoError(t, err)
+	assert.Equal(t, int64(80), port)
+}
+
+func TestOpen_missing(t *testing.T) {
+	_, err := Open("testdata/missing")
+	require.Error(t, err)
+}
+
+func TestClient(t *testing.T) {
+	snap, err := Open("testdata/dump")
+	require.NoError(t, err)
+
+	client := snap.Client
+
+	namespaceClient, err := client.NamespaceClient()
+	require.NoError(t, err)
+	names, err := namespaceClient.Names()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"default", "kube-system"}, names)
+	assert.Equal(t, "default", namespaceClient.InitialNamespace())
+
+	gvr, err := client.Resource(gvk.Deployment.GroupKind())
+	require.NoError(t, err)
+	assert.Equal(t, schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}, gvr)
+	assert.True(t, client.ResourceExists(gvr))
+
+	_, err = client.Resource(gvk.Secret.GroupKind())
+	require.Error(t, err)
+
+	_, err = client.KubernetesClient()
+	assert.Equal(t, ErrNoCluster, err)
+
+	infoClient, err := client.InfoClient()
+	require.NoError(t, err)
+	assert.Equal(t, "snapshot: dump", infoClient.Context())
+}
+
+func TestClient_DiscoveryClient(t *testing.T) {
+	snap, err := Open("testdata/dump")
+	require.NoError(t, err)
+
+	discoveryClient, err := snap.Client.DiscoveryClient()
+	require.NoError(t, err)
+
+	lists, err := discoveryClient.ServerPreferredNamespacedResources()
+	require.NoError(t, err)
+
+	namespaced := make(map[string]bool)
+	for _, list := range lists {
+		for _, resource := range list.APIResources {
+			namespaced[list.GroupVersion+"/"+resource.Name] = true
+		}
+	}
+
+	expected := map[string]bool{
+		"apps/v1/deployments":    true,
+		"example.com/v1/widgets": true,
+		"v1/pods":                true,
+		"v1/services":            true,
+	}
+	assert.Equal(t, expected, namespaced)
+
+	list, err := discoveryClient.ServerResourcesForGroupVersion("v1")
+	require.NoError(t, err)
+
+	var kinds []string
+	for _, resource := range list.APIResources {
+		kinds = append(kinds, resource.Kind)
+	}
+	assert.Equal(t, []string{"Namespace", "Node", "Pod", "Service"}, kinds)
+
+	list, err = discoveryClient.ServerResourcesForGroupVersion("unknown.example.com/v1")
+	require.NoError(t, err)
+	assert.Empty(t, list.APIResources)
+}
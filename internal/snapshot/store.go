@@ -0,0 +1,189 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package snapshot
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubenext/lissio/internal/cluster"
+	"github.com/kubenext/lissio/internal/objectstore"
+	"github.com/kubenext/lissio/pkg/store"
+)
+
+// ErrReadOnly is returned when a snapshot is changed.
+var ErrReadOnly = errors.New("snapshots are read-only")
+
+// Store is a store.Store backed by the objects in a snapshot. It can't be
+// changed.
+type Store struct {
+	objects map[store.Key]*unstructured.Unstructured
+}
+
+var _ store.Store = (*Store)(nil)
+var _ objectstore.CachedObjectLister = (*Store)(nil)
+
+// NewStore creates an instance of Store. If objects have the same key, the
+// last one is kept.
+func NewStore(objects []*unstructured.Unstructured) (*Store, error) {
+	s := &Store{
+		objects: make(map[store.Key]*unstructured.Unstructured),
+	}
+
+	for _, object := range objects {
+		key, err := store.KeyFromObject(object)
+		if err != nil {
+			return nil, err
+		}
+
+		s.objects[key] = object
+	}
+
+	return s, nil
+}
+
+// List lists objects matching a key. Objects are sorted by namespace and name.
+func (s *Store) List(ctx context.Context, key store.Key) (*unstructured.UnstructuredList, bool, error) {
+	list := &unstructured.UnstructuredList{}
+	for _, object := range s.objects {
+		if keyMatches(key, object) {
+			list.Items = append(list.Items, *object.DeepCopy())
+		}
+	}
+
+	sort.Slice(list.Items, func(i, j int) bool {
+		a, b := list.Items[i], list.Items[j]
+		if a.GetNamespace() != b.GetNamespace() {
+			return a.GetNamespace() < b.GetNamespace()
+		}
+		return a.GetName() < b.GetName()
+	})
+
+	return list, false, nil
+}
+
+// Get gets an object.
+func (s *Store) Get(ctx context.Context, key store.Key) (*unstructured.Unstructured, bool, error) {
+	object, ok := s.objects[objectKey(key)]
+	if !ok {
+		return nil, false, nil
+	}
+
+	return object.DeepCopy(), true, nil
+}
+
+// CachedObjects returns copies of the objects in the snapshot, so a
+// snapshot can be exported again. Objects are sorted by api version, kind,
+// namespace and name.
+func (s *Store) CachedObjects() []*unstructured.Unstructured {
+	var objects []*unstructured.Unstructured
+	for _, object := range s.objects {
+		objects = append(objects, object.DeepCopy())
+	}
+
+	sort.Slice(objects, func(i, j int) bool {
+		a, b := objects[i], objects[j]
+		switch {
+		case a.GetAPIVersion() != b.GetAPIVersion():
+			return a.GetAPIVersion() < b.GetAPIVersion()
+		case a.GetKind() != b.GetKind():
+			return a.GetKind() < b.GetKind()
+		case a.GetNamespace() != b.GetNamespace():
+			return a.GetNamespace() < b.GetNamespace()
+		default:
+			return a.GetName() < b.GetName()
+		}
+	})
+
+	return objects
+}
+
+// Delete returns ErrReadOnly.
+func (s *Store) Delete(ctx context.Context, key store.Key) error {
+	return ErrReadOnly
+}
+
+// Create returns ErrReadOnly.
+func (s *Store) Create(ctx context.Context, object *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	return nil, ErrReadOnly
+}
+
+// Update returns ErrReadOnly.
+func (s *Store) Update(ctx context.Context, key store.Key, updater func(*unstructured.Unstructured) error) error {
+	return ErrReadOnly
+}
+
+// Watch sends the objects matching a key to the handler as added. Objects
+// in a snapshot don't change, so there are no other events.
+func (s *Store) Watch(ctx context.Context, key store.Key, handler cache.ResourceEventHandler) error {
+	list, _, err := s.List(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	for i := range list.Items {
+		handler.OnAdd(&list.Items[i])
+	}
+
+	return nil
+}
+
+// Unwatch does nothing. Watches don't hold any resources.
+func (s *Store) Unwatch(ctx context.Context, groupVersionKinds ...schema.GroupVersionKind) error {
+	return nil
+}
+
+// UpdateClusterClient does nothing. A snapshot doesn't have a cluster.
+func (s *Store) UpdateClusterClient(ctx context.Context, client cluster.ClientInterface) error {
+	return nil
+}
+
+// RegisterOnUpdate does nothing. A snapshot is never updated.
+func (s *Store) RegisterOnUpdate(fn store.UpdateFn) {
+}
+
+// IsLoading is always false. Objects are loaded before the store is created.
+func (s *Store) IsLoading(ctx context.Context, key store.Key) bool {
+	return false
+}
+
+// objectKey returns the key an object is stored under.
+func objectKey(key store.Key) store.Key {
+	return store.Key{
+		Namespace:  key.Namespace,
+		APIVersion: key.APIVersion,
+		Kind:       key.Kind,
+		Name:       key.Name,
+	}
+}
+
+// keyMatches returns true if an object matches a key's group version kind,
+// namespace, name and label selector.
+func keyMatches(key store.Key, object *unstructured.Unstructured) bool {
+	if object.GetAPIVersion() != key.APIVersion || object.GetKind() != key.Kind {
+		return false
+	}
+
+	if key.Namespace != "" && object.GetNamespace() != key.Namespace {
+		return false
+	}
+
+	if key.Name != "" && object.GetName() != key.Name {
+		return false
+	}
+
+	if key.Selector != nil && !key.Selector.AsSelector().Matches(labels.Set(object.GetLabels())) {
+		return false
+	}
+
+	return true
+}
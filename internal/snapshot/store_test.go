@@ -0,0 +1,110 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package snapshot
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubenext/lissio/internal/log"
+	"github.com/kubenext/lissio/internal/objectstore"
+	"github.com/kubenext/lissio/internal/testutil"
+	"github.com/kubenext/lissio/pkg/store"
+)
+
+func TestStore_readOnly(t *testing.T) {
+	pod := testutil.ToUnstructured(t, testutil.CreatePod("pod"))
+	s, err := NewStore([]*unstructured.Unstructured{pod})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	key, err := store.KeyFromObject(pod)
+	require.NoError(t, err)
+
+	assert.Equal(t, ErrReadOnly, s.Delete(ctx, key))
+	_, err = s.Create(ctx, pod)
+	assert.Equal(t, ErrReadOnly, err)
+	assert.Equal(t, ErrReadOnly, s.Update(ctx, key, func(*unstructured.Unstructured) error {
+		return nil
+	}))
+
+	got, found, err := s.Get(ctx, key)
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, pod, got)
+}
+
+func TestStore_List_selector(t *testing.T) {
+	web := testutil.CreatePod("web")
+	web.Labels = map[string]string{"app": "web"}
+	worker := testutil.CreatePod("worker")
+	worker.Labels = map[string]string{"app": "worker"}
+
+	s, err := NewStore([]*unstructured.Unstructured{
+		testutil.ToUnstructured(t, worker),
+		testutil.ToUnstructured(t, web),
+	})
+	require.NoError(t, err)
+
+	key := store.Key{
+		Namespace:  web.Namespace,
+		APIVersion: "v1",
+		Kind:       "Pod",
+		Selector:   &labels.Set{"app": "web"},
+	}
+
+	list, isLoading, err := s.List(context.Background(), key)
+	require.NoError(t, err)
+	assert.False(t, isLoading)
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, "web", list.Items[0].GetName())
+}
+
+func TestStore_Watch(t *testing.T) {
+	pod := testutil.ToUnstructured(t, testutil.CreatePod("pod"))
+	s, err := NewStore([]*unstructured.Unstructured{pod})
+	require.NoError(t, err)
+
+	var added []string
+	handler := cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			added = append(added, obj.(*unstructured.Unstructured).GetName())
+		},
+	}
+
+	key := store.Key{Namespace: pod.GetNamespace(), APIVersion: "v1", Kind: "Pod"}
+	require.NoError(t, s.Watch(context.Background(), key, handler))
+	assert.Equal(t, []string{"pod"}, added)
+}
+
+func TestStore_Watcher_Subscribe(t *testing.T) {
+	pod := testutil.ToUnstructured(t, testutil.CreatePod("pod"))
+	s, err := NewStore([]*unstructured.Unstructured{pod})
+	require.NoError(t, err)
+
+	w := objectstore.NewWatcher(s, log.NopLogger())
+	key := store.Key{Namespace: pod.GetNamespace(), APIVersion: "v1", Kind: "Pod"}
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := w.Subscribe(context.Background(), key, func() {})
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("subscribe did not return")
+	}
+}
@@ -0,0 +1,53 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configdir
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/pkg/errors"
+)
+
+const name = "lissio"
+
+// Home returns the directory Lissio's configuration directory is rooted at.
+func Home() string {
+	switch runtime.GOOS {
+	case "windows":
+		return os.Getenv("LOCALAPPDATA")
+
+	case "darwin":
+		return os.Getenv("HOME")
+
+	default: // Unix
+		if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+			return dir
+		}
+	}
+	return os.Getenv("HOME")
+}
+
+// Dir returns Lissio's configuration directory rooted at home.
+func Dir(home string) string {
+	if runtime.GOOS == "windows" || os.Getenv("XDG_CONFIG_HOME") != "" {
+		return filepath.Join(home, name)
+	}
+
+	return filepath.Join(home, ".config", name)
+}
+
+// Default returns Lissio's configuration directory for the current user.
+func Default() (string, error) {
+	home := Home()
+	if home == "" {
+		// home could be blank if running in a container, so bail out...
+		return "", errors.New("unable to find configuration directory: no $HOME env var")
+	}
+
+	return Dir(home), nil
+}
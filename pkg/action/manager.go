@@ -64,6 +64,14 @@ type Alerter interface {
 	SendAlert(alert Alert)
 }
 
+// Responder is an Alerter which can also redirect the client that
+// performed an action and send it the action's result.
+type Responder interface {
+	Alerter
+	SetContentPath(contentPath string)
+	SendActionResult(actionName string, result Payload)
+}
+
 // DispatcherFunc is a function that will be dispatched to handle a payload.
 type DispatcherFunc func(ctx context.Context, alerter Alerter, payload Payload) error
 
@@ -113,9 +121,19 @@ func (m *Manager) Register(actionPath string, actionFunc DispatcherFunc) error {
 	return nil
 }
 
+// Unregister unregisters the dispatcher function for an action path.
+func (m *Manager) Unregister(actionPath string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.dispatches, actionPath)
+}
+
 // Dispatch dispatches a payload to a path.
 func (m *Manager) Dispatch(ctx context.Context, alerter Alerter, actionPath string, payload Payload) error {
+	m.mu.Lock()
 	f, ok := m.dispatches[actionPath]
+	m.mu.Unlock()
 	if !ok {
 		return &NotFoundError{Path: actionPath}
 
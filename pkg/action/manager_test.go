@@ -48,3 +48,23 @@ func TestManager(t *testing.T) {
 
 	assert.True(t, payloadRan)
 }
+
+func TestManager_Unregister(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	alerter := fake.NewMockAlerter(controller)
+
+	m := action.NewManager(log.NopLogger())
+
+	fn := func(context.Context, action.Alerter, action.Payload) error {
+		return nil
+	}
+
+	require.NoError(t, m.Register("path", fn))
+	m.Unregister("path")
+
+	ctx := context.Background()
+	err := m.Dispatch(ctx, alerter, "path", action.Payload{})
+	assert.IsType(t, &action.NotFoundError{}, err)
+}
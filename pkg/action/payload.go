@@ -72,6 +72,27 @@ func (p Payload) Uint16(key string) (uint16, error) {
 	return uint16(i), nil
 }
 
+// OptionalUint16 returns a uint16 from the payload. If the value does
+// not exist, it returns zero.
+func (p Payload) OptionalUint16(key string) (uint16, error) {
+	if _, ok := p[key]; !ok {
+		return 0, nil
+	}
+
+	return p.Uint16(key)
+}
+
+// OptionalBool returns a bool from the payload. If the value does not
+// exist, it returns false.
+func (p Payload) OptionalBool(key string) (bool, error) {
+	b, _, err := unstructured.NestedBool(p, key)
+	if err != nil {
+		return false, err
+	}
+
+	return b, nil
+}
+
 // String returns a string from the payload.
 func (p Payload) String(key string) (string, error) {
 	s, ok := p[key].(string)
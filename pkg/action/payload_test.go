@@ -235,3 +235,87 @@ func TestPayload_Uint16(t *testing.T) {
 		})
 	}
 }
+
+func TestPayload_OptionalUint16(t *testing.T) {
+	tests := []struct {
+		name     string
+		payload  Payload
+		key      string
+		isErr    bool
+		expected uint16
+	}{
+		{
+			name:     "source is int",
+			payload:  Payload{"uint16": float64(7)},
+			key:      "uint16",
+			expected: uint16(7),
+		},
+		{
+			name:     "key does not exist",
+			payload:  Payload{},
+			key:      "uint16",
+			expected: uint16(0),
+		},
+		{
+			name:    "value is not int",
+			payload: Payload{"uint16": true},
+			key:     "uint16",
+			isErr:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.payload.OptionalUint16(test.key)
+			if test.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func TestPayload_OptionalBool(t *testing.T) {
+	tests := []struct {
+		name     string
+		payload  Payload
+		key      string
+		isErr    bool
+		expected bool
+	}{
+		{
+			name:     "source is bool",
+			payload:  Payload{"bool": true},
+			key:      "bool",
+			expected: true,
+		},
+		{
+			name:     "key does not exist",
+			payload:  Payload{},
+			key:      "bool",
+			expected: false,
+		},
+		{
+			name:    "value is not bool",
+			payload: Payload{"bool": "true"},
+			key:     "bool",
+			isErr:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.payload.OptionalBool(test.key)
+			if test.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
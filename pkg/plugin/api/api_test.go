@@ -14,6 +14,8 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
 
 	"github.com/kubenext/lissio/internal/gvk"
 	"github.com/kubenext/lissio/internal/portforward"
@@ -101,6 +103,84 @@ func TestAPI(t *testing.T) {
 				assert.Equal(t, expected, got)
 			},
 		},
+		{
+			name: "create",
+			initFunc: func(t *testing.T, mocks *apiMocks) {
+				mocks.objectStore.EXPECT().
+					Create(gomock.Any(), gomock.Eq(object)).Return(object, nil)
+			},
+			doFunc: func(t *testing.T, client *api.Client) {
+				clientCtx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+				defer cancel()
+
+				got, err := client.Create(clientCtx, object)
+				require.NoError(t, err)
+
+				assert.Equal(t, object, got)
+			},
+		},
+		{
+			name: "delete",
+			initFunc: func(t *testing.T, mocks *apiMocks) {
+				mocks.objectStore.EXPECT().
+					Delete(gomock.Any(), gomock.Eq(getKey)).Return(nil)
+			},
+			doFunc: func(t *testing.T, client *api.Client) {
+				clientCtx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+				defer cancel()
+
+				require.NoError(t, client.Delete(clientCtx, getKey))
+			},
+		},
+		{
+			name: "watch",
+			initFunc: func(t *testing.T, mocks *apiMocks) {
+				object := object.DeepCopy()
+				object.SetNamespace(getKey.Namespace)
+				other := testutil.ToUnstructured(t, testutil.CreateDeployment("other"))
+				other.SetNamespace(getKey.Namespace)
+
+				mocks.objectStore.EXPECT().RegisterOnUpdate(gomock.Any())
+				mocks.objectStore.EXPECT().
+					Watch(gomock.Any(), gomock.Eq(listKey), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, key store.Key, handler cache.ResourceEventHandler) error {
+						go func() {
+							handler.OnAdd(other)
+							handler.OnAdd(object)
+
+							updated := object.DeepCopy()
+							updated.SetResourceVersion("2")
+							handler.OnUpdate(object, object)
+							handler.OnUpdate(object, updated)
+							handler.OnDelete(cache.DeletedFinalStateUnknown{Obj: updated})
+						}()
+						return nil
+					})
+			},
+			doFunc: func(t *testing.T, client *api.Client) {
+				clientCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+
+				ch, err := client.Watch(clientCtx, getKey)
+				require.NoError(t, err)
+
+				var got []api.WatchEventType
+				for event := range ch {
+					assert.Equal(t, "deployment", event.Object.GetName())
+					got = append(got, event.Type)
+					if len(got) == 3 {
+						cancel()
+					}
+				}
+
+				expected := []api.WatchEventType{
+					api.WatchEventAdded,
+					api.WatchEventModified,
+					api.WatchEventDeleted,
+				}
+				assert.Equal(t, expected, got)
+			},
+		},
 		{
 			name: "port forward",
 			initFunc: func(t *testing.T, mocks *apiMocks) {
@@ -205,6 +285,72 @@ func TestAPI(t *testing.T) {
 	}
 }
 
+func TestGRPCService_Watch(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	key := store.Key{
+		Namespace:  "default",
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+	}
+
+	existing := testutil.ToUnstructured(t, testutil.CreateDeployment("existing"))
+	existing.SetNamespace(key.Namespace)
+	added := testutil.ToUnstructured(t, testutil.CreateDeployment("added"))
+	added.SetNamespace(key.Namespace)
+
+	var handler cache.ResourceEventHandler
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().RegisterOnUpdate(gomock.Any())
+	objectStore.EXPECT().
+		Watch(gomock.Any(), key, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, key store.Key, h cache.ResourceEventHandler) error {
+			handler = h
+			return nil
+		})
+	objectStore.EXPECT().
+		List(gomock.Any(), key).
+		Return(&unstructured.UnstructuredList{Items: []unstructured.Unstructured{*existing}}, false, nil)
+
+	service := &api.GRPCService{ObjectStore: objectStore}
+
+	ctx1, cancel1 := context.WithCancel(context.Background())
+	ch1, err := service.Watch(ctx1, key)
+	require.NoError(t, err)
+
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	defer cancel2()
+	ch2, err := service.Watch(ctx2, key)
+	require.NoError(t, err)
+
+	event := <-ch2
+	assert.Equal(t, api.WatchEventAdded, event.Type)
+	assert.Equal(t, "existing", event.Object.GetName())
+
+	handler.OnAdd(added)
+
+	for _, ch := range []<-chan api.WatchEvent{ch1, ch2} {
+		event := <-ch
+		assert.Equal(t, api.WatchEventAdded, event.Type)
+		assert.Equal(t, "added", event.Object.GetName())
+	}
+
+	cancel1()
+	for range ch1 {
+	}
+
+	handler.OnDelete(added)
+
+	event = <-ch2
+	assert.Equal(t, api.WatchEventDeleted, event.Type)
+
+	cancel2()
+	for range ch2 {
+	}
+}
+
 func checkPort(t *testing.T, isListen bool, addr string) {
 	_, err := net.Listen("tcp", addr)
 	if isListen {
@@ -7,6 +7,7 @@ package api
 
 import (
 	"context"
+	"io"
 
 	"google.golang.org/grpc"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -139,6 +140,97 @@ func (c *Client) Update(ctx context.Context, object *unstructured.Unstructured)
 	return err
 }
 
+// Create creates an object in the cluster. The created object is returned.
+func (c *Client) Create(ctx context.Context, object *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	client := c.DashboardConnection.Client()
+
+	data, err := convertFromObject(object)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &proto.CreateRequest{
+		Object: data,
+	}
+
+	resp, err := client.Create(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	created, _, err := convertToObject(resp.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// Delete deletes an object from the cluster.
+func (c *Client) Delete(ctx context.Context, key store.Key) error {
+	client := c.DashboardConnection.Client()
+
+	keyRequest, err := convertFromKey(key)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Delete(ctx, keyRequest)
+	return err
+}
+
+// Watch watches objects matching a key. Objects which already exist are
+// sent as added events. The channel is closed when the context is
+// cancelled or the stream ends.
+func (c *Client) Watch(ctx context.Context, key store.Key) (<-chan WatchEvent, error) {
+	client := c.DashboardConnection.Client()
+
+	keyRequest, err := convertFromKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := client.Watch(ctx, keyRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan WatchEvent, 1)
+
+	go func() {
+		defer close(ch)
+
+		logger := log.From(ctx)
+
+		for {
+			resp, err := stream.Recv()
+			if err != nil {
+				if err != io.EOF && ctx.Err() == nil {
+					logger.Errorf("watch %s: %v", key, err)
+				}
+				return
+			}
+
+			object, found, err := convertToObject(resp.Object)
+			if err != nil {
+				logger.Errorf("watch %s: convert object: %v", key, err)
+				continue
+			}
+			if !found {
+				continue
+			}
+
+			select {
+			case ch <- WatchEvent{Type: WatchEventType(resp.Type), Object: object}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
 // PortForward creates a port forward.
 func (c *Client) PortForward(ctx context.Context, req PortForwardRequest) (PortForwardResponse, error) {
 	client := c.DashboardConnection.Client()
@@ -8,6 +8,7 @@ package api
 import (
 	"encoding/json"
 
+	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
@@ -17,12 +18,23 @@ import (
 )
 
 func convertFromKey(in store.Key) (*proto.KeyRequest, error) {
-	return &proto.KeyRequest{
+	keyRequest := &proto.KeyRequest{
 		Namespace:  in.Namespace,
 		ApiVersion: in.APIVersion,
 		Kind:       in.Kind,
 		Name:       in.Name,
-	}, nil
+	}
+
+	if in.Selector != nil && len(*in.Selector) > 0 {
+		data, err := json.Marshal(in.Selector)
+		if err != nil {
+			return nil, errors.Wrap(err, "marshal label selector")
+		}
+
+		keyRequest.LabelSelector = &wrappers.BytesValue{Value: data}
+	}
+
+	return keyRequest, nil
 }
 
 func convertToKey(in *proto.KeyRequest) (store.Key, error) {
@@ -0,0 +1,47 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kubenext/lissio/pkg/store"
+)
+
+func Test_convertKey(t *testing.T) {
+	selector := labels.Set{"app": "web"}
+
+	tests := []struct {
+		name string
+		key  store.Key
+	}{
+		{
+			name: "without selector",
+			key:  store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod", Name: "pod"},
+		},
+		{
+			name: "with selector",
+			key:  store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod", Selector: &selector},
+		},
+	}
+
+	for i := range tests {
+		test := tests[i]
+		t.Run(test.name, func(t *testing.T) {
+			keyRequest, err := convertFromKey(test.key)
+			require.NoError(t, err)
+
+			got, err := convertToKey(keyRequest)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.key, got)
+		})
+	}
+}
@@ -275,6 +275,131 @@ func (m *UpdateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdateResponse proto.InternalMessageInfo
 
+type CreateRequest struct {
+	Object               []byte   `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateRequest) Reset()         { *m = CreateRequest{} }
+func (m *CreateRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateRequest) ProtoMessage()    {}
+func (*CreateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{6}
+}
+
+func (m *CreateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateRequest.Unmarshal(m, b)
+}
+func (m *CreateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRequest.Merge(m, src)
+}
+func (m *CreateRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateRequest.Size(m)
+}
+func (m *CreateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateRequest proto.InternalMessageInfo
+
+func (m *CreateRequest) GetObject() []byte {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+type CreateResponse struct {
+	Object               []byte   `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateResponse) Reset()         { *m = CreateResponse{} }
+func (m *CreateResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateResponse) ProtoMessage()    {}
+func (*CreateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{7}
+}
+
+func (m *CreateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateResponse.Unmarshal(m, b)
+}
+func (m *CreateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateResponse.Merge(m, src)
+}
+func (m *CreateResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateResponse.Size(m)
+}
+func (m *CreateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateResponse proto.InternalMessageInfo
+
+func (m *CreateResponse) GetObject() []byte {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+type WatchEvent struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Object               []byte   `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchEvent) Reset()         { *m = WatchEvent{} }
+func (m *WatchEvent) String() string { return proto.CompactTextString(m) }
+func (*WatchEvent) ProtoMessage()    {}
+func (*WatchEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{8}
+}
+
+func (m *WatchEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchEvent.Unmarshal(m, b)
+}
+func (m *WatchEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchEvent.Marshal(b, m, deterministic)
+}
+func (m *WatchEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchEvent.Merge(m, src)
+}
+func (m *WatchEvent) XXX_Size() int {
+	return xxx_messageInfo_WatchEvent.Size(m)
+}
+func (m *WatchEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchEvent proto.InternalMessageInfo
+
+func (m *WatchEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *WatchEvent) GetObject() []byte {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
 type PortForwardRequest struct {
 	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	PodName              string   `protobuf:"bytes,2,opt,name=podName,proto3" json:"podName,omitempty"`
@@ -289,7 +414,7 @@ func (m *PortForwardRequest) Reset()         { *m = PortForwardRequest{} }
 func (m *PortForwardRequest) String() string { return proto.CompactTextString(m) }
 func (*PortForwardRequest) ProtoMessage()    {}
 func (*PortForwardRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b97678da3a35dfb, []int{6}
+	return fileDescriptor_9b97678da3a35dfb, []int{9}
 }
 
 func (m *PortForwardRequest) XXX_Unmarshal(b []byte) error {
@@ -350,7 +475,7 @@ func (m *PortForwardResponse) Reset()         { *m = PortForwardResponse{} }
 func (m *PortForwardResponse) String() string { return proto.CompactTextString(m) }
 func (*PortForwardResponse) ProtoMessage()    {}
 func (*PortForwardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b97678da3a35dfb, []int{7}
+	return fileDescriptor_9b97678da3a35dfb, []int{10}
 }
 
 func (m *PortForwardResponse) XXX_Unmarshal(b []byte) error {
@@ -396,7 +521,7 @@ func (m *CancelPortForwardRequest) Reset()         { *m = CancelPortForwardReque
 func (m *CancelPortForwardRequest) String() string { return proto.CompactTextString(m) }
 func (*CancelPortForwardRequest) ProtoMessage()    {}
 func (*CancelPortForwardRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b97678da3a35dfb, []int{8}
+	return fileDescriptor_9b97678da3a35dfb, []int{11}
 }
 
 func (m *CancelPortForwardRequest) XXX_Unmarshal(b []byte) error {
@@ -431,6 +556,9 @@ func init() {
 	proto.RegisterType((*GetResponse)(nil), "proto.GetResponse")
 	proto.RegisterType((*UpdateRequest)(nil), "proto.UpdateRequest")
 	proto.RegisterType((*UpdateResponse)(nil), "proto.UpdateResponse")
+	proto.RegisterType((*CreateRequest)(nil), "proto.CreateRequest")
+	proto.RegisterType((*CreateResponse)(nil), "proto.CreateResponse")
+	proto.RegisterType((*WatchEvent)(nil), "proto.WatchEvent")
 	proto.RegisterType((*PortForwardRequest)(nil), "proto.PortForwardRequest")
 	proto.RegisterType((*PortForwardResponse)(nil), "proto.PortForwardResponse")
 	proto.RegisterType((*CancelPortForwardRequest)(nil), "proto.CancelPortForwardRequest")
@@ -439,37 +567,42 @@ func init() {
 func init() { proto.RegisterFile("dashboard.proto", fileDescriptor_9b97678da3a35dfb) }
 
 var fileDescriptor_9b97678da3a35dfb = []byte{
-	// 476 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xdd, 0x6e, 0xd3, 0x30,
-	0x14, 0x56, 0xfa, 0xab, 0x9e, 0xb6, 0xc0, 0x5c, 0x40, 0x26, 0xa0, 0x51, 0x45, 0x43, 0xf4, 0x02,
-	0x65, 0x62, 0x88, 0x7b, 0x18, 0xa5, 0x13, 0x02, 0x4d, 0x28, 0x88, 0xdd, 0x70, 0xe5, 0x24, 0x87,
-	0x11, 0x48, 0x6d, 0x63, 0xbb, 0x9a, 0xfa, 0x1a, 0xbc, 0x0b, 0x8f, 0xc3, 0xbb, 0xa0, 0xd8, 0x8e,
-	0xda, 0xac, 0x9d, 0xb4, 0xab, 0xf8, 0x7c, 0xe7, 0x3b, 0x27, 0xe7, 0x7c, 0x9f, 0x0d, 0x77, 0x73,
-	0xa6, 0x7f, 0xa4, 0x82, 0xa9, 0x3c, 0x96, 0x4a, 0x18, 0x41, 0xba, 0xf6, 0x13, 0x1e, 0x5e, 0x0a,
-	0x71, 0x59, 0xe2, 0xb1, 0x8d, 0xd2, 0xd5, 0xf7, 0xe3, 0x2b, 0xc5, 0xa4, 0x44, 0xa5, 0x1d, 0x2d,
-	0xea, 0x43, 0xf7, 0xfd, 0x52, 0x9a, 0x75, 0xf4, 0x37, 0x00, 0xf8, 0x88, 0xeb, 0x04, 0x7f, 0xaf,
-	0x50, 0x1b, 0xf2, 0x04, 0x06, 0x9c, 0x2d, 0x51, 0x4b, 0x96, 0x21, 0x0d, 0xa6, 0xc1, 0x6c, 0x90,
-	0x6c, 0x00, 0x72, 0x08, 0xc0, 0x64, 0x71, 0x81, 0x4a, 0x17, 0x82, 0xd3, 0x96, 0x4d, 0x6f, 0x21,
-	0x84, 0x40, 0xe7, 0x57, 0xc1, 0x73, 0xda, 0xb6, 0x19, 0x7b, 0xae, 0xb0, 0xaa, 0x01, 0xed, 0x38,
-	0xac, 0x3a, 0x93, 0xb7, 0x30, 0x2e, 0x59, 0x8a, 0xe5, 0x17, 0x2c, 0x31, 0x33, 0x42, 0xd1, 0xee,
-	0x34, 0x98, 0x0d, 0x4f, 0x1e, 0xc7, 0x6e, 0xea, 0xb8, 0x9e, 0x3a, 0x3e, 0x5d, 0x1b, 0xd4, 0x17,
-	0xac, 0x5c, 0x61, 0xd2, 0xac, 0x88, 0x66, 0x30, 0xfa, 0x54, 0x68, 0x93, 0xa0, 0x96, 0x82, 0x6b,
-	0x24, 0x14, 0xfa, 0x22, 0xfd, 0x89, 0x99, 0xd1, 0x34, 0x98, 0xb6, 0x67, 0xa3, 0xa4, 0x0e, 0xa3,
-	0x67, 0x30, 0x3c, 0xc3, 0x0d, 0xf1, 0x21, 0xf4, 0x5c, 0xc6, 0xae, 0x37, 0x4a, 0x7c, 0x14, 0x3d,
-	0x87, 0xf1, 0x57, 0x99, 0x33, 0x83, 0xb5, 0x14, 0x37, 0x11, 0xef, 0xc1, 0x9d, 0x9a, 0xe8, 0x5a,
-	0x46, 0x7f, 0x02, 0x20, 0x9f, 0x85, 0x32, 0x0b, 0xa1, 0xae, 0x98, 0xca, 0x6f, 0xa7, 0x25, 0x85,
-	0xbe, 0x14, 0xf9, 0x79, 0x25, 0x8d, 0x13, 0xb2, 0x0e, 0xc9, 0x11, 0x8c, 0x33, 0xc1, 0x0d, 0x2b,
-	0x38, 0x2a, 0x9b, 0x77, 0x72, 0x36, 0xc1, 0xca, 0x0b, 0x29, 0x94, 0x39, 0x5f, 0x2d, 0x53, 0x54,
-	0x56, 0xdd, 0x71, 0xb2, 0x85, 0x44, 0xdf, 0x60, 0xd2, 0x98, 0xc9, 0xaf, 0x7f, 0x04, 0x63, 0xb9,
-	0x81, 0x3f, 0xcc, 0xfd, 0x60, 0x4d, 0xf0, 0x5a, 0xf3, 0xd6, 0x4e, 0xf3, 0x37, 0x40, 0xdf, 0x31,
-	0x9e, 0x61, 0xb9, 0x67, 0xed, 0x5b, 0xfd, 0xe1, 0xe4, 0x5f, 0x0b, 0x06, 0xf3, 0xfa, 0xee, 0x92,
-	0x18, 0x3a, 0x95, 0x9b, 0xe4, 0xc0, 0x59, 0x1f, 0x6f, 0x6e, 0x64, 0x38, 0xf1, 0x50, 0xc3, 0xed,
-	0x17, 0xd0, 0x3e, 0xc3, 0xbd, 0x74, 0xe2, 0xa1, 0x6d, 0xcb, 0x5f, 0x43, 0xcf, 0x39, 0x46, 0xee,
-	0xfb, 0x6c, 0xc3, 0xe9, 0xf0, 0xc1, 0x35, 0xd4, 0x97, 0xcd, 0x61, 0xb8, 0xb5, 0x1e, 0x79, 0xe4,
-	0x59, 0xbb, 0x2b, 0x87, 0xe1, 0xbe, 0x94, 0xef, 0x72, 0x0a, 0x07, 0x3b, 0x52, 0x91, 0xa7, 0xbe,
-	0xe0, 0x26, 0x11, 0xc3, 0x91, 0x27, 0xd8, 0x47, 0x4a, 0x5e, 0xc2, 0x64, 0x21, 0x54, 0x86, 0x0b,
-	0x25, 0xb8, 0x41, 0x9e, 0xfb, 0x6d, 0x1a, 0xa4, 0x66, 0x49, 0xda, 0xb3, 0xc1, 0xab, 0xff, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x1a, 0x8c, 0xe2, 0x3b, 0x21, 0x04, 0x00, 0x00,
+	// 547 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xed, 0x6e, 0xd3, 0x30,
+	0x14, 0x55, 0xd6, 0x2f, 0xf5, 0xb6, 0x1d, 0xcc, 0x65, 0x28, 0x04, 0x34, 0xaa, 0x68, 0x88, 0x22,
+	0xa1, 0x14, 0x86, 0x90, 0xf8, 0x09, 0x5b, 0xd7, 0x09, 0x81, 0x26, 0x14, 0xc4, 0xf8, 0xc1, 0x2f,
+	0x27, 0xb9, 0x6c, 0x85, 0x34, 0x36, 0x8e, 0xcb, 0xd4, 0xd7, 0xe0, 0x5d, 0x78, 0x2f, 0x1e, 0x01,
+	0xc5, 0xb1, 0x49, 0xdd, 0x76, 0xa2, 0xbf, 0xea, 0x7b, 0xee, 0x39, 0xee, 0xb5, 0xcf, 0x71, 0xe0,
+	0x56, 0x42, 0xf3, 0xab, 0x88, 0x51, 0x91, 0x04, 0x5c, 0x30, 0xc9, 0x48, 0x43, 0xfd, 0x78, 0x07,
+	0x97, 0x8c, 0x5d, 0xa6, 0x38, 0x52, 0x55, 0x34, 0xff, 0x3a, 0xba, 0x16, 0x94, 0x73, 0x14, 0x79,
+	0x49, 0xf3, 0x5b, 0xd0, 0x38, 0x9d, 0x71, 0xb9, 0xf0, 0x7f, 0x3b, 0x00, 0xef, 0x70, 0x11, 0xe2,
+	0x8f, 0x39, 0xe6, 0x92, 0x3c, 0x80, 0x76, 0x46, 0x67, 0x98, 0x73, 0x1a, 0xa3, 0xeb, 0x0c, 0x9c,
+	0x61, 0x3b, 0xac, 0x00, 0x72, 0x00, 0x40, 0xf9, 0xf4, 0x02, 0x45, 0x3e, 0x65, 0x99, 0xbb, 0xa3,
+	0xda, 0x4b, 0x08, 0x21, 0x50, 0xff, 0x3e, 0xcd, 0x12, 0xb7, 0xa6, 0x3a, 0x6a, 0x5d, 0x60, 0xc5,
+	0x06, 0x6e, 0xbd, 0xc4, 0x8a, 0x35, 0x79, 0x03, 0xbd, 0x94, 0x46, 0x98, 0x7e, 0xc4, 0x14, 0x63,
+	0xc9, 0x84, 0xdb, 0x18, 0x38, 0xc3, 0xce, 0xd1, 0xfd, 0xa0, 0x9c, 0x3a, 0x30, 0x53, 0x07, 0xc7,
+	0x0b, 0x89, 0xf9, 0x05, 0x4d, 0xe7, 0x18, 0xda, 0x0a, 0x7f, 0x08, 0xdd, 0xf7, 0xd3, 0x5c, 0x86,
+	0x98, 0x73, 0x96, 0xe5, 0x48, 0x5c, 0x68, 0xb1, 0xe8, 0x1b, 0xc6, 0x32, 0x77, 0x9d, 0x41, 0x6d,
+	0xd8, 0x0d, 0x4d, 0xe9, 0x3f, 0x82, 0xce, 0x19, 0x56, 0xc4, 0xbb, 0xd0, 0x2c, 0x3b, 0xea, 0x78,
+	0xdd, 0x50, 0x57, 0xfe, 0x63, 0xe8, 0x7d, 0xe2, 0x09, 0x95, 0x68, 0xae, 0xe2, 0x26, 0xe2, 0x6d,
+	0xd8, 0x35, 0xc4, 0x72, 0xcb, 0x42, 0x7a, 0x22, 0x70, 0x0b, 0xe9, 0x10, 0x76, 0x0d, 0xf1, 0x3f,
+	0xd3, 0xbc, 0x02, 0xf8, 0x4c, 0x65, 0x7c, 0x75, 0xfa, 0x13, 0x33, 0x59, 0xdc, 0xa1, 0x5c, 0x70,
+	0x63, 0x88, 0x5a, 0x2f, 0x29, 0x77, 0x2c, 0xe5, 0x2f, 0x07, 0xc8, 0x07, 0x26, 0xe4, 0x84, 0x89,
+	0x6b, 0x2a, 0x92, 0xed, 0x8c, 0x75, 0xa1, 0xc5, 0x59, 0x72, 0x5e, 0xf8, 0x54, 0xba, 0x6a, 0x4a,
+	0x72, 0x08, 0xbd, 0x98, 0x65, 0x92, 0x4e, 0x33, 0x14, 0xaa, 0x5f, 0x7a, 0x6b, 0x83, 0x45, 0x30,
+	0x38, 0x13, 0xf2, 0x7c, 0x3e, 0x8b, 0x50, 0x28, 0xab, 0x7b, 0xe1, 0x12, 0xe2, 0x7f, 0x81, 0xbe,
+	0x35, 0x93, 0x3e, 0xfd, 0x21, 0xf4, 0x78, 0x05, 0xbf, 0x1d, 0xeb, 0xc1, 0x6c, 0x70, 0x65, 0xf3,
+	0x9d, 0xb5, 0xcd, 0x5f, 0x83, 0x7b, 0x42, 0xb3, 0x18, 0xd3, 0x0d, 0xc7, 0xde, 0xea, 0x1f, 0x8e,
+	0xfe, 0xd4, 0xa0, 0x3d, 0x36, 0x0f, 0x89, 0x04, 0x50, 0x2f, 0xa2, 0x45, 0xf6, 0xca, 0x1c, 0x06,
+	0xd5, 0xf3, 0xf0, 0xfa, 0x1a, 0xb2, 0xa2, 0xf7, 0x14, 0x6a, 0x67, 0xb8, 0x91, 0x4e, 0x34, 0xb4,
+	0x9c, 0xbf, 0x97, 0xd0, 0x2c, 0xe3, 0x43, 0xee, 0xe8, 0xae, 0x15, 0x3b, 0x6f, 0x7f, 0x05, 0xad,
+	0x64, 0x65, 0x74, 0xfe, 0xc9, 0xac, 0xc8, 0x79, 0xfb, 0x2b, 0xa8, 0x96, 0x3d, 0x81, 0xe6, 0x18,
+	0x53, 0x94, 0xb8, 0x69, 0xbc, 0xae, 0x86, 0xd4, 0x97, 0x80, 0x8c, 0xa0, 0xa1, 0x22, 0xb7, 0x89,
+	0x69, 0xa0, 0x2a, 0x93, 0xcf, 0x1c, 0x32, 0x86, 0xce, 0xd2, 0x8d, 0x93, 0x7b, 0x9a, 0xb3, 0xee,
+	0x82, 0xe7, 0x6d, 0x6a, 0xe9, 0x09, 0x8f, 0x61, 0x6f, 0xcd, 0x3d, 0xf2, 0xd0, 0x9c, 0xe6, 0x06,
+	0x5f, 0x57, 0x46, 0x7f, 0x0e, 0xfd, 0x09, 0x13, 0x31, 0x4e, 0x04, 0xcb, 0x24, 0x66, 0x89, 0xbe,
+	0x60, 0x8b, 0x64, 0x4b, 0xa2, 0xa6, 0x2a, 0x5e, 0xfc, 0x1d, 0x00, 0x17, 0x14, 0x47, 0x7b, 0x41,
+	0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -487,6 +620,9 @@ type DashboardClient interface {
 	List(ctx context.Context, in *KeyRequest, opts ...grpc.CallOption) (*ListResponse, error)
 	Get(ctx context.Context, in *KeyRequest, opts ...grpc.CallOption) (*GetResponse, error)
 	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
+	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error)
+	Delete(ctx context.Context, in *KeyRequest, opts ...grpc.CallOption) (*Empty, error)
+	Watch(ctx context.Context, in *KeyRequest, opts ...grpc.CallOption) (Dashboard_WatchClient, error)
 	PortForward(ctx context.Context, in *PortForwardRequest, opts ...grpc.CallOption) (*PortForwardResponse, error)
 	CancelPortForward(ctx context.Context, in *CancelPortForwardRequest, opts ...grpc.CallOption) (*Empty, error)
 	ForceFrontendUpdate(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
@@ -527,6 +663,56 @@ func (c *dashboardClient) Update(ctx context.Context, in *UpdateRequest, opts ..
 	return out, nil
 }
 
+func (c *dashboardClient) Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error) {
+	out := new(CreateResponse)
+	err := c.cc.Invoke(ctx, "/proto.Dashboard/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dashboardClient) Delete(ctx context.Context, in *KeyRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/proto.Dashboard/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dashboardClient) Watch(ctx context.Context, in *KeyRequest, opts ...grpc.CallOption) (Dashboard_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Dashboard_serviceDesc.Streams[0], "/proto.Dashboard/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dashboardWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Dashboard_WatchClient interface {
+	Recv() (*WatchEvent, error)
+	grpc.ClientStream
+}
+
+type dashboardWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *dashboardWatchClient) Recv() (*WatchEvent, error) {
+	m := new(WatchEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *dashboardClient) PortForward(ctx context.Context, in *PortForwardRequest, opts ...grpc.CallOption) (*PortForwardResponse, error) {
 	out := new(PortForwardResponse)
 	err := c.cc.Invoke(ctx, "/proto.Dashboard/PortForward", in, out, opts...)
@@ -559,6 +745,9 @@ type DashboardServer interface {
 	List(context.Context, *KeyRequest) (*ListResponse, error)
 	Get(context.Context, *KeyRequest) (*GetResponse, error)
 	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
+	Create(context.Context, *CreateRequest) (*CreateResponse, error)
+	Delete(context.Context, *KeyRequest) (*Empty, error)
+	Watch(*KeyRequest, Dashboard_WatchServer) error
 	PortForward(context.Context, *PortForwardRequest) (*PortForwardResponse, error)
 	CancelPortForward(context.Context, *CancelPortForwardRequest) (*Empty, error)
 	ForceFrontendUpdate(context.Context, *Empty) (*Empty, error)
@@ -577,6 +766,15 @@ func (*UnimplementedDashboardServer) Get(ctx context.Context, req *KeyRequest) (
 func (*UnimplementedDashboardServer) Update(ctx context.Context, req *UpdateRequest) (*UpdateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
+func (*UnimplementedDashboardServer) Create(ctx context.Context, req *CreateRequest) (*CreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (*UnimplementedDashboardServer) Delete(ctx context.Context, req *KeyRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (*UnimplementedDashboardServer) Watch(req *KeyRequest, srv Dashboard_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
 func (*UnimplementedDashboardServer) PortForward(ctx context.Context, req *PortForwardRequest) (*PortForwardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PortForward not implemented")
 }
@@ -645,6 +843,63 @@ func _Dashboard_Update_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dashboard_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Dashboard/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardServer).Create(ctx, req.(*CreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dashboard_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Dashboard/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardServer).Delete(ctx, req.(*KeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dashboard_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(KeyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DashboardServer).Watch(m, &dashboardWatchServer{stream})
+}
+
+type Dashboard_WatchServer interface {
+	Send(*WatchEvent) error
+	grpc.ServerStream
+}
+
+type dashboardWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *dashboardWatchServer) Send(m *WatchEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Dashboard_PortForward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PortForwardRequest)
 	if err := dec(in); err != nil {
@@ -715,6 +970,14 @@ var _Dashboard_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Update",
 			Handler:    _Dashboard_Update_Handler,
 		},
+		{
+			MethodName: "Create",
+			Handler:    _Dashboard_Create_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _Dashboard_Delete_Handler,
+		},
 		{
 			MethodName: "PortForward",
 			Handler:    _Dashboard_PortForward_Handler,
@@ -728,6 +991,12 @@ var _Dashboard_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Dashboard_ForceFrontendUpdate_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _Dashboard_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "dashboard.proto",
 }
@@ -7,9 +7,12 @@ package api
 
 import (
 	"context"
+	"sync"
 
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	kcache "k8s.io/client-go/tools/cache"
 
 	"github.com/kubenext/lissio/internal/gvk"
 	"github.com/kubenext/lissio/internal/portforward"
@@ -31,10 +34,34 @@ type PortForwardResponse struct {
 	Port uint16
 }
 
+// WatchEventType is the type of a watch event.
+type WatchEventType string
+
+const (
+	// WatchEventAdded is an event for an added object.
+	WatchEventAdded WatchEventType = "ADDED"
+	// WatchEventModified is an event for a modified object.
+	WatchEventModified WatchEventType = "MODIFIED"
+	// WatchEventDeleted is an event for a deleted object.
+	WatchEventDeleted WatchEventType = "DELETED"
+)
+
+// WatchEvent is an event for a watched object.
+type WatchEvent struct {
+	Type   WatchEventType
+	Object *unstructured.Unstructured
+}
+
 // Service is the dashboard service.
 type Service interface {
 	List(ctx context.Context, key store.Key) (*unstructured.UnstructuredList, error)
 	Get(ctx context.Context, key store.Key) (*unstructured.Unstructured, bool, error)
+	Create(ctx context.Context, object *unstructured.Unstructured) (*unstructured.Unstructured, error)
+	Delete(ctx context.Context, key store.Key) error
+	// Watch watches objects matching a key. Objects which already exist
+	// are sent as added events. The channel is closed when the context
+	// is cancelled.
+	Watch(ctx context.Context, key store.Key) (<-chan WatchEvent, error)
 	PortForward(ctx context.Context, req PortForwardRequest) (PortForwardResponse, error)
 	CancelPortForward(ctx context.Context, id string)
 	Update(ctx context.Context, object *unstructured.Unstructured) error
@@ -65,6 +92,9 @@ type GRPCService struct {
 	ObjectStore   store.Store
 	PortForwarder portforward.PortForwarder
 	FrontendProxy FrontendProxy
+
+	watchesOnce sync.Once
+	watches     *watchHub
 }
 
 var _ Service = (*GRPCService)(nil)
@@ -81,6 +111,312 @@ func (s *GRPCService) Get(ctx context.Context, key store.Key) (*unstructured.Uns
 	return s.ObjectStore.Get(ctx, key)
 }
 
+// Create creates an object.
+func (s *GRPCService) Create(ctx context.Context, object *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	return s.ObjectStore.Create(ctx, object)
+}
+
+// Delete deletes an object.
+func (s *GRPCService) Delete(ctx context.Context, key store.Key) error {
+	return s.ObjectStore.Delete(ctx, key)
+}
+
+// Watch watches objects matching a key.
+func (s *GRPCService) Watch(ctx context.Context, key store.Key) (<-chan WatchEvent, error) {
+	s.watchesOnce.Do(func() {
+		s.watches = newWatchHub(s.ObjectStore)
+	})
+
+	return s.watches.watch(ctx, key)
+}
+
+// watchHub fans out object store events to watchers. Handlers can't be
+// removed from informers, so the hub adds one handler for each namespace
+// and group version kind and forwards its events to the current watchers.
+type watchHub struct {
+	objectStore store.Store
+	registered  map[store.Key]bool
+	// registerMu serializes adding handlers to the store. Stores may
+	// send existing objects to a handler before Watch returns, so it is
+	// separate from mu.
+	registerMu sync.Mutex
+
+	watchers map[store.Key]map[*watcher]bool
+	mu       sync.Mutex
+}
+
+func newWatchHub(objectStore store.Store) *watchHub {
+	h := &watchHub{
+		objectStore: objectStore,
+		registered:  make(map[store.Key]bool),
+		watchers:    make(map[store.Key]map[*watcher]bool),
+	}
+
+	objectStore.RegisterOnUpdate(h.reset)
+
+	return h
+}
+
+// watch creates a watcher for objects matching key. The watcher is
+// removed when ctx is cancelled.
+func (h *watchHub) watch(ctx context.Context, key store.Key) (<-chan WatchEvent, error) {
+	hubKey := watchKey(key)
+	w := newWatcher(ctx, key)
+
+	h.registerMu.Lock()
+	defer h.registerMu.Unlock()
+
+	h.add(hubKey, w)
+
+	if h.registered[hubKey] {
+		// Objects which already exist were sent to other watchers when
+		// the handler was added, so they are listed for this one. They
+		// are queued ahead of events received since it was added.
+		list, _, err := h.objectStore.List(ctx, hubKey)
+		if err != nil {
+			h.remove(hubKey, w)
+			return nil, errors.Wrapf(err, "list %s", key)
+		}
+
+		w.addExisting(list)
+	} else if err := h.register(hubKey); err != nil {
+		h.remove(hubKey, w)
+		return nil, err
+	}
+
+	go func() {
+		w.run()
+		h.remove(hubKey, w)
+	}()
+
+	return w.ch, nil
+}
+
+// register adds a handler for a key to the store. The handler outlives
+// the watcher which caused it to be added, so it isn't tied to the
+// watcher's context. The caller must hold registerMu.
+func (h *watchHub) register(key store.Key) error {
+	if err := h.objectStore.Watch(context.Background(), key, h.handler(key)); err != nil {
+		return errors.Wrapf(err, "watch %s", key)
+	}
+
+	h.registered[key] = true
+	return nil
+}
+
+func (h *watchHub) add(key store.Key, w *watcher) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.watchers[key] == nil {
+		h.watchers[key] = make(map[*watcher]bool)
+	}
+	h.watchers[key][w] = true
+}
+
+func (h *watchHub) remove(key store.Key, w *watcher) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.watchers[key], w)
+	if len(h.watchers[key]) == 0 {
+		delete(h.watchers, key)
+	}
+}
+
+// handler creates a handler which forwards events for a key to its
+// watchers. Resyncs which don't change an object are ignored.
+func (h *watchHub) handler(key store.Key) kcache.ResourceEventHandler {
+	return kcache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			h.notify(key, WatchEventAdded, obj)
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldObject, ok := oldObj.(*unstructured.Unstructured)
+			newObject, ok2 := newObj.(*unstructured.Unstructured)
+			if ok && ok2 && oldObject.GetResourceVersion() == newObject.GetResourceVersion() {
+				return
+			}
+			h.notify(key, WatchEventModified, newObj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			h.notify(key, WatchEventDeleted, obj)
+		},
+	}
+}
+
+func (h *watchHub) notify(key store.Key, eventType WatchEventType, obj interface{}) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for w := range h.watchers[key] {
+		w.add(eventType, obj)
+	}
+}
+
+// reset adds handlers to an updated store. Informers are recreated when
+// the cluster client changes, so the old handlers are gone. Watchers
+// whose handler can't be added again are stopped.
+func (h *watchHub) reset(objectStore store.Store) {
+	h.registerMu.Lock()
+	defer h.registerMu.Unlock()
+
+	h.objectStore = objectStore
+	h.registered = make(map[store.Key]bool)
+
+	h.mu.Lock()
+	var keys []store.Key
+	for key := range h.watchers {
+		keys = append(keys, key)
+	}
+	h.mu.Unlock()
+
+	for _, key := range keys {
+		if err := h.register(key); err != nil {
+			h.stop(key)
+		}
+	}
+}
+
+func (h *watchHub) stop(key store.Key) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for w := range h.watchers[key] {
+		w.stop()
+	}
+}
+
+// watchKey returns the key a handler is registered for. Handlers receive
+// every object of a kind in a namespace and watchers filter them.
+func watchKey(key store.Key) store.Key {
+	return store.Key{
+		Namespace:  key.Namespace,
+		APIVersion: key.APIVersion,
+		Kind:       key.Kind,
+	}
+}
+
+// watcher sends events for objects matching its key to a channel until
+// its context is cancelled. Events are queued so a slow reader doesn't
+// hold up the store's handler or other watchers.
+type watcher struct {
+	ctx   context.Context
+	stop  context.CancelFunc
+	key   store.Key
+	ch    chan WatchEvent
+	ready chan struct{}
+	queue []WatchEvent
+
+	mu sync.Mutex
+}
+
+func newWatcher(ctx context.Context, key store.Key) *watcher {
+	ctx, cancel := context.WithCancel(ctx)
+
+	return &watcher{
+		ctx:   ctx,
+		stop:  cancel,
+		key:   key,
+		ch:    make(chan WatchEvent, 1),
+		ready: make(chan struct{}, 1),
+	}
+}
+
+// run sends queued events to the channel. The channel is closed when the
+// watcher's context is cancelled.
+func (w *watcher) run() {
+	defer close(w.ch)
+
+	for {
+		w.mu.Lock()
+		events := w.queue
+		w.queue = nil
+		w.mu.Unlock()
+
+		for _, event := range events {
+			select {
+			case w.ch <- event:
+			case <-w.ctx.Done():
+				return
+			}
+		}
+
+		select {
+		case <-w.ready:
+		case <-w.ctx.Done():
+			return
+		}
+	}
+}
+
+// addExisting queues added events for objects which existed before the
+// watcher was created. They are sent before any queued events.
+func (w *watcher) addExisting(list *unstructured.UnstructuredList) {
+	if list == nil {
+		return
+	}
+
+	var events []WatchEvent
+	for i := range list.Items {
+		object := &list.Items[i]
+		if keyMatches(w.key, object) {
+			events = append(events, WatchEvent{Type: WatchEventAdded, Object: object})
+		}
+	}
+
+	w.mu.Lock()
+	w.queue = append(events, w.queue...)
+	w.mu.Unlock()
+
+	w.signal()
+}
+
+// add queues an event if its object matches the watcher's key.
+func (w *watcher) add(eventType WatchEventType, obj interface{}) {
+	if tombstone, ok := obj.(kcache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+
+	object, ok := obj.(*unstructured.Unstructured)
+	if !ok || !keyMatches(w.key, object) {
+		return
+	}
+
+	w.mu.Lock()
+	w.queue = append(w.queue, WatchEvent{Type: eventType, Object: object})
+	w.mu.Unlock()
+
+	w.signal()
+}
+
+func (w *watcher) signal() {
+	select {
+	case w.ready <- struct{}{}:
+	default:
+	}
+}
+
+// keyMatches returns true if an object matches a key's namespace, name
+// and label selector. Informers can be shared across namespaces, so
+// objects have to be filtered.
+func keyMatches(key store.Key, object *unstructured.Unstructured) bool {
+	if key.Namespace != "" && object.GetNamespace() != key.Namespace {
+		return false
+	}
+
+	if key.Name != "" && object.GetName() != key.Name {
+		return false
+	}
+
+	if key.Selector != nil && !key.Selector.AsSelector().Matches(labels.Set(object.GetLabels())) {
+		return false
+	}
+
+	return true
+}
+
+// Update updates an object.
 func (s *GRPCService) Update(ctx context.Context, object *unstructured.Unstructured) error {
 	key, err := store.KeyFromObject(object)
 	if err != nil {
@@ -199,6 +535,76 @@ func (c *grpcServer) Update(ctx context.Context, in *proto.UpdateRequest) (*prot
 	return &proto.UpdateResponse{}, nil
 }
 
+// Create creates an object.
+func (c *grpcServer) Create(ctx context.Context, in *proto.CreateRequest) (*proto.CreateResponse, error) {
+	object, found, err := convertToObject(in.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, errors.New("can't create an empty object")
+	}
+
+	created, err := c.service.Create(ctx, object)
+	if err != nil {
+		return nil, err
+	}
+
+	encodedObject, err := convertFromObject(created)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.CreateResponse{Object: encodedObject}, nil
+}
+
+// Delete deletes an object.
+func (c *grpcServer) Delete(ctx context.Context, in *proto.KeyRequest) (*proto.Empty, error) {
+	key, err := convertToKey(in)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.service.Delete(ctx, key); err != nil {
+		return nil, err
+	}
+
+	return &proto.Empty{}, nil
+}
+
+// Watch streams events for objects matching a key until the client
+// cancels the stream.
+func (c *grpcServer) Watch(in *proto.KeyRequest, stream proto.Dashboard_WatchServer) error {
+	key, err := convertToKey(in)
+	if err != nil {
+		return err
+	}
+
+	events, err := c.service.Watch(stream.Context(), key)
+	if err != nil {
+		return err
+	}
+
+	for event := range events {
+		encodedObject, err := convertFromObject(event.Object)
+		if err != nil {
+			return err
+		}
+
+		out := &proto.WatchEvent{
+			Type:   string(event.Type),
+			Object: encodedObject,
+		}
+
+		if err := stream.Send(out); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // PortForward creates a port forward.
 func (c *grpcServer) PortForward(ctx context.Context, in *proto.PortForwardRequest) (*proto.PortForwardResponse, error) {
 	req, err := convertToPortForwardRequest(in)
@@ -13,6 +13,7 @@ import (
 
 	"github.com/kubenext/lissio/pkg/action"
 	"github.com/kubenext/lissio/pkg/navigation"
+	"github.com/kubenext/lissio/pkg/store"
 	"github.com/kubenext/lissio/pkg/view/component"
 )
 
@@ -33,6 +34,10 @@ type Capabilities struct {
 	SupportsObjectStatus []schema.GroupVersionKind `json:",omitempty"`
 	// SupportsTab are the GVKs the plugin will create an additional tab for.
 	SupportsTab []schema.GroupVersionKind `json:",omitempty"`
+	// SupportsObjectVisitor are the GVKs the plugin will find related objects for.
+	SupportsObjectVisitor []schema.GroupVersionKind `json:",omitempty"`
+	// SupportsListColumns are the columns the plugin will add to lists of objects.
+	SupportsListColumns []ListColumns `json:",omitempty"`
 	// IsModule is true this plugin is a module.
 	IsModule bool `json:",omitempty"`
 	// ActionNames is a list of action names this plugin handles
@@ -52,6 +57,34 @@ func (c Capabilities) HasTabSupport(gvk schema.GroupVersionKind) bool {
 	return includesGVK(gvk, c.SupportsTab)
 }
 
+// HasObjectVisitorSupport returns true if this plugin finds related objects
+// for the supplied GVK.
+func (c Capabilities) HasObjectVisitorSupport(gvk schema.GroupVersionKind) bool {
+	return includesGVK(gvk, c.SupportsObjectVisitor)
+}
+
+// ListColumnsFor returns the columns this plugin adds to lists of objects
+// with the supplied GVK.
+func (c Capabilities) ListColumnsFor(gvk schema.GroupVersionKind) []string {
+	var columns []string
+
+	for _, lc := range c.SupportsListColumns {
+		if lc.GroupVersionKind == gvk {
+			columns = append(columns, lc.Columns...)
+		}
+	}
+
+	return columns
+}
+
+// ListColumns are columns a plugin adds to lists of objects with a GVK.
+type ListColumns struct {
+	// GroupVersionKind is the GVK of the listed objects.
+	GroupVersionKind schema.GroupVersionKind
+	// Columns are the names of the columns.
+	Columns []string
+}
+
 // PrintResponse is a printer response from the plugin. The dashboard
 // will use this to the add the plugin's output to a summary view.
 type PrintResponse struct {
@@ -76,11 +109,38 @@ type ObjectStatusResponse struct {
 	ObjectStatus component.PodSummary
 }
 
+// RelatedObjectsResponse is a related objects response from a plugin.
+type RelatedObjectsResponse struct {
+	// Objects are references to objects related to the object. The
+	// dashboard will add them to the object's resource viewer.
+	Objects []store.Key
+}
+
+// ListColumnsResponse is a list columns response from a plugin.
+type ListColumnsResponse struct {
+	// Rows are the cells for each object keyed by column name. They are in
+	// the same order as the objects the plugin was called with.
+	Rows []component.TableRow
+}
+
+// ActionResponse is the response from a plugin action.
+type ActionResponse struct {
+	// Alerts are alerts shown to the user who performed the action.
+	Alerts []action.Alert
+	// Result is the result of the action. It is sent to the client which
+	// performed the action.
+	Result action.Payload
+	// Redirect is a content path the client will be redirected to.
+	Redirect string
+}
+
 // Metadata is plugin metadata.
 type Metadata struct {
 	Name         string
 	Description  string
 	Capabilities Capabilities
+	// Settings are the settings the plugin can be configured with.
+	Settings []SettingField `json:",omitempty"`
 }
 
 // Service is the interface that is exposed as a plugin. The plugin is required to implement this
@@ -90,7 +150,10 @@ type Service interface {
 	Print(ctx context.Context, object runtime.Object) (PrintResponse, error)
 	PrintTab(ctx context.Context, object runtime.Object) (TabResponse, error)
 	ObjectStatus(ctx context.Context, object runtime.Object) (ObjectStatusResponse, error)
-	HandleAction(ctx context.Context, payload action.Payload) error
+	RelatedObjects(ctx context.Context, object runtime.Object) (RelatedObjectsResponse, error)
+	HandleAction(ctx context.Context, payload action.Payload) (ActionResponse, error)
+	UpdateSettings(ctx context.Context, settings Settings) error
+	ListColumns(ctx context.Context, objects []runtime.Object) (ListColumnsResponse, error)
 }
 
 // ModuleService is the interface that is exposed as a plugin as a module. The plugin is required to implement this
@@ -77,3 +77,48 @@ func TestCapabilities_HasTabSupport(t *testing.T) {
 		})
 	}
 }
+
+func TestCapabilities_HasObjectVisitorSupport(t *testing.T) {
+	cases := []struct {
+		name         string
+		in           schema.GroupVersionKind
+		capabilities Capabilities
+		hasSupport   bool
+	}{
+		{
+			name: "with object visitor support",
+			in:   gvk.Pod,
+			capabilities: Capabilities{
+				SupportsObjectVisitor: []schema.GroupVersionKind{gvk.Pod},
+			},
+			hasSupport: true,
+		},
+		{
+			name: "with out object visitor support",
+			in:   gvk.Deployment,
+			capabilities: Capabilities{
+				SupportsObjectVisitor: []schema.GroupVersionKind{gvk.Pod},
+			},
+			hasSupport: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.hasSupport, tc.capabilities.HasObjectVisitorSupport(tc.in))
+		})
+	}
+}
+
+func TestCapabilities_ListColumnsFor(t *testing.T) {
+	capabilities := Capabilities{
+		SupportsListColumns: []ListColumns{
+			{GroupVersionKind: gvk.Pod, Columns: []string{"Cost"}},
+			{GroupVersionKind: gvk.Deployment, Columns: []string{"Owner Team"}},
+			{GroupVersionKind: gvk.Pod, Columns: []string{"Owner Team"}},
+		},
+	}
+
+	assert.Equal(t, []string{"Cost", "Owner Team"}, capabilities.ListColumnsFor(gvk.Pod))
+	assert.Empty(t, capabilities.ListColumnsFor(gvk.Service))
+}
@@ -7,11 +7,14 @@ package plugin
 
 import (
 	"encoding/json"
+	"time"
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	"github.com/kubenext/lissio/pkg/action"
 	"github.com/kubenext/lissio/pkg/navigation"
 	"github.com/kubenext/lissio/pkg/plugin/dashboard"
+	"github.com/kubenext/lissio/pkg/store"
 	"github.com/kubenext/lissio/pkg/view/component"
 )
 
@@ -26,6 +29,8 @@ func convertToCapabilities(in *dashboard.RegisterResponse_Capabilities) Capabili
 		SupportsPrinterItems:  convertToGroupVersionKindList(in.SupportsPrinterItems),
 		SupportsObjectStatus:  convertToGroupVersionKindList(in.SupportsObjectStatus),
 		SupportsTab:           convertToGroupVersionKindList(in.SupportsTab),
+		SupportsObjectVisitor: convertToGroupVersionKindList(in.SupportsObjectVisitor),
+		SupportsListColumns:   convertToListColumns(in.SupportsListColumns),
 		IsModule:              in.IsModule,
 		ActionNames:           in.ActionNames,
 	}
@@ -40,6 +45,8 @@ func convertFromCapabilities(in Capabilities) dashboard.RegisterResponse_Capabil
 		SupportsPrinterItems:  convertFromGroupVersionKindList(in.SupportsPrinterItems),
 		SupportsObjectStatus:  convertFromGroupVersionKindList(in.SupportsObjectStatus),
 		SupportsTab:           convertFromGroupVersionKindList(in.SupportsTab),
+		SupportsObjectVisitor: convertFromGroupVersionKindList(in.SupportsObjectVisitor),
+		SupportsListColumns:   convertFromListColumns(in.SupportsListColumns),
 		IsModule:              in.IsModule,
 		ActionNames:           in.ActionNames,
 	}
@@ -47,6 +54,73 @@ func convertFromCapabilities(in Capabilities) dashboard.RegisterResponse_Capabil
 	return c
 }
 
+func convertToListColumns(in []*dashboard.RegisterResponse_ListColumns) []ListColumns {
+	var list []ListColumns
+
+	for _, lc := range in {
+		if lc == nil || lc.GroupVersionKind == nil {
+			continue
+		}
+
+		list = append(list, ListColumns{
+			GroupVersionKind: convertToGroupVersionKind(*lc.GroupVersionKind),
+			Columns:          lc.Columns,
+		})
+	}
+
+	return list
+}
+
+func convertFromListColumns(in []ListColumns) []*dashboard.RegisterResponse_ListColumns {
+	var list []*dashboard.RegisterResponse_ListColumns
+
+	for _, lc := range in {
+		gvk := convertFromGroupVersionKind(lc.GroupVersionKind)
+		list = append(list, &dashboard.RegisterResponse_ListColumns{
+			GroupVersionKind: &gvk,
+			Columns:          lc.Columns,
+		})
+	}
+
+	return list
+}
+
+func convertToSettingFields(in []*dashboard.RegisterResponse_SettingField) []SettingField {
+	var list []SettingField
+
+	for _, field := range in {
+		if field == nil {
+			continue
+		}
+
+		list = append(list, SettingField{
+			Name:        field.Name,
+			Label:       field.Label,
+			Type:        SettingType(field.Type),
+			Default:     field.Default,
+			Description: field.Description,
+		})
+	}
+
+	return list
+}
+
+func convertFromSettingFields(in []SettingField) []*dashboard.RegisterResponse_SettingField {
+	var list []*dashboard.RegisterResponse_SettingField
+
+	for _, field := range in {
+		list = append(list, &dashboard.RegisterResponse_SettingField{
+			Name:        field.Name,
+			Label:       field.Label,
+			Type:        string(field.Type),
+			Default:     field.Default,
+			Description: field.Description,
+		})
+	}
+
+	return list
+}
+
 func convertToGroupVersionKindList(in []*dashboard.RegisterResponse_GroupVersionKind) []schema.GroupVersionKind {
 	var list []schema.GroupVersionKind
 
@@ -176,3 +250,104 @@ func convertFromSummarySection(in component.SummarySection) (*dashboard.PrintRes
 		Component: data,
 	}, nil
 }
+
+// convertToActionResponse converts a handle action response. Alert
+// expirations are sent as Unix time in nanoseconds.
+func convertToActionResponse(in *dashboard.HandleActionResponse) (ActionResponse, error) {
+	var out ActionResponse
+	if in == nil {
+		return out, nil
+	}
+
+	for _, alert := range in.Alerts {
+		if alert == nil {
+			continue
+		}
+
+		a := action.Alert{
+			Type:    action.AlertType(alert.Type),
+			Message: alert.Message,
+		}
+		if alert.Expiration > 0 {
+			expiration := time.Unix(0, alert.Expiration)
+			a.Expiration = &expiration
+		}
+
+		out.Alerts = append(out.Alerts, a)
+	}
+
+	if len(in.Result) > 0 {
+		if err := json.Unmarshal(in.Result, &out.Result); err != nil {
+			return ActionResponse{}, err
+		}
+	}
+
+	out.Redirect = in.Redirect
+
+	return out, nil
+}
+
+func convertFromActionResponse(in ActionResponse) (*dashboard.HandleActionResponse, error) {
+	out := &dashboard.HandleActionResponse{
+		Redirect: in.Redirect,
+	}
+
+	for _, alert := range in.Alerts {
+		a := &dashboard.HandleActionResponse_Alert{
+			Type:    string(alert.Type),
+			Message: alert.Message,
+		}
+		if alert.Expiration != nil {
+			a.Expiration = alert.Expiration.UnixNano()
+		}
+
+		out.Alerts = append(out.Alerts, a)
+	}
+
+	if in.Result != nil {
+		data, err := json.Marshal(in.Result)
+		if err != nil {
+			return nil, err
+		}
+		out.Result = data
+	}
+
+	return out, nil
+}
+
+func convertToRelatedObjectsResponse(in *dashboard.RelatedObjectsResponse) RelatedObjectsResponse {
+	var out RelatedObjectsResponse
+	if in == nil {
+		return out
+	}
+
+	for _, ref := range in.Objects {
+		if ref == nil {
+			continue
+		}
+
+		out.Objects = append(out.Objects, store.Key{
+			Namespace:  ref.Namespace,
+			APIVersion: ref.ApiVersion,
+			Kind:       ref.Kind,
+			Name:       ref.Name,
+		})
+	}
+
+	return out
+}
+
+func convertFromRelatedObjectsResponse(in RelatedObjectsResponse) *dashboard.RelatedObjectsResponse {
+	out := &dashboard.RelatedObjectsResponse{}
+
+	for _, key := range in.Objects {
+		out.Objects = append(out.Objects, &dashboard.RelatedObjectsResponse_ObjectReference{
+			ApiVersion: key.APIVersion,
+			Kind:       key.Kind,
+			Namespace:  key.Namespace,
+			Name:       key.Name,
+		})
+	}
+
+	return out
+}
@@ -173,9 +173,12 @@ func (m *HandleActionRequest) GetPayload() []byte {
 }
 
 type HandleActionResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Alerts               []*HandleActionResponse_Alert `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	Result               []byte                        `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	Redirect             string                        `protobuf:"bytes,3,opt,name=redirect,proto3" json:"redirect,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
 }
 
 func (m *HandleActionResponse) Reset()         { *m = HandleActionResponse{} }
@@ -203,6 +206,82 @@ func (m *HandleActionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HandleActionResponse proto.InternalMessageInfo
 
+func (m *HandleActionResponse) GetAlerts() []*HandleActionResponse_Alert {
+	if m != nil {
+		return m.Alerts
+	}
+	return nil
+}
+
+func (m *HandleActionResponse) GetResult() []byte {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *HandleActionResponse) GetRedirect() string {
+	if m != nil {
+		return m.Redirect
+	}
+	return ""
+}
+
+type HandleActionResponse_Alert struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Expiration           int64    `protobuf:"varint,3,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HandleActionResponse_Alert) Reset()         { *m = HandleActionResponse_Alert{} }
+func (m *HandleActionResponse_Alert) String() string { return proto.CompactTextString(m) }
+func (*HandleActionResponse_Alert) ProtoMessage()    {}
+func (*HandleActionResponse_Alert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{4, 0}
+}
+
+func (m *HandleActionResponse_Alert) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HandleActionResponse_Alert.Unmarshal(m, b)
+}
+func (m *HandleActionResponse_Alert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HandleActionResponse_Alert.Marshal(b, m, deterministic)
+}
+func (m *HandleActionResponse_Alert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandleActionResponse_Alert.Merge(m, src)
+}
+func (m *HandleActionResponse_Alert) XXX_Size() int {
+	return xxx_messageInfo_HandleActionResponse_Alert.Size(m)
+}
+func (m *HandleActionResponse_Alert) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandleActionResponse_Alert.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandleActionResponse_Alert proto.InternalMessageInfo
+
+func (m *HandleActionResponse_Alert) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *HandleActionResponse_Alert) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *HandleActionResponse_Alert) GetExpiration() int64 {
+	if m != nil {
+		return m.Expiration
+	}
+	return 0
+}
+
 type NavigationRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -384,12 +463,13 @@ func (m *RegisterRequest) GetDashboardAPIAddress() string {
 }
 
 type RegisterResponse struct {
-	PluginName           string                         `protobuf:"bytes,1,opt,name=pluginName,proto3" json:"pluginName,omitempty"`
-	Description          string                         `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Capabilities         *RegisterResponse_Capabilities `protobuf:"bytes,3,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
-	XXX_unrecognized     []byte                         `json:"-"`
-	XXX_sizecache        int32                          `json:"-"`
+	PluginName           string                           `protobuf:"bytes,1,opt,name=pluginName,proto3" json:"pluginName,omitempty"`
+	Description          string                           `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Capabilities         *RegisterResponse_Capabilities   `protobuf:"bytes,3,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+	Settings             []*RegisterResponse_SettingField `protobuf:"bytes,4,rep,name=settings,proto3" json:"settings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
 }
 
 func (m *RegisterResponse) Reset()         { *m = RegisterResponse{} }
@@ -438,6 +518,13 @@ func (m *RegisterResponse) GetCapabilities() *RegisterResponse_Capabilities {
 	return nil
 }
 
+func (m *RegisterResponse) GetSettings() []*RegisterResponse_SettingField {
+	if m != nil {
+		return m.Settings
+	}
+	return nil
+}
+
 type RegisterResponse_GroupVersionKind struct {
 	Group                string   `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
 	Version              string   `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
@@ -501,6 +588,8 @@ type RegisterResponse_Capabilities struct {
 	SupportsTab           []*RegisterResponse_GroupVersionKind `protobuf:"bytes,5,rep,name=supportsTab,proto3" json:"supportsTab,omitempty"`
 	IsModule              bool                                 `protobuf:"varint,6,opt,name=isModule,proto3" json:"isModule,omitempty"`
 	ActionNames           []string                             `protobuf:"bytes,7,rep,name=action_names,json=actionNames,proto3" json:"action_names,omitempty"`
+	SupportsObjectVisitor []*RegisterResponse_GroupVersionKind `protobuf:"bytes,8,rep,name=supportsObjectVisitor,proto3" json:"supportsObjectVisitor,omitempty"`
+	SupportsListColumns   []*RegisterResponse_ListColumns      `protobuf:"bytes,9,rep,name=supportsListColumns,proto3" json:"supportsListColumns,omitempty"`
 	XXX_NoUnkeyedLiteral  struct{}                             `json:"-"`
 	XXX_unrecognized      []byte                               `json:"-"`
 	XXX_sizecache         int32                                `json:"-"`
@@ -580,6 +669,138 @@ func (m *RegisterResponse_Capabilities) GetActionNames() []string {
 	return nil
 }
 
+func (m *RegisterResponse_Capabilities) GetSupportsObjectVisitor() []*RegisterResponse_GroupVersionKind {
+	if m != nil {
+		return m.SupportsObjectVisitor
+	}
+	return nil
+}
+
+func (m *RegisterResponse_Capabilities) GetSupportsListColumns() []*RegisterResponse_ListColumns {
+	if m != nil {
+		return m.SupportsListColumns
+	}
+	return nil
+}
+
+type RegisterResponse_SettingField struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Label                string   `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Type                 string   `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Default              string   `protobuf:"bytes,4,opt,name=default,proto3" json:"default,omitempty"`
+	Description          string   `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisterResponse_SettingField) Reset()         { *m = RegisterResponse_SettingField{} }
+func (m *RegisterResponse_SettingField) String() string { return proto.CompactTextString(m) }
+func (*RegisterResponse_SettingField) ProtoMessage()    {}
+func (*RegisterResponse_SettingField) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{8, 2}
+}
+
+func (m *RegisterResponse_SettingField) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterResponse_SettingField.Unmarshal(m, b)
+}
+func (m *RegisterResponse_SettingField) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterResponse_SettingField.Marshal(b, m, deterministic)
+}
+func (m *RegisterResponse_SettingField) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterResponse_SettingField.Merge(m, src)
+}
+func (m *RegisterResponse_SettingField) XXX_Size() int {
+	return xxx_messageInfo_RegisterResponse_SettingField.Size(m)
+}
+func (m *RegisterResponse_SettingField) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterResponse_SettingField.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterResponse_SettingField proto.InternalMessageInfo
+
+func (m *RegisterResponse_SettingField) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RegisterResponse_SettingField) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *RegisterResponse_SettingField) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *RegisterResponse_SettingField) GetDefault() string {
+	if m != nil {
+		return m.Default
+	}
+	return ""
+}
+
+func (m *RegisterResponse_SettingField) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type RegisterResponse_ListColumns struct {
+	GroupVersionKind     *RegisterResponse_GroupVersionKind `protobuf:"bytes,1,opt,name=groupVersionKind,proto3" json:"groupVersionKind,omitempty"`
+	Columns              []string                           `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
+}
+
+func (m *RegisterResponse_ListColumns) Reset()         { *m = RegisterResponse_ListColumns{} }
+func (m *RegisterResponse_ListColumns) String() string { return proto.CompactTextString(m) }
+func (*RegisterResponse_ListColumns) ProtoMessage()    {}
+func (*RegisterResponse_ListColumns) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{8, 3}
+}
+
+func (m *RegisterResponse_ListColumns) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterResponse_ListColumns.Unmarshal(m, b)
+}
+func (m *RegisterResponse_ListColumns) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterResponse_ListColumns.Marshal(b, m, deterministic)
+}
+func (m *RegisterResponse_ListColumns) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterResponse_ListColumns.Merge(m, src)
+}
+func (m *RegisterResponse_ListColumns) XXX_Size() int {
+	return xxx_messageInfo_RegisterResponse_ListColumns.Size(m)
+}
+func (m *RegisterResponse_ListColumns) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterResponse_ListColumns.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterResponse_ListColumns proto.InternalMessageInfo
+
+func (m *RegisterResponse_ListColumns) GetGroupVersionKind() *RegisterResponse_GroupVersionKind {
+	if m != nil {
+		return m.GroupVersionKind
+	}
+	return nil
+}
+
+func (m *RegisterResponse_ListColumns) GetColumns() []string {
+	if m != nil {
+		return m.Columns
+	}
+	return nil
+}
+
 type ObjectRequest struct {
 	Object               []byte   `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -807,6 +1028,227 @@ func (m *ObjectStatusResponse) GetObjectStatus() []byte {
 	return nil
 }
 
+type RelatedObjectsResponse struct {
+	Objects              []*RelatedObjectsResponse_ObjectReference `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *RelatedObjectsResponse) Reset()         { *m = RelatedObjectsResponse{} }
+func (m *RelatedObjectsResponse) String() string { return proto.CompactTextString(m) }
+func (*RelatedObjectsResponse) ProtoMessage()    {}
+func (*RelatedObjectsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{13}
+}
+
+func (m *RelatedObjectsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RelatedObjectsResponse.Unmarshal(m, b)
+}
+func (m *RelatedObjectsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RelatedObjectsResponse.Marshal(b, m, deterministic)
+}
+func (m *RelatedObjectsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelatedObjectsResponse.Merge(m, src)
+}
+func (m *RelatedObjectsResponse) XXX_Size() int {
+	return xxx_messageInfo_RelatedObjectsResponse.Size(m)
+}
+func (m *RelatedObjectsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelatedObjectsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelatedObjectsResponse proto.InternalMessageInfo
+
+func (m *RelatedObjectsResponse) GetObjects() []*RelatedObjectsResponse_ObjectReference {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+type RelatedObjectsResponse_ObjectReference struct {
+	ApiVersion           string   `protobuf:"bytes,1,opt,name=apiVersion,proto3" json:"apiVersion,omitempty"`
+	Kind                 string   `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Namespace            string   `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name                 string   `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RelatedObjectsResponse_ObjectReference) Reset() {
+	*m = RelatedObjectsResponse_ObjectReference{}
+}
+func (m *RelatedObjectsResponse_ObjectReference) String() string { return proto.CompactTextString(m) }
+func (*RelatedObjectsResponse_ObjectReference) ProtoMessage()    {}
+func (*RelatedObjectsResponse_ObjectReference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{13, 0}
+}
+
+func (m *RelatedObjectsResponse_ObjectReference) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RelatedObjectsResponse_ObjectReference.Unmarshal(m, b)
+}
+func (m *RelatedObjectsResponse_ObjectReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RelatedObjectsResponse_ObjectReference.Marshal(b, m, deterministic)
+}
+func (m *RelatedObjectsResponse_ObjectReference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelatedObjectsResponse_ObjectReference.Merge(m, src)
+}
+func (m *RelatedObjectsResponse_ObjectReference) XXX_Size() int {
+	return xxx_messageInfo_RelatedObjectsResponse_ObjectReference.Size(m)
+}
+func (m *RelatedObjectsResponse_ObjectReference) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelatedObjectsResponse_ObjectReference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelatedObjectsResponse_ObjectReference proto.InternalMessageInfo
+
+func (m *RelatedObjectsResponse_ObjectReference) GetApiVersion() string {
+	if m != nil {
+		return m.ApiVersion
+	}
+	return ""
+}
+
+func (m *RelatedObjectsResponse_ObjectReference) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *RelatedObjectsResponse_ObjectReference) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *RelatedObjectsResponse_ObjectReference) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type UpdateSettingsRequest struct {
+	Settings             []byte   `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateSettingsRequest) Reset()         { *m = UpdateSettingsRequest{} }
+func (m *UpdateSettingsRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateSettingsRequest) ProtoMessage()    {}
+func (*UpdateSettingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{14}
+}
+
+func (m *UpdateSettingsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateSettingsRequest.Unmarshal(m, b)
+}
+func (m *UpdateSettingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateSettingsRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateSettingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateSettingsRequest.Merge(m, src)
+}
+func (m *UpdateSettingsRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateSettingsRequest.Size(m)
+}
+func (m *UpdateSettingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateSettingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateSettingsRequest proto.InternalMessageInfo
+
+func (m *UpdateSettingsRequest) GetSettings() []byte {
+	if m != nil {
+		return m.Settings
+	}
+	return nil
+}
+
+type ListColumnsRequest struct {
+	Objects              [][]byte `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListColumnsRequest) Reset()         { *m = ListColumnsRequest{} }
+func (m *ListColumnsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListColumnsRequest) ProtoMessage()    {}
+func (*ListColumnsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{15}
+}
+
+func (m *ListColumnsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListColumnsRequest.Unmarshal(m, b)
+}
+func (m *ListColumnsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListColumnsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListColumnsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListColumnsRequest.Merge(m, src)
+}
+func (m *ListColumnsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListColumnsRequest.Size(m)
+}
+func (m *ListColumnsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListColumnsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListColumnsRequest proto.InternalMessageInfo
+
+func (m *ListColumnsRequest) GetObjects() [][]byte {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+type ListColumnsResponse struct {
+	Rows                 [][]byte `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListColumnsResponse) Reset()         { *m = ListColumnsResponse{} }
+func (m *ListColumnsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListColumnsResponse) ProtoMessage()    {}
+func (*ListColumnsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{16}
+}
+
+func (m *ListColumnsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListColumnsResponse.Unmarshal(m, b)
+}
+func (m *ListColumnsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListColumnsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListColumnsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListColumnsResponse.Merge(m, src)
+}
+func (m *ListColumnsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListColumnsResponse.Size(m)
+}
+func (m *ListColumnsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListColumnsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListColumnsResponse proto.InternalMessageInfo
+
+func (m *ListColumnsResponse) GetRows() [][]byte {
+	if m != nil {
+		return m.Rows
+	}
+	return nil
+}
+
 type WatchRequest struct {
 	WatchID              string   `protobuf:"bytes,1,opt,name=watchID,proto3" json:"watchID,omitempty"`
 	Object               []byte   `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
@@ -819,7 +1261,7 @@ func (m *WatchRequest) Reset()         { *m = WatchRequest{} }
 func (m *WatchRequest) String() string { return proto.CompactTextString(m) }
 func (*WatchRequest) ProtoMessage()    {}
 func (*WatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b97678da3a35dfb, []int{13}
+	return fileDescriptor_9b97678da3a35dfb, []int{17}
 }
 
 func (m *WatchRequest) XXX_Unmarshal(b []byte) error {
@@ -860,6 +1302,7 @@ func init() {
 	proto.RegisterType((*ContentResponse)(nil), "dashboard.ContentResponse")
 	proto.RegisterType((*HandleActionRequest)(nil), "dashboard.HandleActionRequest")
 	proto.RegisterType((*HandleActionResponse)(nil), "dashboard.HandleActionResponse")
+	proto.RegisterType((*HandleActionResponse_Alert)(nil), "dashboard.HandleActionResponse.Alert")
 	proto.RegisterType((*NavigationRequest)(nil), "dashboard.NavigationRequest")
 	proto.RegisterType((*NavigationResponse)(nil), "dashboard.NavigationResponse")
 	proto.RegisterType((*NavigationResponse_Navigation)(nil), "dashboard.NavigationResponse.Navigation")
@@ -867,73 +1310,104 @@ func init() {
 	proto.RegisterType((*RegisterResponse)(nil), "dashboard.RegisterResponse")
 	proto.RegisterType((*RegisterResponse_GroupVersionKind)(nil), "dashboard.RegisterResponse.GroupVersionKind")
 	proto.RegisterType((*RegisterResponse_Capabilities)(nil), "dashboard.RegisterResponse.Capabilities")
+	proto.RegisterType((*RegisterResponse_SettingField)(nil), "dashboard.RegisterResponse.SettingField")
+	proto.RegisterType((*RegisterResponse_ListColumns)(nil), "dashboard.RegisterResponse.ListColumns")
 	proto.RegisterType((*ObjectRequest)(nil), "dashboard.ObjectRequest")
 	proto.RegisterType((*PrintResponse)(nil), "dashboard.PrintResponse")
 	proto.RegisterType((*PrintResponse_SummaryItem)(nil), "dashboard.PrintResponse.SummaryItem")
 	proto.RegisterType((*PrintTabResponse)(nil), "dashboard.PrintTabResponse")
 	proto.RegisterType((*ObjectStatusResponse)(nil), "dashboard.ObjectStatusResponse")
+	proto.RegisterType((*RelatedObjectsResponse)(nil), "dashboard.RelatedObjectsResponse")
+	proto.RegisterType((*RelatedObjectsResponse_ObjectReference)(nil), "dashboard.RelatedObjectsResponse.ObjectReference")
+	proto.RegisterType((*UpdateSettingsRequest)(nil), "dashboard.UpdateSettingsRequest")
+	proto.RegisterType((*ListColumnsRequest)(nil), "dashboard.ListColumnsRequest")
+	proto.RegisterType((*ListColumnsResponse)(nil), "dashboard.ListColumnsResponse")
 	proto.RegisterType((*WatchRequest)(nil), "dashboard.WatchRequest")
 }
 
 func init() { proto.RegisterFile("dashboard.proto", fileDescriptor_9b97678da3a35dfb) }
 
 var fileDescriptor_9b97678da3a35dfb = []byte{
-	// 871 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xef, 0x6e, 0xe3, 0x44,
-	0x10, 0x57, 0xfe, 0x27, 0x13, 0x1f, 0x09, 0xdb, 0x70, 0x18, 0xf7, 0xb8, 0x06, 0xeb, 0x24, 0x8a,
-	0x84, 0x02, 0x3a, 0x84, 0x84, 0xe0, 0x84, 0x1a, 0xa5, 0x88, 0x46, 0x40, 0x5b, 0xb9, 0xa5, 0x7c,
-	0x2c, 0x1b, 0x7b, 0x49, 0x16, 0x9c, 0x5d, 0xe3, 0x5d, 0x17, 0xe5, 0x59, 0xf8, 0xc4, 0x17, 0x24,
-	0x5e, 0x81, 0x07, 0xe2, 0x39, 0x90, 0xd7, 0x6b, 0x67, 0x9d, 0x26, 0x11, 0x2d, 0xf7, 0xcd, 0xf3,
-	0x9b, 0x99, 0xdf, 0xcc, 0xce, 0x9f, 0x5d, 0x43, 0x2f, 0xc0, 0x62, 0x31, 0xe3, 0x38, 0x0e, 0x46,
-	0x51, 0xcc, 0x25, 0x47, 0x9d, 0x02, 0x70, 0x5b, 0xd0, 0xf8, 0x6a, 0x19, 0xc9, 0x95, 0xfb, 0x02,
-	0xde, 0x98, 0x70, 0x26, 0x09, 0x93, 0x1e, 0xf9, 0x35, 0x21, 0x42, 0x22, 0x04, 0xf5, 0x08, 0xcb,
-	0x85, 0x5d, 0x19, 0x56, 0x8e, 0x3b, 0x9e, 0xfa, 0x76, 0x5f, 0x41, 0xaf, 0xb0, 0x12, 0x11, 0x67,
-	0x82, 0xa0, 0x0f, 0xa0, 0xef, 0x67, 0xd0, 0x6d, 0xac, 0x31, 0xe5, 0x62, 0x79, 0x3d, 0xbf, 0x6c,
-	0xea, 0x7e, 0x04, 0x07, 0x67, 0x98, 0x05, 0x21, 0x19, 0xfb, 0x92, 0x72, 0x96, 0x07, 0xb2, 0xa1,
-	0x15, 0xe1, 0x55, 0xc8, 0x71, 0xa0, 0x1d, 0x73, 0xd1, 0x7d, 0x0a, 0x83, 0xb2, 0x83, 0x26, 0x3a,
-	0x80, 0x37, 0xcf, 0xf1, 0x1d, 0x9d, 0x63, 0x83, 0xc6, 0xfd, 0xbd, 0x0a, 0xc8, 0x44, 0x75, 0x7e,
-	0x67, 0x00, 0xac, 0x40, 0x55, 0x80, 0xee, 0xcb, 0xe3, 0xd1, 0xba, 0x24, 0xf7, 0x5d, 0x4c, 0xc8,
-	0xf0, 0x75, 0xfe, 0xae, 0x00, 0xac, 0x55, 0x68, 0x00, 0x0d, 0x49, 0x65, 0x48, 0x74, 0x81, 0x32,
-	0xa1, 0xa8, 0x5a, 0x75, 0x5d, 0x35, 0x74, 0x0a, 0x6d, 0x7f, 0x41, 0xc3, 0x20, 0x26, 0xcc, 0xae,
-	0x0d, 0x6b, 0x0f, 0x4a, 0xa0, 0xf0, 0x44, 0x87, 0xd0, 0xa1, 0x3e, 0x67, 0xb7, 0x0c, 0x2f, 0x89,
-	0x5d, 0x57, 0xf4, 0xed, 0x14, 0x38, 0xc7, 0x4b, 0x82, 0x8e, 0xa0, 0xab, 0x94, 0x82, 0x27, 0xb1,
-	0x4f, 0xec, 0x86, 0x52, 0x43, 0x0a, 0x5d, 0x29, 0xc4, 0x9d, 0x40, 0xcf, 0x23, 0x73, 0x2a, 0x24,
-	0x89, 0xf3, 0xba, 0x7f, 0x0c, 0x07, 0x45, 0x16, 0xe3, 0xcb, 0xe9, 0x38, 0x08, 0x62, 0x22, 0x84,
-	0x3e, 0xce, 0x36, 0x95, 0xfb, 0x67, 0x13, 0xfa, 0x6b, 0x16, 0x5d, 0xe0, 0xe7, 0x00, 0x51, 0x98,
-	0xcc, 0xa9, 0x4a, 0x44, 0x7b, 0x1b, 0x08, 0x1a, 0x42, 0x37, 0x20, 0xc2, 0x8f, 0x69, 0xa4, 0x3a,
-	0x90, 0x15, 0xc6, 0x84, 0xd0, 0xb7, 0x60, 0xf9, 0x38, 0xc2, 0x33, 0x1a, 0x52, 0x49, 0x89, 0xb0,
-	0x6b, 0xf7, 0x9a, 0xb4, 0x19, 0x74, 0x34, 0x31, 0xec, 0xbd, 0x92, 0xb7, 0x73, 0x03, 0xfd, 0xaf,
-	0x63, 0x9e, 0x44, 0x37, 0x24, 0x16, 0x94, 0xb3, 0x6f, 0x28, 0x0b, 0xd2, 0x5e, 0xcd, 0x53, 0x2c,
-	0xef, 0x95, 0x12, 0xd2, 0xc1, 0xbb, 0xcb, 0x8c, 0x74, 0x56, 0xb9, 0x98, 0x76, 0xf1, 0x17, 0xca,
-	0x02, 0x95, 0x49, 0xc7, 0x53, 0xdf, 0xce, 0x5f, 0x75, 0xb0, 0xcc, 0xb0, 0x68, 0x06, 0x6f, 0x89,
-	0x24, 0x8a, 0x78, 0x2c, 0xc5, 0x65, 0x4c, 0x99, 0x24, 0xf1, 0x84, 0xb3, 0x9f, 0xe8, 0xdc, 0xae,
-	0xa8, 0x1e, 0x7f, 0xb8, 0x2f, 0xff, 0xcd, 0x0c, 0xbd, 0xed, 0x54, 0x5b, 0x62, 0x5c, 0x49, 0x2c,
-	0x13, 0x61, 0x57, 0x5f, 0x43, 0x8c, 0x8c, 0x0a, 0xfd, 0x08, 0x83, 0x0d, 0xc5, 0x54, 0x92, 0xa5,
-	0xd0, 0xa3, 0xfa, 0xb0, 0x10, 0x5b, 0x99, 0xcc, 0x08, 0x17, 0xb3, 0x9f, 0x89, 0x2f, 0xf5, 0x21,
-	0xea, 0xff, 0x27, 0x82, 0xc9, 0x84, 0xce, 0xa1, 0x9b, 0xe3, 0xd7, 0x78, 0x66, 0x37, 0x1e, 0x41,
-	0x6c, 0x12, 0x20, 0x07, 0xda, 0x54, 0x7c, 0xc7, 0x83, 0x24, 0x24, 0x76, 0x73, 0x58, 0x39, 0x6e,
-	0x7b, 0x85, 0x8c, 0xde, 0x03, 0x0b, 0xab, 0xfb, 0x48, 0xad, 0xa2, 0xb0, 0x5b, 0xc3, 0x5a, 0x3a,
-	0xd1, 0x19, 0x96, 0x8e, 0xbc, 0x70, 0xdf, 0x87, 0x27, 0x59, 0x7a, 0xf9, 0xae, 0x3d, 0x85, 0x26,
-	0x57, 0x80, 0xbe, 0xe2, 0xb4, 0xe4, 0xfe, 0x53, 0x81, 0x27, 0xaa, 0x54, 0xc5, 0x3a, 0xbd, 0x82,
-	0xa6, 0x6f, 0x8e, 0xd1, 0x0b, 0xe3, 0x10, 0x25, 0xcb, 0xd1, 0x55, 0xb2, 0x5c, 0xe2, 0x78, 0x95,
-	0x96, 0xd8, 0xd3, 0x3e, 0xa9, 0xb7, 0x30, 0x07, 0xe4, 0x3f, 0x7a, 0x67, 0x3e, 0xe9, 0x9a, 0x50,
-	0xdd, 0xfa, 0x34, 0xc9, 0x4c, 0x70, 0x26, 0xd0, 0x35, 0x8c, 0xd3, 0xa3, 0x2c, 0x08, 0x0e, 0x48,
-	0xac, 0x97, 0x49, 0x4b, 0xe8, 0x19, 0x74, 0x7c, 0xbe, 0x8c, 0x38, 0x23, 0x4c, 0xaa, 0x7d, 0xb2,
-	0xbc, 0x35, 0xe0, 0x7e, 0x09, 0x7d, 0x15, 0xff, 0x1a, 0xcf, 0x8a, 0xa3, 0x22, 0xa8, 0xb3, 0xf5,
-	0x9d, 0xa1, 0xbe, 0x53, 0xf6, 0x10, 0xaf, 0x78, 0x92, 0x53, 0x68, 0xc9, 0xfd, 0x1c, 0x06, 0x66,
-	0xc3, 0x0b, 0x0e, 0x17, 0x2c, 0x6e, 0x8e, 0x54, 0x56, 0xde, 0x12, 0xe6, 0x9e, 0x80, 0xf5, 0x03,
-	0x96, 0xfe, 0xc2, 0x78, 0x70, 0x7e, 0x4b, 0xe5, 0xe9, 0xa9, 0x0e, 0x9d, 0x8b, 0x46, 0x9b, 0xaa,
-	0x66, 0x9b, 0x5e, 0xfe, 0xd1, 0x80, 0xe6, 0xa5, 0xba, 0xd2, 0xd0, 0x09, 0xb4, 0xf4, 0x13, 0x88,
-	0xde, 0x31, 0x8a, 0x5b, 0x7e, 0x3c, 0x1d, 0x67, 0x9b, 0x4a, 0xa7, 0x7c, 0x01, 0x96, 0xf9, 0xaa,
-	0xa1, 0xe7, 0x86, 0xed, 0x96, 0xf7, 0xd1, 0x39, 0xda, 0xa9, 0xd7, 0x84, 0xd3, 0xd2, 0xbb, 0xf4,
-	0x6c, 0xc7, 0xdb, 0x92, 0x91, 0xbd, 0xbb, 0xf7, 0xe5, 0x41, 0x13, 0x68, 0xe7, 0x9b, 0x82, 0x9c,
-	0xad, 0xeb, 0x93, 0xd1, 0x1c, 0xee, 0x59, 0x2d, 0xf4, 0x05, 0x34, 0x54, 0xaf, 0x91, 0x6d, 0x58,
-	0x95, 0xf6, 0xc1, 0xb1, 0x77, 0xcd, 0x25, 0x9a, 0x82, 0x55, 0xda, 0xec, 0xdd, 0x1c, 0x47, 0xf7,
-	0x34, 0x1b, 0xb3, 0x31, 0x86, 0x76, 0x3e, 0x73, 0x7b, 0x68, 0x0e, 0x37, 0x53, 0x31, 0x47, 0xf4,
-	0x53, 0x68, 0xab, 0xd1, 0x19, 0x07, 0x01, 0x7a, 0xdb, 0x30, 0x34, 0xe7, 0xc9, 0xe9, 0x1b, 0x0a,
-	0xf5, 0x37, 0x85, 0x3e, 0x83, 0xae, 0xb2, 0xf8, 0x3e, 0x0a, 0xb0, 0x24, 0x8f, 0xf1, 0x3c, 0x25,
-	0x21, 0x79, 0x90, 0xe7, 0xac, 0xa9, 0x7e, 0xee, 0x3e, 0xf9, 0x37, 0x00, 0x00, 0xff, 0xff, 0x3a,
-	0xe2, 0x8f, 0x89, 0xef, 0x09, 0x00, 0x00,
+	// 1253 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x6f, 0x6f, 0xdc, 0x44,
+	0x13, 0xd7, 0xdd, 0xe5, 0xfe, 0xcd, 0x5d, 0x93, 0x7b, 0x36, 0x69, 0x1e, 0xe3, 0x94, 0xe4, 0x6a,
+	0x15, 0x35, 0x95, 0xd0, 0x01, 0xa9, 0x90, 0x10, 0x14, 0xd4, 0xd3, 0x05, 0x68, 0xd4, 0x34, 0x8d,
+	0x9c, 0x36, 0xc0, 0xab, 0xb2, 0x67, 0x6f, 0x2e, 0x0b, 0x3e, 0xdb, 0x78, 0xf7, 0x1a, 0xf2, 0x12,
+	0x89, 0x17, 0x7c, 0x07, 0xbe, 0x49, 0x3f, 0x0c, 0xe2, 0x15, 0xe2, 0x63, 0xa0, 0x5d, 0xef, 0xda,
+	0xeb, 0x8b, 0x73, 0x21, 0x29, 0xef, 0x3c, 0xb3, 0x33, 0xbf, 0x99, 0x9d, 0xdd, 0xdf, 0xec, 0x18,
+	0x56, 0x7c, 0xcc, 0x4e, 0xc7, 0x11, 0x4e, 0xfc, 0x41, 0x9c, 0x44, 0x3c, 0x42, 0xed, 0x4c, 0xe1,
+	0x34, 0xa1, 0xfe, 0xe5, 0x34, 0xe6, 0xe7, 0xce, 0x3d, 0x58, 0x1e, 0x45, 0x21, 0x27, 0x21, 0x77,
+	0xc9, 0x4f, 0x33, 0xc2, 0x38, 0x42, 0xb0, 0x14, 0x63, 0x7e, 0x6a, 0x55, 0xfa, 0x95, 0xed, 0xb6,
+	0x2b, 0xbf, 0x9d, 0x47, 0xb0, 0x92, 0x59, 0xb1, 0x38, 0x0a, 0x19, 0x41, 0x0f, 0xa0, 0xe7, 0xa5,
+	0xaa, 0x57, 0x89, 0xd2, 0x49, 0x97, 0xae, 0xbb, 0xe2, 0x15, 0x4d, 0x9d, 0x0f, 0x60, 0xf5, 0x09,
+	0x0e, 0xfd, 0x80, 0x0c, 0x3d, 0x4e, 0xa3, 0x50, 0x07, 0xb2, 0xa0, 0x19, 0xe3, 0xf3, 0x20, 0xc2,
+	0xbe, 0x72, 0xd4, 0xa2, 0xf3, 0x67, 0x05, 0xd6, 0x8a, 0x1e, 0x2a, 0xe8, 0xe7, 0xd0, 0xc0, 0x01,
+	0x49, 0x38, 0xb3, 0x2a, 0xfd, 0xda, 0x76, 0x67, 0xe7, 0xbd, 0x41, 0xbe, 0xc7, 0x32, 0x87, 0xc1,
+	0x50, 0x58, 0xbb, 0xca, 0x09, 0xad, 0x43, 0x23, 0x21, 0x6c, 0x16, 0x70, 0xab, 0x2a, 0x03, 0x2a,
+	0x09, 0xd9, 0xd0, 0x4a, 0x88, 0x4f, 0x13, 0xe2, 0x71, 0xab, 0x26, 0xb7, 0x9d, 0xc9, 0xf6, 0x4b,
+	0xa8, 0x4b, 0x10, 0x51, 0x17, 0x7e, 0x1e, 0x13, 0x5d, 0x17, 0xf1, 0x2d, 0xb6, 0x30, 0x25, 0x8c,
+	0xe1, 0x09, 0x91, 0x88, 0x6d, 0x57, 0x8b, 0x68, 0x13, 0x80, 0xfc, 0x1c, 0xd3, 0x04, 0x8b, 0x74,
+	0x24, 0x68, 0xcd, 0x35, 0x34, 0xce, 0x2a, 0xfc, 0xef, 0x00, 0xbf, 0xa6, 0x13, 0x6c, 0x54, 0xc4,
+	0xf9, 0xbd, 0x0a, 0xc8, 0xd4, 0xaa, 0x5d, 0x3f, 0x01, 0x08, 0x33, 0xad, 0x8c, 0xdf, 0xd9, 0xd9,
+	0x36, 0x76, 0x7e, 0xd1, 0xc5, 0x54, 0x19, 0xbe, 0xf6, 0x9b, 0x0a, 0x40, 0xbe, 0x84, 0xd6, 0xa0,
+	0xce, 0x29, 0x0f, 0xf4, 0x9e, 0x52, 0x21, 0xbb, 0x00, 0xd5, 0xfc, 0x02, 0xa0, 0x5d, 0x68, 0x79,
+	0xa7, 0x34, 0xf0, 0x13, 0x22, 0x36, 0x53, 0xbb, 0x56, 0x02, 0x99, 0x27, 0xda, 0x80, 0x36, 0xf5,
+	0xa2, 0xf0, 0x55, 0x88, 0xa7, 0xc4, 0x5a, 0x4a, 0x0b, 0x2d, 0x14, 0x07, 0x78, 0x4a, 0xd0, 0x16,
+	0x74, 0xe4, 0x22, 0x8b, 0x66, 0x89, 0x47, 0xac, 0xba, 0x5c, 0x06, 0xa1, 0x3a, 0x92, 0x1a, 0x67,
+	0x04, 0x2b, 0x2e, 0x99, 0x50, 0xc6, 0x49, 0xa2, 0xaf, 0xd0, 0x87, 0xb0, 0x9a, 0x65, 0x31, 0x3c,
+	0xdc, 0x1b, 0xfa, 0x7e, 0x42, 0x18, 0x53, 0xdb, 0x29, 0x5b, 0x72, 0xde, 0x00, 0xf4, 0x72, 0x14,
+	0x55, 0xe0, 0x4d, 0x80, 0x38, 0x98, 0x4d, 0xa8, 0x4c, 0x44, 0x79, 0x1b, 0x1a, 0xd4, 0x87, 0x8e,
+	0x4f, 0x98, 0x97, 0xd0, 0x58, 0x9e, 0x40, 0x5a, 0x18, 0x53, 0x85, 0xf6, 0xa1, 0xeb, 0xe1, 0x18,
+	0x8f, 0x69, 0x40, 0x39, 0x25, 0x4c, 0x1e, 0x78, 0xb1, 0x46, 0xf3, 0x41, 0x07, 0x23, 0xc3, 0xde,
+	0x2d, 0x78, 0x8b, 0x6a, 0x33, 0xc2, 0x39, 0x0d, 0x27, 0xcc, 0x5a, 0xea, 0xd7, 0xae, 0x42, 0x3a,
+	0x4a, 0x6d, 0xbf, 0xa2, 0x24, 0xf0, 0xdd, 0xcc, 0xd3, 0x3e, 0x86, 0xde, 0xd7, 0x49, 0x34, 0x8b,
+	0x8f, 0x49, 0xc2, 0x68, 0x14, 0x3e, 0xa5, 0xa1, 0x2f, 0x4e, 0x7c, 0x22, 0x74, 0xfa, 0xc4, 0xa5,
+	0x20, 0xae, 0xf1, 0xeb, 0xd4, 0x48, 0x5f, 0x63, 0x25, 0x8a, 0xbb, 0xf0, 0x23, 0x0d, 0x7d, 0xc5,
+	0x0a, 0xf9, 0x6d, 0xff, 0x5d, 0x87, 0xae, 0x99, 0x3c, 0x1a, 0xc3, 0x6d, 0x36, 0x8b, 0xe3, 0x28,
+	0xe1, 0xec, 0x30, 0xa1, 0x21, 0x27, 0xc9, 0x28, 0x0a, 0x4f, 0xe8, 0x44, 0x91, 0xf4, 0xfd, 0x45,
+	0xb9, 0xcf, 0x67, 0xe8, 0x96, 0x43, 0x95, 0xc4, 0x38, 0xe2, 0x98, 0xcf, 0x98, 0x55, 0xfd, 0x0f,
+	0x62, 0xa4, 0x50, 0xe8, 0x7b, 0x58, 0x9b, 0x5b, 0xd8, 0xe3, 0x64, 0xca, 0xac, 0xda, 0x0d, 0x42,
+	0x94, 0x22, 0x99, 0x11, 0x9e, 0x8f, 0x7f, 0x20, 0x1e, 0x57, 0x9b, 0x58, 0x7a, 0x9b, 0x08, 0x26,
+	0x12, 0x3a, 0x80, 0x8e, 0xd6, 0xbf, 0xc0, 0x63, 0xab, 0x7e, 0x03, 0x60, 0x13, 0x40, 0xb4, 0x46,
+	0xca, 0x9e, 0x45, 0xfe, 0x2c, 0x20, 0x56, 0xa3, 0x5f, 0xd9, 0x6e, 0xb9, 0x99, 0x8c, 0xee, 0x42,
+	0x17, 0xcb, 0x76, 0x2b, 0x09, 0xcd, 0xac, 0x66, 0xbf, 0x26, 0x78, 0x91, 0xea, 0x04, 0x71, 0x0a,
+	0x57, 0x23, 0x4d, 0xf3, 0x98, 0x32, 0xca, 0xa3, 0xc4, 0x6a, 0xbd, 0xcd, 0xb1, 0x15, 0xa0, 0xd0,
+	0x77, 0xb0, 0xaa, 0x17, 0xf6, 0x29, 0xe3, 0xa3, 0x28, 0x98, 0x4d, 0x43, 0x66, 0xb5, 0x65, 0x84,
+	0xfb, 0x8b, 0x22, 0x18, 0xe6, 0x6e, 0x19, 0x86, 0xfd, 0x5b, 0x05, 0xba, 0x26, 0xbb, 0x04, 0x1f,
+	0xc2, 0xbc, 0x47, 0xc8, 0x6f, 0xc1, 0xa9, 0x00, 0x8f, 0x49, 0xa0, 0xb8, 0x93, 0x0a, 0xd9, 0x73,
+	0x51, 0x2b, 0x3e, 0x17, 0x3e, 0x39, 0xc1, 0xe2, 0x01, 0x4a, 0xbb, 0x9f, 0x16, 0xe7, 0x3b, 0x4c,
+	0xfd, 0x42, 0x87, 0xb1, 0x7f, 0xa9, 0x40, 0xc7, 0x48, 0x0d, 0x7d, 0x0b, 0xbd, 0xc9, 0x5c, 0x81,
+	0xd4, 0xd3, 0x70, 0xbd, 0xa2, 0x5e, 0x40, 0x11, 0x59, 0x7a, 0xaa, 0x86, 0x55, 0x79, 0xa2, 0x5a,
+	0x74, 0xee, 0xc3, 0xad, 0xb4, 0xf4, 0xba, 0xff, 0xae, 0x43, 0x23, 0x92, 0x0a, 0xf5, 0x82, 0x2b,
+	0xc9, 0xf9, 0xab, 0x02, 0xb7, 0xe4, 0xc5, 0xcf, 0x5a, 0xec, 0x23, 0x68, 0x78, 0x66, 0x53, 0xb8,
+	0x67, 0x24, 0x59, 0xb0, 0x1c, 0x1c, 0xcd, 0xa6, 0x53, 0x9c, 0x9c, 0x0b, 0xc2, 0xb8, 0xca, 0x47,
+	0x78, 0x33, 0x93, 0xee, 0xff, 0xd2, 0x3b, 0xf5, 0x11, 0x07, 0x44, 0x15, 0x91, 0x45, 0x92, 0xa9,
+	0x60, 0x8f, 0xa0, 0x63, 0x18, 0x8b, 0xad, 0x9c, 0x12, 0xec, 0x93, 0x44, 0x9d, 0xad, 0x92, 0xd0,
+	0x1d, 0x68, 0x7b, 0xd1, 0x34, 0x8e, 0x42, 0x12, 0xea, 0xb1, 0x21, 0x57, 0x38, 0x5f, 0x40, 0x4f,
+	0xc6, 0x7f, 0x81, 0xc7, 0xd9, 0x56, 0xcb, 0xee, 0xc8, 0x3a, 0x34, 0x02, 0x7c, 0x1e, 0xcd, 0xb2,
+	0xc9, 0x23, 0x95, 0x9c, 0x4f, 0x61, 0xcd, 0xa4, 0x6f, 0x86, 0xe1, 0x40, 0x37, 0x32, 0xf4, 0xaa,
+	0xbc, 0x05, 0x9d, 0xf3, 0x47, 0x05, 0xd6, 0x5d, 0x12, 0x60, 0x4e, 0xfc, 0x14, 0x23, 0x77, 0x7f,
+	0x0a, 0xcd, 0xd4, 0x54, 0x0f, 0x4a, 0x1f, 0x15, 0xee, 0x44, 0x99, 0xcf, 0x40, 0x9f, 0xec, 0x09,
+	0x49, 0x48, 0xe8, 0x11, 0x57, 0x23, 0xd8, 0x67, 0xb0, 0x32, 0xb7, 0x26, 0x1e, 0x4c, 0x1c, 0x53,
+	0x75, 0x69, 0xf4, 0x83, 0x99, 0x6b, 0xb2, 0x67, 0xa3, 0x9a, 0x3f, 0x1b, 0xa2, 0x90, 0xb2, 0x4d,
+	0xc4, 0xd8, 0xd3, 0xac, 0xc8, 0x15, 0x59, 0xd1, 0x96, 0xf2, 0xa2, 0x39, 0x0f, 0xe1, 0xf6, 0xcb,
+	0xd8, 0xc7, 0x9c, 0x28, 0x0a, 0x32, 0x7d, 0xed, 0x6c, 0xe3, 0x7d, 0x4c, 0x2b, 0x93, 0xc9, 0xce,
+	0x00, 0x90, 0x49, 0xeb, 0x7c, 0xd6, 0x34, 0x0b, 0xd2, 0xcd, 0x76, 0xe7, 0x3c, 0x80, 0xd5, 0x82,
+	0x7d, 0x7e, 0x88, 0x49, 0x74, 0xa6, 0xad, 0xe5, 0xb7, 0xf3, 0x18, 0xba, 0xdf, 0x60, 0xee, 0x9d,
+	0x1a, 0xa0, 0x67, 0x42, 0xde, 0xdb, 0x55, 0x25, 0xd0, 0xa2, 0xc1, 0x8b, 0xaa, 0xc9, 0x8b, 0x9d,
+	0x5f, 0x9b, 0xd0, 0x38, 0x94, 0x73, 0x05, 0x7a, 0x0c, 0x4d, 0x35, 0x52, 0xa3, 0x77, 0x8c, 0xc3,
+	0x29, 0x0e, 0xe3, 0xb6, 0x5d, 0xb6, 0xa4, 0x52, 0x7c, 0x0e, 0x5d, 0x73, 0xe6, 0x45, 0x9b, 0x97,
+	0x0e, 0xc3, 0x29, 0xd6, 0xd6, 0x15, 0xc3, 0x32, 0xda, 0x2b, 0x0c, 0x87, 0x77, 0x2e, 0x19, 0xf0,
+	0x52, 0xb0, 0x77, 0x17, 0x8e, 0x7f, 0x68, 0x04, 0x2d, 0xdd, 0x7a, 0x90, 0x5d, 0xda, 0x8f, 0x52,
+	0x98, 0x8d, 0x05, 0xbd, 0x0a, 0x7d, 0x06, 0x75, 0x49, 0x2e, 0x64, 0x19, 0x56, 0x85, 0x06, 0x64,
+	0x5b, 0x97, 0x35, 0x02, 0xb4, 0x07, 0xdd, 0xc2, 0xc3, 0x78, 0x39, 0xc6, 0xd6, 0x85, 0x95, 0x39,
+	0x32, 0x0e, 0xa1, 0xa5, 0x49, 0xbe, 0x00, 0x66, 0x63, 0x3e, 0x15, 0xb3, 0x27, 0x3c, 0x83, 0xe5,
+	0x22, 0xed, 0x16, 0x00, 0xdd, 0xbd, 0x92, 0xab, 0x68, 0x17, 0x96, 0x8b, 0xcc, 0x40, 0x7d, 0xc3,
+	0xa9, 0x94, 0x34, 0x76, 0xcf, 0xb0, 0x90, 0xff, 0x7e, 0x68, 0xbf, 0xf8, 0xa2, 0x98, 0x47, 0x7a,
+	0x91, 0x42, 0xf6, 0xe6, 0x65, 0xcb, 0x2a, 0xa7, 0x8f, 0xa1, 0x25, 0xd9, 0x31, 0xf4, 0x7d, 0xf4,
+	0x7f, 0xc3, 0xd6, 0xa4, 0x4c, 0x49, 0x12, 0x9f, 0x40, 0x47, 0x5a, 0xa4, 0x49, 0xdf, 0xc4, 0x73,
+	0x97, 0x04, 0xe4, 0x5a, 0x9e, 0xe3, 0x86, 0xfc, 0x1f, 0x7e, 0xf8, 0xcf, 0x00, 0xda, 0xd8, 0xe2,
+	0x3b, 0x22, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -955,6 +1429,9 @@ type PluginClient interface {
 	Print(ctx context.Context, in *ObjectRequest, opts ...grpc.CallOption) (*PrintResponse, error)
 	ObjectStatus(ctx context.Context, in *ObjectRequest, opts ...grpc.CallOption) (*ObjectStatusResponse, error)
 	PrintTab(ctx context.Context, in *ObjectRequest, opts ...grpc.CallOption) (*PrintTabResponse, error)
+	RelatedObjects(ctx context.Context, in *ObjectRequest, opts ...grpc.CallOption) (*RelatedObjectsResponse, error)
+	UpdateSettings(ctx context.Context, in *UpdateSettingsRequest, opts ...grpc.CallOption) (*Empty, error)
+	ListColumns(ctx context.Context, in *ListColumnsRequest, opts ...grpc.CallOption) (*ListColumnsResponse, error)
 	WatchAdd(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (*Empty, error)
 	WatchUpdate(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (*Empty, error)
 	WatchDelete(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (*Empty, error)
@@ -1031,6 +1508,33 @@ func (c *pluginClient) PrintTab(ctx context.Context, in *ObjectRequest, opts ...
 	return out, nil
 }
 
+func (c *pluginClient) RelatedObjects(ctx context.Context, in *ObjectRequest, opts ...grpc.CallOption) (*RelatedObjectsResponse, error) {
+	out := new(RelatedObjectsResponse)
+	err := c.cc.Invoke(ctx, "/dashboard.Plugin/RelatedObjects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginClient) UpdateSettings(ctx context.Context, in *UpdateSettingsRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/dashboard.Plugin/UpdateSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginClient) ListColumns(ctx context.Context, in *ListColumnsRequest, opts ...grpc.CallOption) (*ListColumnsResponse, error) {
+	out := new(ListColumnsResponse)
+	err := c.cc.Invoke(ctx, "/dashboard.Plugin/ListColumns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pluginClient) WatchAdd(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
 	err := c.cc.Invoke(ctx, "/dashboard.Plugin/WatchAdd", in, out, opts...)
@@ -1067,6 +1571,9 @@ type PluginServer interface {
 	Print(context.Context, *ObjectRequest) (*PrintResponse, error)
 	ObjectStatus(context.Context, *ObjectRequest) (*ObjectStatusResponse, error)
 	PrintTab(context.Context, *ObjectRequest) (*PrintTabResponse, error)
+	RelatedObjects(context.Context, *ObjectRequest) (*RelatedObjectsResponse, error)
+	UpdateSettings(context.Context, *UpdateSettingsRequest) (*Empty, error)
+	ListColumns(context.Context, *ListColumnsRequest) (*ListColumnsResponse, error)
 	WatchAdd(context.Context, *WatchRequest) (*Empty, error)
 	WatchUpdate(context.Context, *WatchRequest) (*Empty, error)
 	WatchDelete(context.Context, *WatchRequest) (*Empty, error)
@@ -1097,6 +1604,15 @@ func (*UnimplementedPluginServer) ObjectStatus(ctx context.Context, req *ObjectR
 func (*UnimplementedPluginServer) PrintTab(ctx context.Context, req *ObjectRequest) (*PrintTabResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PrintTab not implemented")
 }
+func (*UnimplementedPluginServer) RelatedObjects(ctx context.Context, req *ObjectRequest) (*RelatedObjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelatedObjects not implemented")
+}
+func (*UnimplementedPluginServer) UpdateSettings(ctx context.Context, req *UpdateSettingsRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSettings not implemented")
+}
+func (*UnimplementedPluginServer) ListColumns(ctx context.Context, req *ListColumnsRequest) (*ListColumnsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListColumns not implemented")
+}
 func (*UnimplementedPluginServer) WatchAdd(ctx context.Context, req *WatchRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WatchAdd not implemented")
 }
@@ -1237,6 +1753,60 @@ func _Plugin_PrintTab_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Plugin_RelatedObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServer).RelatedObjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dashboard.Plugin/RelatedObjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServer).RelatedObjects(ctx, req.(*ObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Plugin_UpdateSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServer).UpdateSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dashboard.Plugin/UpdateSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServer).UpdateSettings(ctx, req.(*UpdateSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Plugin_ListColumns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListColumnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServer).ListColumns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dashboard.Plugin/ListColumns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServer).ListColumns(ctx, req.(*ListColumnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Plugin_WatchAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(WatchRequest)
 	if err := dec(in); err != nil {
@@ -1323,6 +1893,18 @@ var _Plugin_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PrintTab",
 			Handler:    _Plugin_PrintTab_Handler,
 		},
+		{
+			MethodName: "RelatedObjects",
+			Handler:    _Plugin_RelatedObjects_Handler,
+		},
+		{
+			MethodName: "UpdateSettings",
+			Handler:    _Plugin_UpdateSettings_Handler,
+		},
+		{
+			MethodName: "ListColumns",
+			Handler:    _Plugin_ListColumns_Handler,
+		},
 		{
 			MethodName: "WatchAdd",
 			Handler:    _Plugin_WatchAdd_Handler,
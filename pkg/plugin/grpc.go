@@ -75,7 +75,9 @@ func (c *GRPCClient) Content(ctx context.Context, contentPath string) (component
 }
 
 // HandleAction runs an action on a plugin.
-func (c *GRPCClient) HandleAction(ctx context.Context, payload action.Payload) error {
+func (c *GRPCClient) HandleAction(ctx context.Context, payload action.Payload) (ActionResponse, error) {
+	var actionResponse ActionResponse
+
 	err := c.run(func() error {
 		data, err := json.Marshal(&payload)
 		if err != nil {
@@ -86,8 +88,42 @@ func (c *GRPCClient) HandleAction(ctx context.Context, payload action.Payload) e
 			Payload: data,
 		}
 
-		_, err = c.client.HandleAction(ctx, req)
+		resp, err := c.client.HandleAction(ctx, req)
+		if err != nil {
+			if s, isStatus := status.FromError(err); isStatus {
+				return errors.Errorf("grpc error: %s", s.Message())
+			}
+			return err
+		}
+
+		actionResponse, err = convertToActionResponse(resp)
+		if err != nil {
+			return errors.Wrap(err, "convert action response")
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return ActionResponse{}, err
+	}
+
+	return actionResponse, nil
+}
+
+// UpdateSettings sends settings to a plugin.
+func (c *GRPCClient) UpdateSettings(ctx context.Context, settings Settings) error {
+	return c.run(func() error {
+		data, err := json.Marshal(&settings)
 		if err != nil {
+			return err
+		}
+
+		req := &dashboard.UpdateSettingsRequest{
+			Settings: data,
+		}
+
+		if _, err := c.client.UpdateSettings(ctx, req); err != nil {
 			if s, isStatus := status.FromError(err); isStatus {
 				return errors.Errorf("grpc error: %s", s.Message())
 			}
@@ -96,8 +132,43 @@ func (c *GRPCClient) HandleAction(ctx context.Context, payload action.Payload) e
 
 		return nil
 	})
+}
+
+// ListColumns returns cells for the columns a plugin adds to a list of objects.
+func (c *GRPCClient) ListColumns(ctx context.Context, objects []runtime.Object) (ListColumnsResponse, error) {
+	var lcr ListColumnsResponse
+
+	err := c.run(func() error {
+		req := &dashboard.ListColumnsRequest{}
+		for _, object := range objects {
+			data, err := json.Marshal(object)
+			if err != nil {
+				return err
+			}
+			req.Objects = append(req.Objects, data)
+		}
+
+		resp, err := c.client.ListColumns(ctx, req)
+		if err != nil {
+			return errors.Wrap(err, "grpc client list columns")
+		}
+
+		for _, data := range resp.Rows {
+			var row component.TableRow
+			if err := json.Unmarshal(data, &row); err != nil {
+				return errors.Wrap(err, "convert list columns row")
+			}
+			lcr.Rows = append(lcr.Rows, row)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return ListColumnsResponse{}, err
+	}
 
-	return err
+	return lcr, nil
 }
 
 // Navigation returns navigation entries from a plugin.
@@ -145,6 +216,7 @@ func (c *GRPCClient) Register(ctx context.Context, dashboardAPIAddress string) (
 			Name:         resp.PluginName,
 			Description:  resp.Description,
 			Capabilities: capabilities,
+			Settings:     convertToSettingFields(resp.Settings),
 		}
 
 		return nil
@@ -191,6 +263,33 @@ func (c *GRPCClient) ObjectStatus(ctx context.Context, object runtime.Object) (O
 	return osr, nil
 }
 
+// RelatedObjects returns objects related to an object.
+func (c *GRPCClient) RelatedObjects(ctx context.Context, object runtime.Object) (RelatedObjectsResponse, error) {
+	var ror RelatedObjectsResponse
+
+	err := c.run(func() error {
+		in, err := createObjectRequest(object)
+		if err != nil {
+			return err
+		}
+
+		resp, err := c.client.RelatedObjects(ctx, in)
+		if err != nil {
+			return errors.Wrap(err, "grpc client related objects")
+		}
+
+		ror = convertToRelatedObjectsResponse(resp)
+
+		return nil
+	})
+
+	if err != nil {
+		return RelatedObjectsResponse{}, err
+	}
+
+	return ror, nil
+}
+
 // Print prints an object.
 func (c *GRPCClient) Print(ctx context.Context, object runtime.Object) (PrintResponse, error) {
 	var pr PrintResponse
@@ -333,11 +432,12 @@ func (s *GRPCServer) HandleAction(ctx context.Context, handleActionRequest *dash
 		return nil, err
 	}
 
-	if err := s.Impl.HandleAction(ctx, payload); err != nil {
+	actionResponse, err := s.Impl.HandleAction(ctx, payload)
+	if err != nil {
 		return nil, err
 	}
 
-	return &dashboard.HandleActionResponse{}, nil
+	return convertFromActionResponse(actionResponse)
 }
 
 // Navigation returns navigation entries from a plugin.
@@ -373,9 +473,52 @@ func (s *GRPCServer) Register(ctx context.Context, registerRequest *dashboard.Re
 		PluginName:   m.Name,
 		Description:  m.Description,
 		Capabilities: &capabilities,
+		Settings:     convertFromSettingFields(m.Settings),
 	}, nil
 }
 
+// UpdateSettings updates the settings of a plugin.
+func (s *GRPCServer) UpdateSettings(ctx context.Context, req *dashboard.UpdateSettingsRequest) (*dashboard.Empty, error) {
+	var settings Settings
+	if err := json.Unmarshal(req.Settings, &settings); err != nil {
+		return nil, err
+	}
+
+	if err := s.Impl.UpdateSettings(ctx, settings); err != nil {
+		return nil, err
+	}
+
+	return &dashboard.Empty{}, nil
+}
+
+// ListColumns returns cells for the columns a plugin adds to a list of objects.
+func (s *GRPCServer) ListColumns(ctx context.Context, req *dashboard.ListColumnsRequest) (*dashboard.ListColumnsResponse, error) {
+	var objects []runtime.Object
+	for _, data := range req.Objects {
+		u, err := decodeObjectRequest(&dashboard.ObjectRequest{Object: data})
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, u)
+	}
+
+	lcr, err := s.Impl.ListColumns(ctx, objects)
+	if err != nil {
+		return nil, errors.Wrap(err, "grpc server list columns")
+	}
+
+	out := &dashboard.ListColumnsResponse{}
+	for _, row := range lcr.Rows {
+		data, err := json.Marshal(row)
+		if err != nil {
+			return nil, err
+		}
+		out.Rows = append(out.Rows, data)
+	}
+
+	return out, nil
+}
+
 // Print prints an object.
 func (s *GRPCServer) Print(ctx context.Context, objectRequest *dashboard.ObjectRequest) (*dashboard.PrintResponse, error) {
 	u, err := decodeObjectRequest(objectRequest)
@@ -436,6 +579,21 @@ func (s *GRPCServer) ObjectStatus(ctx context.Context, objectRequest *dashboard.
 	return out, nil
 }
 
+// RelatedObjects returns objects related to an object.
+func (s *GRPCServer) RelatedObjects(ctx context.Context, objectRequest *dashboard.ObjectRequest) (*dashboard.RelatedObjectsResponse, error) {
+	u, err := decodeObjectRequest(objectRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	ror, err := s.Impl.RelatedObjects(ctx, u)
+	if err != nil {
+		return nil, errors.Wrap(err, "grpc server related objects")
+	}
+
+	return convertFromRelatedObjectsResponse(ror), nil
+}
+
 func decodeObjectRequest(req *dashboard.ObjectRequest) (*unstructured.Unstructured, error) {
 	m := map[string]interface{}{}
 
@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -19,10 +20,12 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/kubenext/lissio/internal/testutil"
+	"github.com/kubenext/lissio/pkg/action"
 	"github.com/kubenext/lissio/pkg/navigation"
 	"github.com/kubenext/lissio/pkg/plugin"
 	"github.com/kubenext/lissio/pkg/plugin/dashboard"
 	"github.com/kubenext/lissio/pkg/plugin/fake"
+	"github.com/kubenext/lissio/pkg/store"
 	"github.com/kubenext/lissio/pkg/view/component"
 	"github.com/kubenext/lissio/pkg/view/flexlayout"
 )
@@ -145,6 +148,9 @@ func Test_GRPCClient_Register(t *testing.T) {
 				SupportsObjectStatus:  inGVKs,
 				SupportsTab:           inGVKs,
 			},
+			Settings: []*dashboard.RegisterResponse_SettingField{
+				{Name: "url", Label: "URL", Type: "string", Default: "https://example.com", Description: "API URL"},
+			},
 		}
 
 		mocks.protoClient.EXPECT().Register(gomock.Any(), gomock.Any()).Return(resp, nil)
@@ -167,6 +173,9 @@ func Test_GRPCClient_Register(t *testing.T) {
 				SupportsObjectStatus:  outGVKs,
 				SupportsTab:           outGVKs,
 			},
+			Settings: []plugin.SettingField{
+				{Name: "url", Label: "URL", Type: plugin.SettingTypeString, Default: "https://example.com", Description: "API URL"},
+			},
 		}
 		assert.Equal(t, expected, got)
 	})
@@ -311,6 +320,118 @@ func Test_GRPCClient_ObjectStatus(t *testing.T) {
 	})
 }
 
+func Test_GRPCClient_HandleAction(t *testing.T) {
+	testWithGRPCClient(t, func(mocks *grpcClientMocks) {
+		payload := action.Payload{"name": "value"}
+		payloadData, err := json.Marshal(&payload)
+		require.NoError(t, err)
+
+		expiration := time.Unix(0, 1561939200000000000)
+
+		resp := &dashboard.HandleActionResponse{
+			Alerts: []*dashboard.HandleActionResponse_Alert{
+				{Type: "INFO", Message: "message", Expiration: expiration.UnixNano()},
+				{Type: "ERROR", Message: "error"},
+			},
+			Result:   []byte(`{"id":"1"}`),
+			Redirect: "/overview",
+		}
+
+		mocks.protoClient.EXPECT().
+			HandleAction(gomock.Any(), &dashboard.HandleActionRequest{Payload: payloadData}).
+			Return(resp, nil)
+
+		client := mocks.genClient()
+		got, err := client.HandleAction(context.Background(), payload)
+		require.NoError(t, err)
+
+		expected := plugin.ActionResponse{
+			Alerts: []action.Alert{
+				{Type: action.AlertTypeInfo, Message: "message", Expiration: &expiration},
+				{Type: action.AlertTypeError, Message: "error"},
+			},
+			Result:   action.Payload{"id": "1"},
+			Redirect: "/overview",
+		}
+
+		assert.Equal(t, expected, got)
+	})
+}
+
+func Test_GRPCClient_UpdateSettings(t *testing.T) {
+	testWithGRPCClient(t, func(mocks *grpcClientMocks) {
+		settings := plugin.Settings{"url": "https://example.com"}
+		settingsData, err := json.Marshal(&settings)
+		require.NoError(t, err)
+
+		mocks.protoClient.EXPECT().
+			UpdateSettings(gomock.Any(), &dashboard.UpdateSettingsRequest{Settings: settingsData}).
+			Return(&dashboard.Empty{}, nil)
+
+		client := mocks.genClient()
+		require.NoError(t, client.UpdateSettings(context.Background(), settings))
+	})
+}
+
+func Test_GRPCClient_RelatedObjects(t *testing.T) {
+	testWithGRPCClient(t, func(mocks *grpcClientMocks) {
+		object := testutil.CreatePod("pod")
+
+		objectData, err := json.Marshal(object)
+		require.NoError(t, err)
+
+		resp := &dashboard.RelatedObjectsResponse{
+			Objects: []*dashboard.RelatedObjectsResponse_ObjectReference{
+				{ApiVersion: "v1", Kind: "Secret", Namespace: "default", Name: "secret"},
+			},
+		}
+
+		mocks.protoClient.EXPECT().
+			RelatedObjects(gomock.Any(), &dashboard.ObjectRequest{Object: objectData}).
+			Return(resp, nil)
+
+		client := mocks.genClient()
+		got, err := client.RelatedObjects(context.Background(), object)
+		require.NoError(t, err)
+
+		expected := plugin.RelatedObjectsResponse{
+			Objects: []store.Key{
+				{Namespace: "default", APIVersion: "v1", Kind: "Secret", Name: "secret"},
+			},
+		}
+
+		assert.Equal(t, expected, got)
+	})
+}
+
+func Test_GRPCClient_ListColumns(t *testing.T) {
+	testWithGRPCClient(t, func(mocks *grpcClientMocks) {
+		object := testutil.CreatePod("pod")
+
+		objectData, err := json.Marshal(object)
+		require.NoError(t, err)
+
+		row := component.TableRow{"Cost": component.NewText("$1")}
+		resp := &dashboard.ListColumnsResponse{
+			Rows: [][]byte{encodeTableRow(t, row)},
+		}
+
+		mocks.protoClient.EXPECT().
+			ListColumns(gomock.Any(), &dashboard.ListColumnsRequest{Objects: [][]byte{objectData}}).
+			Return(resp, nil)
+
+		client := mocks.genClient()
+		got, err := client.ListColumns(context.Background(), []runtime.Object{object})
+		require.NoError(t, err)
+
+		expected := plugin.ListColumnsResponse{
+			Rows: []component.TableRow{row},
+		}
+
+		assert.Equal(t, expected, got)
+	})
+}
+
 func Test_GRPCServer_Content(t *testing.T) {
 	testWithGRPCServer(t, func(mocks *grpcServerMocks) {
 		server := mocks.genModuleServer()
@@ -371,6 +492,9 @@ func Test_GRPCServer_Register(t *testing.T) {
 				SupportsObjectStatus:  inGVKs,
 				SupportsTab:           inGVKs,
 			},
+			Settings: []plugin.SettingField{
+				{Name: "enabled", Type: plugin.SettingTypeBool, Default: "true"},
+			},
 		}
 
 		apiAddress := "localhost:54321"
@@ -396,6 +520,9 @@ func Test_GRPCServer_Register(t *testing.T) {
 				SupportsObjectStatus:  outGVKs,
 				SupportsTab:           outGVKs,
 			},
+			Settings: []*dashboard.RegisterResponse_SettingField{
+				{Name: "enabled", Type: "bool", Default: "true"},
+			},
 		}
 
 		assert.Equal(t, expected, got)
@@ -500,6 +627,91 @@ func Test_GRPCServer_ObjectStatus(t *testing.T) {
 	})
 }
 
+func Test_GRPCServer_HandleAction(t *testing.T) {
+	testWithGRPCServer(t, func(mocks *grpcServerMocks) {
+		server := mocks.genServer()
+
+		payload := action.Payload{"name": "value"}
+		payloadData, err := json.Marshal(&payload)
+		require.NoError(t, err)
+
+		expiration := time.Unix(0, 1561939200000000000)
+
+		mocks.service.EXPECT().
+			HandleAction(gomock.Any(), payload).
+			Return(plugin.ActionResponse{
+				Alerts: []action.Alert{
+					{Type: action.AlertTypeWarning, Message: "message", Expiration: &expiration},
+				},
+				Result:   action.Payload{"id": "1"},
+				Redirect: "/overview",
+			}, nil)
+
+		got, err := server.HandleAction(context.Background(), &dashboard.HandleActionRequest{Payload: payloadData})
+		require.NoError(t, err)
+
+		expected := &dashboard.HandleActionResponse{
+			Alerts: []*dashboard.HandleActionResponse_Alert{
+				{Type: "WARNING", Message: "message", Expiration: expiration.UnixNano()},
+			},
+			Result:   []byte(`{"id":"1"}`),
+			Redirect: "/overview",
+		}
+
+		assert.Equal(t, expected, got)
+	})
+}
+
+func Test_GRPCServer_UpdateSettings(t *testing.T) {
+	testWithGRPCServer(t, func(mocks *grpcServerMocks) {
+		server := mocks.genServer()
+
+		settings := plugin.Settings{"url": "https://example.com"}
+		settingsData, err := json.Marshal(&settings)
+		require.NoError(t, err)
+
+		mocks.service.EXPECT().
+			UpdateSettings(gomock.Any(), settings).
+			Return(nil)
+
+		got, err := server.UpdateSettings(context.Background(), &dashboard.UpdateSettingsRequest{Settings: settingsData})
+		require.NoError(t, err)
+
+		assert.Equal(t, &dashboard.Empty{}, got)
+	})
+}
+
+func Test_GRPCServer_ListColumns(t *testing.T) {
+	testWithGRPCServer(t, func(mocks *grpcServerMocks) {
+		server := mocks.genServer()
+
+		object := testutil.ToUnstructured(t, testutil.CreatePod("pod"))
+		objectData, err := json.Marshal(object)
+		require.NoError(t, err)
+
+		row := component.TableRow{"Cost": component.NewText("$1")}
+
+		mocks.service.EXPECT().
+			ListColumns(gomock.Any(), []runtime.Object{object}).
+			Return(plugin.ListColumnsResponse{Rows: []component.TableRow{row}}, nil)
+
+		got, err := server.ListColumns(context.Background(), &dashboard.ListColumnsRequest{Objects: [][]byte{objectData}})
+		require.NoError(t, err)
+
+		expected := &dashboard.ListColumnsResponse{
+			Rows: [][]byte{encodeTableRow(t, row)},
+		}
+
+		assert.Equal(t, expected, got)
+	})
+}
+
+func encodeTableRow(t *testing.T, row component.TableRow) []byte {
+	data, err := json.Marshal(row)
+	require.NoError(t, err)
+	return data
+}
+
 func encodeComponent(t *testing.T, view component.Component) []byte {
 	data, err := json.Marshal(view)
 	require.NoError(t, err)
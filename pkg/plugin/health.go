@@ -0,0 +1,527 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubenext/lissio/internal/log"
+	"github.com/kubenext/lissio/pkg/action"
+	"github.com/kubenext/lissio/pkg/navigation"
+	"github.com/kubenext/lissio/pkg/view/component"
+)
+
+const (
+	// crashBackoffBase is how long the manager waits before restarting a
+	// plugin which crashed once. The wait doubles with each consecutive crash.
+	crashBackoffBase = 5 * time.Second
+	// crashBackoffMax is the longest the manager waits before restarting a
+	// crashed plugin.
+	crashBackoffMax = 5 * time.Minute
+	// crashResetPeriod is how long a plugin has to run before its
+	// consecutive crashes are forgotten.
+	crashResetPeriod = time.Minute
+	// pluginLogCapacity is the number of log entries kept for each plugin.
+	pluginLogCapacity = 500
+	// circuitFailureThreshold is the number of consecutive failed RPCs
+	// after which a plugin is skipped.
+	circuitFailureThreshold = 3
+	// circuitOpenPeriod is how long a plugin is skipped. Once it passes, the
+	// plugin is called again; it is skipped again if that call fails.
+	circuitOpenPeriod = 30 * time.Second
+)
+
+// renderRPCs are the RPCs made while content is rendered. Only their
+// failures count toward skipping a plugin, so failing actions or settings
+// updates don't stop a plugin's content from being shown.
+var renderRPCs = map[string]bool{
+	"Print":          true,
+	"PrintTab":       true,
+	"Content":        true,
+	"ListColumns":    true,
+	"ObjectStatus":   true,
+	"RelatedObjects": true,
+}
+
+// PluginState is the state of a plugin.
+type PluginState string
+
+const (
+	// PluginStateRunning means the plugin is running.
+	PluginStateRunning PluginState = "Running"
+	// PluginStateCrashing means the plugin crashed and is waiting to be
+	// restarted.
+	PluginStateCrashing PluginState = "Crashing"
+	// PluginStateStopped means the plugin isn't running.
+	PluginStateStopped PluginState = "Stopped"
+	// PluginStateDisabled means the plugin was disabled.
+	PluginStateDisabled PluginState = "Disabled"
+)
+
+// RPCStats are statistics for calls to a plugin RPC.
+type RPCStats struct {
+	// Calls is the number of calls.
+	Calls int
+	// Errors is the number of calls which returned an error.
+	Errors int
+	// TotalLatency is the total time spent in calls.
+	TotalLatency time.Duration
+	// MaxLatency is the latency of the slowest call.
+	MaxLatency time.Duration
+	// LastError is the error returned by the last failing call.
+	LastError string
+}
+
+// AverageLatency returns the average latency of calls.
+func (s RPCStats) AverageLatency() time.Duration {
+	if s.Calls == 0 {
+		return 0
+	}
+
+	return s.TotalLatency / time.Duration(s.Calls)
+}
+
+// LogEntry is a log entry captured from a plugin.
+type LogEntry struct {
+	Timestamp time.Time
+	Level     string
+	Message   string
+}
+
+// Health is the health of a plugin.
+type Health struct {
+	// Crashes is the number of consecutive crashes.
+	Crashes int
+	// LastCrash is when the plugin last crashed.
+	LastCrash time.Time
+	// NextRestart is when a crashing plugin will be restarted.
+	NextRestart time.Time
+	// LastError is the error which caused the last crash.
+	LastError string
+	// SkippedUntil is when the plugin will be called again after repeated
+	// RPC failures. It is zero if the plugin isn't being skipped.
+	SkippedUntil time.Time
+	// RPC are statistics for RPCs keyed by method name.
+	RPC map[string]RPCStats
+	// Logs are the most recent log entries from the plugin.
+	Logs []LogEntry
+}
+
+// crashBackoff returns how long to wait before restarting a plugin which
+// crashed a number of consecutive times.
+func crashBackoff(crashes int) time.Duration {
+	backoff := crashBackoffBase
+	for i := 1; i < crashes; i++ {
+		backoff *= 2
+		if backoff >= crashBackoffMax {
+			return crashBackoffMax
+		}
+	}
+
+	return backoff
+}
+
+// pluginHealth tracks the health of a plugin. It is safe for concurrent use.
+type pluginHealth struct {
+	crashes     int
+	crashing    bool
+	lastCrash   time.Time
+	nextRestart time.Time
+	lastError   string
+	startedAt   time.Time
+	rpc         map[string]RPCStats
+
+	// rpcFailures is the number of consecutive failed RPCs.
+	rpcFailures  int
+	skippedUntil time.Time
+
+	logs  *logBuffer
+	clock func() time.Time
+
+	mu sync.Mutex
+}
+
+func newPluginHealth() *pluginHealth {
+	return &pluginHealth{
+		rpc:   make(map[string]RPCStats),
+		logs:  newLogBuffer(pluginLogCapacity),
+		clock: time.Now,
+	}
+}
+
+// started records that the plugin started.
+func (h *pluginHealth) started(now time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.crashing = false
+	h.startedAt = now
+	h.rpcFailures = 0
+	h.skippedUntil = time.Time{}
+}
+
+// crashed records that the plugin crashed and schedules its restart.
+func (h *pluginHealth) crashed(now time.Time, err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.crashes++
+	h.crashing = true
+	h.lastCrash = now
+	h.nextRestart = now.Add(crashBackoff(h.crashes))
+	if err != nil {
+		h.lastError = err.Error()
+	}
+}
+
+// healthy records that the plugin responded. Consecutive crashes are
+// forgotten once the plugin has been running long enough.
+func (h *pluginHealth) healthy(now time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.crashes > 0 && now.Sub(h.startedAt) >= crashResetPeriod {
+		h.crashes = 0
+	}
+}
+
+// reset forgets crashes, e.g. when a plugin is restarted or disabled by the user.
+func (h *pluginHealth) reset() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.crashes = 0
+	h.crashing = false
+	h.nextRestart = time.Time{}
+}
+
+// isCrashing returns true if the plugin crashed and wasn't restarted yet.
+func (h *pluginHealth) isCrashing() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.crashing
+}
+
+// restartDue returns true if the plugin crashed and its backoff has passed.
+func (h *pluginHealth) restartDue(now time.Time) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.crashing && !now.Before(h.nextRestart)
+}
+
+// observe records a call to a plugin RPC. A plugin whose render RPCs fail
+// repeatedly is skipped for a while.
+func (h *pluginHealth) observe(method string, latency time.Duration, err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	stats := h.rpc[method]
+	stats.Calls++
+	stats.TotalLatency += latency
+	if latency > stats.MaxLatency {
+		stats.MaxLatency = latency
+	}
+
+	if err != nil {
+		stats.Errors++
+		stats.LastError = err.Error()
+	}
+	h.rpc[method] = stats
+
+	if !renderRPCs[method] {
+		return
+	}
+
+	if err != nil {
+		h.rpcFailures++
+		if h.rpcFailures >= circuitFailureThreshold {
+			h.skippedUntil = h.clock().Add(circuitOpenPeriod)
+		}
+	} else {
+		h.rpcFailures = 0
+		h.skippedUntil = time.Time{}
+	}
+}
+
+// skipped returns true if the plugin shouldn't be called because its RPCs
+// failed repeatedly.
+func (h *pluginHealth) skipped() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.clock().Before(h.skippedUntil)
+}
+
+// health returns a snapshot of the plugin's health.
+func (h *pluginHealth) health() Health {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	rpc := make(map[string]RPCStats, len(h.rpc))
+	for method, stats := range h.rpc {
+		rpc[method] = stats
+	}
+
+	return Health{
+		Crashes:      h.crashes,
+		LastCrash:    h.lastCrash,
+		NextRestart:  h.nextRestart,
+		LastError:    h.lastError,
+		SkippedUntil: h.skippedUntil,
+		RPC:          rpc,
+		Logs:         h.logs.entries(),
+	}
+}
+
+// logBuffer is a ring buffer of log entries. It is safe for concurrent use.
+type logBuffer struct {
+	list  []LogEntry
+	next  int
+	full  bool
+	mu    sync.Mutex
+	clock func() time.Time
+}
+
+func newLogBuffer(capacity int) *logBuffer {
+	return &logBuffer{
+		list:  make([]LogEntry, capacity),
+		clock: time.Now,
+	}
+}
+
+func (b *logBuffer) add(level, message string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.list) == 0 {
+		return
+	}
+
+	b.list[b.next] = LogEntry{
+		Timestamp: b.clock(),
+		Level:     level,
+		Message:   message,
+	}
+
+	b.next = (b.next + 1) % len(b.list)
+	if b.next == 0 {
+		b.full = true
+	}
+}
+
+// entries returns the entries in the buffer from oldest to newest.
+func (b *logBuffer) entries() []LogEntry {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.full {
+		return append([]LogEntry(nil), b.list[:b.next]...)
+	}
+
+	list := append([]LogEntry(nil), b.list[b.next:]...)
+	return append(list, b.list[:b.next]...)
+}
+
+// pluginLogger is a logger which captures log entries in a plugin's log
+// buffer. Warnings and errors are also sent to the dashboard's logger;
+// everything else is only sent to it at debug level so chatty plugins
+// don't flood the dashboard's log.
+type pluginLogger struct {
+	next   log.Logger
+	logs   *logBuffer
+	fields []interface{}
+}
+
+var _ log.Logger = (*pluginLogger)(nil)
+
+func newPluginLogger(next log.Logger, logs *logBuffer) *pluginLogger {
+	return &pluginLogger{
+		next: next,
+		logs: logs,
+	}
+}
+
+func (l *pluginLogger) Debugf(template string, args ...interface{}) {
+	l.record("debug", template, args...)
+	l.next.Debugf(template, args...)
+}
+
+func (l *pluginLogger) Infof(template string, args ...interface{}) {
+	l.record("info", template, args...)
+	l.next.Debugf(template, args...)
+}
+
+func (l *pluginLogger) Warnf(template string, args ...interface{}) {
+	l.record("warn", template, args...)
+	l.next.Warnf(template, args...)
+}
+
+func (l *pluginLogger) Errorf(template string, args ...interface{}) {
+	l.record("error", template, args...)
+	l.next.Errorf(template, args...)
+}
+
+func (l *pluginLogger) With(args ...interface{}) log.Logger {
+	fields := append(append([]interface{}(nil), l.fields...), args...)
+	return &pluginLogger{
+		next:   l.next.With(args...),
+		logs:   l.logs,
+		fields: fields,
+	}
+}
+
+func (l *pluginLogger) WithErr(err error) log.Logger {
+	return l.With("err", err.Error())
+}
+
+func (l *pluginLogger) Named(name string) log.Logger {
+	return &pluginLogger{
+		next:   l.next.Named(name),
+		logs:   l.logs,
+		fields: l.fields,
+	}
+}
+
+func (l *pluginLogger) record(level, template string, args ...interface{}) {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf(template, args...))
+
+	for i := 0; i+1 < len(l.fields); i += 2 {
+		sb.WriteString(fmt.Sprintf(" %v=%v", l.fields[i], l.fields[i+1]))
+	}
+
+	l.logs.add(level, sb.String())
+}
+
+// rpcObserver observes calls to plugin RPCs.
+type rpcObserver func(method string, latency time.Duration, err error)
+
+func (o rpcObserver) observe(method string, start time.Time, err error) {
+	o(method, time.Since(start), err)
+}
+
+// instrumentedService is a Service which records statistics for its RPCs.
+type instrumentedService struct {
+	Service
+	observer rpcObserver
+}
+
+var _ Service = (*instrumentedService)(nil)
+
+func (s *instrumentedService) Print(ctx context.Context, object runtime.Object) (PrintResponse, error) {
+	start := time.Now()
+	resp, err := s.Service.Print(ctx, object)
+	s.observer.observe("Print", start, err)
+	return resp, err
+}
+
+func (s *instrumentedService) PrintTab(ctx context.Context, object runtime.Object) (TabResponse, error) {
+	start := time.Now()
+	resp, err := s.Service.PrintTab(ctx, object)
+	s.observer.observe("PrintTab", start, err)
+	return resp, err
+}
+
+func (s *instrumentedService) ObjectStatus(ctx context.Context, object runtime.Object) (ObjectStatusResponse, error) {
+	start := time.Now()
+	resp, err := s.Service.ObjectStatus(ctx, object)
+	s.observer.observe("ObjectStatus", start, err)
+	return resp, err
+}
+
+func (s *instrumentedService) RelatedObjects(ctx context.Context, object runtime.Object) (RelatedObjectsResponse, error) {
+	start := time.Now()
+	resp, err := s.Service.RelatedObjects(ctx, object)
+	s.observer.observe("RelatedObjects", start, err)
+	return resp, err
+}
+
+func (s *instrumentedService) HandleAction(ctx context.Context, payload action.Payload) (ActionResponse, error) {
+	start := time.Now()
+	resp, err := s.Service.HandleAction(ctx, payload)
+	s.observer.observe("HandleAction", start, err)
+	return resp, err
+}
+
+func (s *instrumentedService) UpdateSettings(ctx context.Context, settings Settings) error {
+	start := time.Now()
+	err := s.Service.UpdateSettings(ctx, settings)
+	s.observer.observe("UpdateSettings", start, err)
+	return err
+}
+
+func (s *instrumentedService) ListColumns(ctx context.Context, objects []runtime.Object) (ListColumnsResponse, error) {
+	start := time.Now()
+	resp, err := s.Service.ListColumns(ctx, objects)
+	s.observer.observe("ListColumns", start, err)
+	return resp, err
+}
+
+// instrumentedModuleService is a ModuleService which records statistics
+// for its RPCs.
+type instrumentedModuleService struct {
+	ModuleService
+	service *instrumentedService
+}
+
+var _ ModuleService = (*instrumentedModuleService)(nil)
+
+func instrumentModuleService(service ModuleService, observer rpcObserver) *instrumentedModuleService {
+	return &instrumentedModuleService{
+		ModuleService: service,
+		service:       &instrumentedService{Service: service, observer: observer},
+	}
+}
+
+func (s *instrumentedModuleService) Print(ctx context.Context, object runtime.Object) (PrintResponse, error) {
+	return s.service.Print(ctx, object)
+}
+
+func (s *instrumentedModuleService) PrintTab(ctx context.Context, object runtime.Object) (TabResponse, error) {
+	return s.service.PrintTab(ctx, object)
+}
+
+func (s *instrumentedModuleService) ObjectStatus(ctx context.Context, object runtime.Object) (ObjectStatusResponse, error) {
+	return s.service.ObjectStatus(ctx, object)
+}
+
+func (s *instrumentedModuleService) RelatedObjects(ctx context.Context, object runtime.Object) (RelatedObjectsResponse, error) {
+	return s.service.RelatedObjects(ctx, object)
+}
+
+func (s *instrumentedModuleService) HandleAction(ctx context.Context, payload action.Payload) (ActionResponse, error) {
+	return s.service.HandleAction(ctx, payload)
+}
+
+func (s *instrumentedModuleService) UpdateSettings(ctx context.Context, settings Settings) error {
+	return s.service.UpdateSettings(ctx, settings)
+}
+
+func (s *instrumentedModuleService) ListColumns(ctx context.Context, objects []runtime.Object) (ListColumnsResponse, error) {
+	return s.service.ListColumns(ctx, objects)
+}
+
+func (s *instrumentedModuleService) Content(ctx context.Context, contentPath string) (component.ContentResponse, error) {
+	start := time.Now()
+	resp, err := s.ModuleService.Content(ctx, contentPath)
+	s.service.observer.observe("Content", start, err)
+	return resp, err
+}
+
+func (s *instrumentedModuleService) Navigation(ctx context.Context) (navigation.Navigation, error) {
+	start := time.Now()
+	nav, err := s.ModuleService.Navigation(ctx)
+	s.service.observer.observe("Navigation", start, err)
+	return nav, err
+}
@@ -0,0 +1,185 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubenext/lissio/internal/log"
+)
+
+func Test_crashBackoff(t *testing.T) {
+	tests := []struct {
+		crashes  int
+		expected time.Duration
+	}{
+		{crashes: 1, expected: 5 * time.Second},
+		{crashes: 2, expected: 10 * time.Second},
+		{crashes: 4, expected: 40 * time.Second},
+		{crashes: 7, expected: 5 * time.Minute},
+		{crashes: 100, expected: 5 * time.Minute},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%d crashes", test.crashes), func(t *testing.T) {
+			assert.Equal(t, test.expected, crashBackoff(test.crashes))
+		})
+	}
+}
+
+func Test_pluginHealth(t *testing.T) {
+	h := newPluginHealth()
+	now := time.Unix(1000, 0)
+
+	h.started(now)
+	assert.False(t, h.isCrashing())
+
+	h.crashed(now, errors.New("crashed"))
+	h.crashed(now, errors.New("crashed again"))
+	assert.True(t, h.isCrashing())
+	assert.False(t, h.restartDue(now.Add(9*time.Second)))
+	assert.True(t, h.restartDue(now.Add(10*time.Second)))
+
+	health := h.health()
+	assert.Equal(t, 2, health.Crashes)
+	assert.Equal(t, now, health.LastCrash)
+	assert.Equal(t, now.Add(10*time.Second), health.NextRestart)
+	assert.Equal(t, "crashed again", health.LastError)
+
+	restarted := now.Add(10 * time.Second)
+	h.started(restarted)
+	assert.False(t, h.isCrashing())
+
+	h.healthy(restarted.Add(30 * time.Second))
+	assert.Equal(t, 2, h.health().Crashes)
+
+	h.healthy(restarted.Add(crashResetPeriod))
+	assert.Equal(t, 0, h.health().Crashes)
+}
+
+func Test_pluginHealth_observe(t *testing.T) {
+	h := newPluginHealth()
+
+	h.observe("Print", 2*time.Millisecond, nil)
+	h.observe("Print", 4*time.Millisecond, errors.New("failed"))
+
+	stats := h.health().RPC["Print"]
+	assert.Equal(t, 2, stats.Calls)
+	assert.Equal(t, 1, stats.Errors)
+	assert.Equal(t, 4*time.Millisecond, stats.MaxLatency)
+	assert.Equal(t, 3*time.Millisecond, stats.AverageLatency())
+	assert.Equal(t, "failed", stats.LastError)
+}
+
+func Test_logBuffer(t *testing.T) {
+	b := newLogBuffer(3)
+	b.clock = func() time.Time { return time.Unix(0, 0) }
+
+	assert.Empty(t, b.entries())
+
+	for i := 0; i < 4; i++ {
+		b.add("info", fmt.Sprintf("message %d", i))
+	}
+
+	var messages []string
+	for _, entry := range b.entries() {
+		messages = append(messages, entry.Message)
+	}
+
+	assert.Equal(t, []string{"message 1", "message 2", "message 3"}, messages)
+}
+
+func Test_pluginLogger(t *testing.T) {
+	b := newLogBuffer(10)
+	logger := newPluginLogger(log.NopLogger(), b)
+
+	logger.Infof("started %s", "plugin")
+	logger.With("path", "/plugin").WithErr(errors.New("boom")).Errorf("failed")
+
+	entries := b.entries()
+	require.Len(t, entries, 2)
+	assert.Equal(t, "info", entries[0].Level)
+	assert.Equal(t, "started plugin", entries[0].Message)
+	assert.Equal(t, "error", entries[1].Level)
+	assert.Equal(t, "failed path=/plugin err=boom", entries[1].Message)
+}
+
+type stubPrintService struct {
+	Service
+	err error
+}
+
+func (s *stubPrintService) Print(ctx context.Context, object runtime.Object) (PrintResponse, error) {
+	return PrintResponse{}, s.err
+}
+
+func Test_instrumentedService(t *testing.T) {
+	h := newPluginHealth()
+	service := &instrumentedService{
+		Service:  &stubPrintService{err: errors.New("failed")},
+		observer: h.observe,
+	}
+
+	_, err := service.Print(context.Background(), nil)
+	require.Error(t, err)
+
+	stats := h.health().RPC["Print"]
+	assert.Equal(t, 1, stats.Calls)
+	assert.Equal(t, 1, stats.Errors)
+}
+
+func Test_pluginHealth_circuit(t *testing.T) {
+	h := newPluginHealth()
+	now := time.Unix(1000, 0)
+	h.clock = func() time.Time { return now }
+
+	for i := 0; i < circuitFailureThreshold-1; i++ {
+		h.observe("Print", time.Millisecond, errors.New("failed"))
+	}
+	assert.False(t, h.skipped())
+
+	h.observe("Print", time.Millisecond, errors.New("failed"))
+	assert.True(t, h.skipped())
+	assert.Equal(t, now.Add(circuitOpenPeriod), h.health().SkippedUntil)
+
+	now = now.Add(circuitOpenPeriod)
+	assert.False(t, h.skipped())
+
+	// The plugin is skipped again if it fails after the open period.
+	h.observe("Print", time.Millisecond, errors.New("failed"))
+	assert.True(t, h.skipped())
+
+	now = now.Add(circuitOpenPeriod)
+	h.observe("Print", time.Millisecond, nil)
+	assert.False(t, h.skipped())
+	assert.True(t, h.health().SkippedUntil.IsZero())
+}
+
+func Test_pluginHealth_circuit_ignores_actions(t *testing.T) {
+	h := newPluginHealth()
+
+	for i := 0; i < circuitFailureThreshold; i++ {
+		h.observe("HandleAction", time.Millisecond, errors.New("invalid form"))
+		h.observe("UpdateSettings", time.Millisecond, errors.New("invalid setting"))
+	}
+	assert.False(t, h.skipped())
+	assert.Equal(t, circuitFailureThreshold, h.health().RPC["HandleAction"].Errors)
+
+	// Successful actions don't close the circuit for failing renders.
+	for i := 0; i < circuitFailureThreshold; i++ {
+		h.observe("Print", time.Millisecond, errors.New("failed"))
+	}
+	h.observe("HandleAction", time.Millisecond, nil)
+	assert.True(t, h.skipped())
+}
@@ -0,0 +1,819 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-plugin"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kubenext/lissio/pkg/action"
+	"github.com/kubenext/lissio/pkg/navigation"
+	"github.com/kubenext/lissio/pkg/plugin/dashboard"
+	"github.com/kubenext/lissio/pkg/store"
+	"github.com/kubenext/lissio/pkg/view/component"
+)
+
+// Plugins reached over HTTP expose the plugin service as JSON. Each method
+// is a POST to <url>/<method> with a JSON request body and a JSON response
+// body. A plugin is healthy when a GET to <url>/health succeeds. Failures
+// are returned with a non 2xx status and a body of {"error": "message"}.
+const (
+	httpMethodRegister       = "register"
+	httpMethodPrint          = "print"
+	httpMethodPrintTab       = "printTab"
+	httpMethodObjectStatus   = "objectStatus"
+	httpMethodRelatedObjects = "relatedObjects"
+	httpMethodHandleAction   = "handleAction"
+	httpMethodUpdateSettings = "updateSettings"
+	httpMethodListColumns    = "listColumns"
+	httpMethodNavigation     = "navigation"
+	httpMethodContent        = "content"
+	httpHealthPath           = "health"
+
+	// httpPluginTimeout is the longest a call to a plugin reached over HTTP
+	// can take. Calls made while content is generated have shorter deadlines.
+	httpPluginTimeout = 30 * time.Second
+	// httpPingTimeout is how long a plugin has to respond to health checks.
+	httpPingTimeout = 5 * time.Second
+)
+
+type httpRegisterRequest struct {
+	DashboardAPIAddress string `json:"dashboardAPIAddress"`
+}
+
+type httpGroupVersionKind struct {
+	Group   string `json:"group"`
+	Version string `json:"version"`
+	Kind    string `json:"kind"`
+}
+
+type httpCapabilities struct {
+	SupportsPrinterConfig []httpGroupVersionKind `json:"supportsPrinterConfig,omitempty"`
+	SupportsPrinterStatus []httpGroupVersionKind `json:"supportsPrinterStatus,omitempty"`
+	SupportsPrinterItems  []httpGroupVersionKind `json:"supportsPrinterItems,omitempty"`
+	SupportsObjectStatus  []httpGroupVersionKind `json:"supportsObjectStatus,omitempty"`
+	SupportsTab           []httpGroupVersionKind `json:"supportsTab,omitempty"`
+	SupportsObjectVisitor []httpGroupVersionKind `json:"supportsObjectVisitor,omitempty"`
+	SupportsListColumns   []httpListColumns      `json:"supportsListColumns,omitempty"`
+	IsModule              bool                   `json:"isModule,omitempty"`
+	ActionNames           []string               `json:"actionNames,omitempty"`
+}
+
+type httpListColumns struct {
+	GroupVersionKind httpGroupVersionKind `json:"groupVersionKind"`
+	Columns          []string             `json:"columns"`
+}
+
+type httpSettingField struct {
+	Name        string `json:"name"`
+	Label       string `json:"label,omitempty"`
+	Type        string `json:"type,omitempty"`
+	Default     string `json:"default,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type httpRegisterResponse struct {
+	Name         string             `json:"name"`
+	Description  string             `json:"description,omitempty"`
+	Capabilities httpCapabilities   `json:"capabilities"`
+	Settings     []httpSettingField `json:"settings,omitempty"`
+}
+
+type httpObjectRequest struct {
+	Object json.RawMessage `json:"object"`
+}
+
+type httpPrintResponse struct {
+	Config []component.SummarySection `json:"config,omitempty"`
+	Status []component.SummarySection `json:"status,omitempty"`
+	Items  []component.FlexLayoutItem `json:"items,omitempty"`
+}
+
+type httpPrintTabResponse struct {
+	Name   string          `json:"name"`
+	Layout json.RawMessage `json:"layout"`
+}
+
+type httpObjectReference struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+}
+
+type httpRelatedObjectsResponse struct {
+	Objects []httpObjectReference `json:"objects,omitempty"`
+}
+
+type httpHandleActionRequest struct {
+	Payload action.Payload `json:"payload"`
+}
+
+type httpHandleActionResponse struct {
+	Alerts   []action.Alert `json:"alerts,omitempty"`
+	Result   action.Payload `json:"result,omitempty"`
+	Redirect string         `json:"redirect,omitempty"`
+}
+
+type httpUpdateSettingsRequest struct {
+	Settings Settings `json:"settings"`
+}
+
+type httpListColumnsRequest struct {
+	Objects []json.RawMessage `json:"objects"`
+}
+
+type httpListColumnsResponse struct {
+	Rows []component.TableRow `json:"rows"`
+}
+
+type httpContentRequest struct {
+	Path string `json:"path"`
+}
+
+type httpError struct {
+	Error string `json:"error"`
+}
+
+// HTTPClient is the client the dashboard uses to call a plugin over HTTP.
+type HTTPClient struct {
+	url       string
+	client    *http.Client
+	transport *http.Transport
+}
+
+var _ Service = (*HTTPClient)(nil)
+var _ ModuleService = (*HTTPClient)(nil)
+
+// NewHTTPClient creates an instance of HTTPClient for a plugin at a URL.
+func NewHTTPClient(pluginURL string) (*HTTPClient, error) {
+	u, err := url.Parse(pluginURL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse plugin url %q", pluginURL)
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, errors.Errorf("plugin url %q must use http or https", pluginURL)
+	}
+
+	if u.Host == "" {
+		return nil, errors.Errorf("plugin url %q has no host", pluginURL)
+	}
+
+	transport := &http.Transport{Proxy: http.ProxyFromEnvironment}
+
+	return &HTTPClient{
+		url:       strings.TrimSuffix(pluginURL, "/"),
+		client:    &http.Client{Timeout: httpPluginTimeout, Transport: transport},
+		transport: transport,
+	}, nil
+}
+
+// call posts a request to a plugin method and decodes the response into out
+// if it isn't nil.
+func (c *HTTPClient) call(ctx context.Context, method string, in, out interface{}) error {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return errors.Wrapf(err, "encode %s request", method)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, c.url+"/"+method, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return errors.Wrapf(err, "http client %s", method)
+	}
+	defer resp.Body.Close()
+
+	if err := checkHTTPResponse(resp); err != nil {
+		return errors.Wrapf(err, "http client %s", method)
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return errors.Wrapf(err, "decode %s response", method)
+	}
+
+	return nil
+}
+
+// checkHTTPResponse returns the error a plugin sent if the response failed.
+func checkHTTPResponse(resp *http.Response) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	data, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 64*1024))
+
+	var he httpError
+	if err := json.Unmarshal(data, &he); err == nil && he.Error != "" {
+		return errors.Errorf("plugin error (%d): %s", resp.StatusCode, he.Error)
+	}
+
+	return errors.Errorf("plugin error (%d): %s", resp.StatusCode, strings.TrimSpace(string(data)))
+}
+
+// Ping checks the plugin is healthy.
+func (c *HTTPClient) Ping(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, httpPingTimeout)
+	defer cancel()
+
+	req, err := http.NewRequest(http.MethodGet, c.url+"/"+httpHealthPath, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return errors.Wrap(err, "http client health")
+	}
+	defer resp.Body.Close()
+
+	return checkHTTPResponse(resp)
+}
+
+// Register registers a plugin.
+func (c *HTTPClient) Register(ctx context.Context, dashboardAPIAddress string) (Metadata, error) {
+	var resp httpRegisterResponse
+	req := httpRegisterRequest{DashboardAPIAddress: dashboardAPIAddress}
+	if err := c.call(ctx, httpMethodRegister, req, &resp); err != nil {
+		return Metadata{}, err
+	}
+
+	return convertFromHTTPRegisterResponse(resp), nil
+}
+
+// Print prints an object.
+func (c *HTTPClient) Print(ctx context.Context, object runtime.Object) (PrintResponse, error) {
+	req, err := createHTTPObjectRequest(object)
+	if err != nil {
+		return PrintResponse{}, err
+	}
+
+	var resp httpPrintResponse
+	if err := c.call(ctx, httpMethodPrint, req, &resp); err != nil {
+		return PrintResponse{}, err
+	}
+
+	return PrintResponse{
+		Config: resp.Config,
+		Status: resp.Status,
+		Items:  resp.Items,
+	}, nil
+}
+
+// PrintTab creates a tab for an object.
+func (c *HTTPClient) PrintTab(ctx context.Context, object runtime.Object) (TabResponse, error) {
+	req, err := createHTTPObjectRequest(object)
+	if err != nil {
+		return TabResponse{}, err
+	}
+
+	var resp httpPrintTabResponse
+	if err := c.call(ctx, httpMethodPrintTab, req, &resp); err != nil {
+		return TabResponse{}, err
+	}
+
+	var to component.TypedObject
+	if err := json.Unmarshal(resp.Layout, &to); err != nil {
+		return TabResponse{}, errors.Wrap(err, "decode tab layout")
+	}
+
+	view, err := to.ToComponent()
+	if err != nil {
+		return TabResponse{}, err
+	}
+
+	layout, ok := view.(*component.FlexLayout)
+	if !ok {
+		return TabResponse{}, errors.Errorf("expected to be flex layout was: %T", view)
+	}
+
+	return TabResponse{
+		Tab: &component.Tab{Name: resp.Name, Contents: *layout},
+	}, nil
+}
+
+// ObjectStatus gets an object status.
+func (c *HTTPClient) ObjectStatus(ctx context.Context, object runtime.Object) (ObjectStatusResponse, error) {
+	req, err := createHTTPObjectRequest(object)
+	if err != nil {
+		return ObjectStatusResponse{}, err
+	}
+
+	var objectStatus component.PodSummary
+	if err := c.call(ctx, httpMethodObjectStatus, req, &objectStatus); err != nil {
+		return ObjectStatusResponse{}, err
+	}
+
+	return ObjectStatusResponse{ObjectStatus: objectStatus}, nil
+}
+
+// RelatedObjects returns objects related to an object.
+func (c *HTTPClient) RelatedObjects(ctx context.Context, object runtime.Object) (RelatedObjectsResponse, error) {
+	req, err := createHTTPObjectRequest(object)
+	if err != nil {
+		return RelatedObjectsResponse{}, err
+	}
+
+	var resp httpRelatedObjectsResponse
+	if err := c.call(ctx, httpMethodRelatedObjects, req, &resp); err != nil {
+		return RelatedObjectsResponse{}, err
+	}
+
+	var out RelatedObjectsResponse
+	for _, ref := range resp.Objects {
+		out.Objects = append(out.Objects, store.Key{
+			Namespace:  ref.Namespace,
+			APIVersion: ref.APIVersion,
+			Kind:       ref.Kind,
+			Name:       ref.Name,
+		})
+	}
+
+	return out, nil
+}
+
+// HandleAction runs an action on a plugin.
+func (c *HTTPClient) HandleAction(ctx context.Context, payload action.Payload) (ActionResponse, error) {
+	var resp httpHandleActionResponse
+	if err := c.call(ctx, httpMethodHandleAction, httpHandleActionRequest{Payload: payload}, &resp); err != nil {
+		return ActionResponse{}, err
+	}
+
+	return ActionResponse{
+		Alerts:   resp.Alerts,
+		Result:   resp.Result,
+		Redirect: resp.Redirect,
+	}, nil
+}
+
+// UpdateSettings sends settings to a plugin.
+func (c *HTTPClient) UpdateSettings(ctx context.Context, settings Settings) error {
+	return c.call(ctx, httpMethodUpdateSettings, httpUpdateSettingsRequest{Settings: settings}, nil)
+}
+
+// ListColumns returns cells for the columns a plugin adds to a list of objects.
+func (c *HTTPClient) ListColumns(ctx context.Context, objects []runtime.Object) (ListColumnsResponse, error) {
+	var req httpListColumnsRequest
+	for _, object := range objects {
+		data, err := json.Marshal(object)
+		if err != nil {
+			return ListColumnsResponse{}, err
+		}
+		req.Objects = append(req.Objects, data)
+	}
+
+	var resp httpListColumnsResponse
+	if err := c.call(ctx, httpMethodListColumns, req, &resp); err != nil {
+		return ListColumnsResponse{}, err
+	}
+
+	return ListColumnsResponse{Rows: resp.Rows}, nil
+}
+
+// Navigation returns navigation entries from a plugin.
+func (c *HTTPClient) Navigation(ctx context.Context) (navigation.Navigation, error) {
+	var entries navigation.Navigation
+	if err := c.call(ctx, httpMethodNavigation, struct{}{}, &entries); err != nil {
+		return navigation.Navigation{}, err
+	}
+
+	return entries, nil
+}
+
+// Content returns content from a plugin.
+func (c *HTTPClient) Content(ctx context.Context, contentPath string) (component.ContentResponse, error) {
+	var contentResponse component.ContentResponse
+	if err := c.call(ctx, httpMethodContent, httpContentRequest{Path: contentPath}, &contentResponse); err != nil {
+		return component.ContentResponse{}, err
+	}
+
+	return contentResponse, nil
+}
+
+func createHTTPObjectRequest(object runtime.Object) (httpObjectRequest, error) {
+	data, err := json.Marshal(object)
+	if err != nil {
+		return httpObjectRequest{}, err
+	}
+
+	return httpObjectRequest{Object: data}, nil
+}
+
+// HTTPServer serves a plugin service over HTTP. It is the counterpart of
+// HTTPClient and documents the protocol plugins written in other languages
+// implement.
+type HTTPServer struct {
+	Impl Service
+
+	mux *http.ServeMux
+}
+
+var _ http.Handler = (*HTTPServer)(nil)
+
+// NewHTTPServer creates an instance of HTTPServer.
+func NewHTTPServer(impl Service) *HTTPServer {
+	s := &HTTPServer{Impl: impl}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/"+httpHealthPath, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	s.handle(mux, httpMethodRegister, s.register)
+	s.handle(mux, httpMethodPrint, s.print)
+	s.handle(mux, httpMethodPrintTab, s.printTab)
+	s.handle(mux, httpMethodObjectStatus, s.objectStatus)
+	s.handle(mux, httpMethodRelatedObjects, s.relatedObjects)
+	s.handle(mux, httpMethodHandleAction, s.handleAction)
+	s.handle(mux, httpMethodUpdateSettings, s.updateSettings)
+	s.handle(mux, httpMethodListColumns, s.listColumns)
+	s.handle(mux, httpMethodNavigation, s.navigation)
+	s.handle(mux, httpMethodContent, s.content)
+	s.mux = mux
+
+	return s
+}
+
+// ServeHTTP serves the plugin service.
+func (s *HTTPServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+type httpMethodFunc func(ctx context.Context, body []byte) (interface{}, error)
+
+func (s *HTTPServer) handle(mux *http.ServeMux, method string, fn httpMethodFunc) {
+	mux.HandleFunc("/"+method, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			writeHTTPError(w, http.StatusMethodNotAllowed, errors.Errorf("%s requires POST", method))
+			return
+		}
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			writeHTTPError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		out, err := fn(r.Context(), body)
+		if err != nil {
+			writeHTTPError(w, http.StatusInternalServerError, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if out == nil {
+			out = struct{}{}
+		}
+		if err := json.NewEncoder(w).Encode(out); err != nil {
+			writeHTTPError(w, http.StatusInternalServerError, err)
+		}
+	})
+}
+
+func writeHTTPError(w http.ResponseWriter, code int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(httpError{Error: fmt.Sprintf("%s", err)})
+}
+
+func (s *HTTPServer) register(ctx context.Context, body []byte) (interface{}, error) {
+	var req httpRegisterRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, err
+	}
+
+	m, err := s.Impl.Register(ctx, req.DashboardAPIAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertToHTTPRegisterResponse(m), nil
+}
+
+func (s *HTTPServer) print(ctx context.Context, body []byte) (interface{}, error) {
+	object, err := decodeHTTPObjectRequest(body)
+	if err != nil {
+		return nil, err
+	}
+
+	pr, err := s.Impl.Print(ctx, object)
+	if err != nil {
+		return nil, err
+	}
+
+	return httpPrintResponse{Config: pr.Config, Status: pr.Status, Items: pr.Items}, nil
+}
+
+func (s *HTTPServer) printTab(ctx context.Context, body []byte) (interface{}, error) {
+	object, err := decodeHTTPObjectRequest(body)
+	if err != nil {
+		return nil, err
+	}
+
+	tabResponse, err := s.Impl.PrintTab(ctx, object)
+	if err != nil {
+		return nil, err
+	}
+
+	if tabResponse.Tab == nil {
+		return nil, errors.New("tab is nil")
+	}
+
+	layout, err := json.Marshal(tabResponse.Tab.Contents)
+	if err != nil {
+		return nil, err
+	}
+
+	return httpPrintTabResponse{Name: tabResponse.Tab.Name, Layout: layout}, nil
+}
+
+func (s *HTTPServer) objectStatus(ctx context.Context, body []byte) (interface{}, error) {
+	object, err := decodeHTTPObjectRequest(body)
+	if err != nil {
+		return nil, err
+	}
+
+	osr, err := s.Impl.ObjectStatus(ctx, object)
+	if err != nil {
+		return nil, err
+	}
+
+	return osr.ObjectStatus, nil
+}
+
+func (s *HTTPServer) relatedObjects(ctx context.Context, body []byte) (interface{}, error) {
+	object, err := decodeHTTPObjectRequest(body)
+	if err != nil {
+		return nil, err
+	}
+
+	ror, err := s.Impl.RelatedObjects(ctx, object)
+	if err != nil {
+		return nil, err
+	}
+
+	var out httpRelatedObjectsResponse
+	for _, key := range ror.Objects {
+		out.Objects = append(out.Objects, httpObjectReference{
+			APIVersion: key.APIVersion,
+			Kind:       key.Kind,
+			Namespace:  key.Namespace,
+			Name:       key.Name,
+		})
+	}
+
+	return out, nil
+}
+
+func (s *HTTPServer) handleAction(ctx context.Context, body []byte) (interface{}, error) {
+	var req httpHandleActionRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.Impl.HandleAction(ctx, req.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return httpHandleActionResponse{
+		Alerts:   resp.Alerts,
+		Result:   resp.Result,
+		Redirect: resp.Redirect,
+	}, nil
+}
+
+func (s *HTTPServer) updateSettings(ctx context.Context, body []byte) (interface{}, error) {
+	var req httpUpdateSettingsRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, err
+	}
+
+	return nil, s.Impl.UpdateSettings(ctx, req.Settings)
+}
+
+func (s *HTTPServer) listColumns(ctx context.Context, body []byte) (interface{}, error) {
+	var req httpListColumnsRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, err
+	}
+
+	var objects []runtime.Object
+	for _, data := range req.Objects {
+		u, err := decodeObjectRequest(&dashboard.ObjectRequest{Object: data})
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, u)
+	}
+
+	lcr, err := s.Impl.ListColumns(ctx, objects)
+	if err != nil {
+		return nil, err
+	}
+
+	return httpListColumnsResponse{Rows: lcr.Rows}, nil
+}
+
+func (s *HTTPServer) navigation(ctx context.Context, body []byte) (interface{}, error) {
+	service, ok := s.Impl.(ModuleService)
+	if !ok {
+		return nil, errors.Errorf("plugin is not a module, it's a %T", s.Impl)
+	}
+
+	return service.Navigation(ctx)
+}
+
+func (s *HTTPServer) content(ctx context.Context, body []byte) (interface{}, error) {
+	service, ok := s.Impl.(ModuleService)
+	if !ok {
+		return nil, errors.Errorf("plugin is not a module, it's a %T", s.Impl)
+	}
+
+	var req httpContentRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, err
+	}
+
+	return service.Content(ctx, req.Path)
+}
+
+func decodeHTTPObjectRequest(body []byte) (runtime.Object, error) {
+	var req httpObjectRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, err
+	}
+
+	return decodeObjectRequest(&dashboard.ObjectRequest{Object: req.Object})
+}
+
+// httpPluginClient is the Client for a plugin reached over HTTP. The plugin
+// runs on its own, so killing the client only closes idle connections.
+type httpPluginClient struct {
+	service *HTTPClient
+}
+
+var _ Client = (*httpPluginClient)(nil)
+var _ plugin.ClientProtocol = (*httpPluginClient)(nil)
+
+func newHTTPPluginClient(pluginURL string) (*httpPluginClient, error) {
+	service, err := NewHTTPClient(pluginURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &httpPluginClient{service: service}, nil
+}
+
+func (c *httpPluginClient) Client() (plugin.ClientProtocol, error) {
+	return c, nil
+}
+
+func (c *httpPluginClient) Kill() {
+	_ = c.Close()
+}
+
+func (c *httpPluginClient) Close() error {
+	c.service.transport.CloseIdleConnections()
+	return nil
+}
+
+func (c *httpPluginClient) Dispense(name string) (interface{}, error) {
+	if name != Name {
+		return nil, errors.Errorf("unknown plugin type %q", name)
+	}
+
+	return c.service, nil
+}
+
+func (c *httpPluginClient) Ping() error {
+	return c.service.Ping(context.Background())
+}
+
+func convertFromHTTPGroupVersionKinds(in []httpGroupVersionKind) []schema.GroupVersionKind {
+	var list []schema.GroupVersionKind
+	for _, gvk := range in {
+		list = append(list, schema.GroupVersionKind{Group: gvk.Group, Version: gvk.Version, Kind: gvk.Kind})
+	}
+
+	return list
+}
+
+func convertToHTTPGroupVersionKinds(in []schema.GroupVersionKind) []httpGroupVersionKind {
+	var list []httpGroupVersionKind
+	for _, gvk := range in {
+		list = append(list, httpGroupVersionKind{Group: gvk.Group, Version: gvk.Version, Kind: gvk.Kind})
+	}
+
+	return list
+}
+
+func convertFromHTTPListColumns(in []httpListColumns) []ListColumns {
+	var list []ListColumns
+	for _, lc := range in {
+		gvk := lc.GroupVersionKind
+		list = append(list, ListColumns{
+			GroupVersionKind: schema.GroupVersionKind{Group: gvk.Group, Version: gvk.Version, Kind: gvk.Kind},
+			Columns:          lc.Columns,
+		})
+	}
+
+	return list
+}
+
+func convertToHTTPListColumns(in []ListColumns) []httpListColumns {
+	var list []httpListColumns
+	for _, lc := range in {
+		gvk := lc.GroupVersionKind
+		list = append(list, httpListColumns{
+			GroupVersionKind: httpGroupVersionKind{Group: gvk.Group, Version: gvk.Version, Kind: gvk.Kind},
+			Columns:          lc.Columns,
+		})
+	}
+
+	return list
+}
+
+func convertFromHTTPRegisterResponse(in httpRegisterResponse) Metadata {
+	m := Metadata{
+		Name:        in.Name,
+		Description: in.Description,
+		Capabilities: Capabilities{
+			SupportsPrinterConfig: convertFromHTTPGroupVersionKinds(in.Capabilities.SupportsPrinterConfig),
+			SupportsPrinterStatus: convertFromHTTPGroupVersionKinds(in.Capabilities.SupportsPrinterStatus),
+			SupportsPrinterItems:  convertFromHTTPGroupVersionKinds(in.Capabilities.SupportsPrinterItems),
+			SupportsObjectStatus:  convertFromHTTPGroupVersionKinds(in.Capabilities.SupportsObjectStatus),
+			SupportsTab:           convertFromHTTPGroupVersionKinds(in.Capabilities.SupportsTab),
+			SupportsObjectVisitor: convertFromHTTPGroupVersionKinds(in.Capabilities.SupportsObjectVisitor),
+			SupportsListColumns:   convertFromHTTPListColumns(in.Capabilities.SupportsListColumns),
+			IsModule:              in.Capabilities.IsModule,
+			ActionNames:           in.Capabilities.ActionNames,
+		},
+	}
+
+	for _, field := range in.Settings {
+		m.Settings = append(m.Settings, SettingField{
+			Name:        field.Name,
+			Label:       field.Label,
+			Type:        SettingType(field.Type),
+			Default:     field.Default,
+			Description: field.Description,
+		})
+	}
+
+	return m
+}
+
+func convertToHTTPRegisterResponse(in Metadata) httpRegisterResponse {
+	out := httpRegisterResponse{
+		Name:        in.Name,
+		Description: in.Description,
+		Capabilities: httpCapabilities{
+			SupportsPrinterConfig: convertToHTTPGroupVersionKinds(in.Capabilities.SupportsPrinterConfig),
+			SupportsPrinterStatus: convertToHTTPGroupVersionKinds(in.Capabilities.SupportsPrinterStatus),
+			SupportsPrinterItems:  convertToHTTPGroupVersionKinds(in.Capabilities.SupportsPrinterItems),
+			SupportsObjectStatus:  convertToHTTPGroupVersionKinds(in.Capabilities.SupportsObjectStatus),
+			SupportsTab:           convertToHTTPGroupVersionKinds(in.Capabilities.SupportsTab),
+			SupportsObjectVisitor: convertToHTTPGroupVersionKinds(in.Capabilities.SupportsObjectVisitor),
+			SupportsListColumns:   convertToHTTPListColumns(in.Capabilities.SupportsListColumns),
+			IsModule:              in.Capabilities.IsModule,
+			ActionNames:           in.Capabilities.ActionNames,
+		},
+	}
+
+	for _, field := range in.Settings {
+		out.Settings = append(out.Settings, httpSettingField{
+			Name:        field.Name,
+			Label:       field.Label,
+			Type:        string(field.Type),
+			Default:     field.Default,
+			Description: field.Description,
+		})
+	}
+
+	return out
+}
@@ -0,0 +1,246 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kubenext/lissio/internal/testutil"
+	"github.com/kubenext/lissio/pkg/action"
+	"github.com/kubenext/lissio/pkg/navigation"
+	"github.com/kubenext/lissio/pkg/plugin"
+	"github.com/kubenext/lissio/pkg/plugin/fake"
+	"github.com/kubenext/lissio/pkg/store"
+	"github.com/kubenext/lissio/pkg/view/component"
+)
+
+func testWithHTTPPlugin(t *testing.T, fn func(service *fake.MockModuleService, client *plugin.HTTPClient)) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	service := fake.NewMockModuleService(controller)
+
+	server := httptest.NewServer(plugin.NewHTTPServer(service))
+	defer server.Close()
+
+	client, err := plugin.NewHTTPClient(server.URL)
+	require.NoError(t, err)
+
+	fn(service, client)
+}
+
+func TestNewHTTPClient_invalid_url(t *testing.T) {
+	for _, pluginURL := range []string{"localhost:8080", "ftp://localhost", "http://", "%"} {
+		_, err := plugin.NewHTTPClient(pluginURL)
+		assert.Error(t, err, pluginURL)
+	}
+}
+
+func Test_HTTPClient_Register(t *testing.T) {
+	testWithHTTPPlugin(t, func(service *fake.MockModuleService, client *plugin.HTTPClient) {
+		m := plugin.Metadata{
+			Name:        "plugin",
+			Description: "description",
+			Capabilities: plugin.Capabilities{
+				SupportsPrinterConfig: []schema.GroupVersionKind{{Version: "v1", Kind: "Pod"}},
+				SupportsTab:           []schema.GroupVersionKind{{Version: "v1", Kind: "Pod"}},
+				IsModule:              true,
+				ActionNames:           []string{"action"},
+			},
+			Settings: []plugin.SettingField{
+				{Name: "url", Label: "URL", Type: plugin.SettingTypeString, Default: "https://example.com"},
+			},
+		}
+		service.EXPECT().Register(gomock.Any(), "address").Return(m, nil)
+
+		got, err := client.Register(context.Background(), "address")
+		require.NoError(t, err)
+		assert.Equal(t, m, got)
+	})
+}
+
+func Test_HTTPClient_Print(t *testing.T) {
+	testWithHTTPPlugin(t, func(service *fake.MockModuleService, client *plugin.HTTPClient) {
+		object := testutil.CreateDeployment("deployment")
+
+		pr := plugin.PrintResponse{
+			Config: []component.SummarySection{{Header: "config1", Content: component.NewText("config1 value")}},
+			Status: []component.SummarySection{{Header: "status1", Content: component.NewText("status1 value")}},
+			Items: component.FlexLayoutSection{
+				{Width: component.WidthFull, View: component.NewText("section 1")},
+			},
+		}
+		service.EXPECT().Print(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, got runtime.Object) (plugin.PrintResponse, error) {
+				assert.Equal(t, object.Name, testutil.ToUnstructured(t, got).GetName())
+				return pr, nil
+			})
+
+		got, err := client.Print(context.Background(), object)
+		require.NoError(t, err)
+		assert.Equal(t, pr, got)
+	})
+}
+
+func Test_HTTPClient_PrintTab(t *testing.T) {
+	testWithHTTPPlugin(t, func(service *fake.MockModuleService, client *plugin.HTTPClient) {
+		layout := component.NewFlexLayout("tab")
+		layout.AddSections(component.FlexLayoutSection{
+			{Width: component.WidthFull, View: component.NewText("content")},
+		})
+
+		tab := &component.Tab{Name: "tab", Contents: *layout}
+		service.EXPECT().PrintTab(gomock.Any(), gomock.Any()).Return(plugin.TabResponse{Tab: tab}, nil)
+
+		got, err := client.PrintTab(context.Background(), testutil.CreatePod("pod"))
+		require.NoError(t, err)
+		testutil.AssertJSONEqual(t, plugin.TabResponse{Tab: tab}, got)
+	})
+}
+
+func Test_HTTPClient_RelatedObjects(t *testing.T) {
+	testWithHTTPPlugin(t, func(service *fake.MockModuleService, client *plugin.HTTPClient) {
+		ror := plugin.RelatedObjectsResponse{
+			Objects: []store.Key{{Namespace: "default", APIVersion: "v1", Kind: "Secret", Name: "secret"}},
+		}
+		service.EXPECT().RelatedObjects(gomock.Any(), gomock.Any()).Return(ror, nil)
+
+		got, err := client.RelatedObjects(context.Background(), testutil.CreatePod("pod"))
+		require.NoError(t, err)
+		assert.Equal(t, ror, got)
+	})
+}
+
+func Test_HTTPClient_HandleAction(t *testing.T) {
+	testWithHTTPPlugin(t, func(service *fake.MockModuleService, client *plugin.HTTPClient) {
+		payload := action.Payload{"foo": "bar"}
+		resp := plugin.ActionResponse{
+			Alerts:   []action.Alert{{Type: action.AlertTypeInfo, Message: "deployed"}},
+			Result:   action.Payload{"revision": "2"},
+			Redirect: "/plugin/deployments",
+		}
+		service.EXPECT().HandleAction(gomock.Any(), payload).Return(resp, nil)
+
+		got, err := client.HandleAction(context.Background(), payload)
+		require.NoError(t, err)
+		assert.Equal(t, resp, got)
+	})
+}
+
+func Test_HTTPClient_UpdateSettings(t *testing.T) {
+	testWithHTTPPlugin(t, func(service *fake.MockModuleService, client *plugin.HTTPClient) {
+		settings := plugin.Settings{"url": "https://jira.example.com"}
+		service.EXPECT().UpdateSettings(gomock.Any(), settings).Return(nil)
+
+		require.NoError(t, client.UpdateSettings(context.Background(), settings))
+	})
+}
+
+func Test_HTTPClient_ListColumns(t *testing.T) {
+	testWithHTTPPlugin(t, func(service *fake.MockModuleService, client *plugin.HTTPClient) {
+		lcr := plugin.ListColumnsResponse{
+			Rows: []component.TableRow{{"Cost": component.NewText("$1")}},
+		}
+		service.EXPECT().ListColumns(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, objects []runtime.Object) (plugin.ListColumnsResponse, error) {
+				require.Len(t, objects, 1)
+				assert.Equal(t, "pod", testutil.ToUnstructured(t, objects[0]).GetName())
+				return lcr, nil
+			})
+
+		got, err := client.ListColumns(context.Background(), []runtime.Object{testutil.CreatePod("pod")})
+		require.NoError(t, err)
+		assert.Equal(t, lcr, got)
+	})
+}
+
+func Test_HTTPClient_module(t *testing.T) {
+	testWithHTTPPlugin(t, func(service *fake.MockModuleService, client *plugin.HTTPClient) {
+		nav := navigation.Navigation{Title: "Plugin", Path: "/plugin"}
+		service.EXPECT().Navigation(gomock.Any()).Return(nav, nil)
+
+		contentResponse := component.ContentResponse{
+			Title:      component.TitleFromString("Plugin"),
+			Components: []component.Component{component.NewText("content")},
+		}
+		service.EXPECT().Content(gomock.Any(), "/nested").Return(contentResponse, nil)
+
+		ctx := context.Background()
+
+		gotNav, err := client.Navigation(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, nav, gotNav)
+
+		gotContent, err := client.Content(ctx, "/nested")
+		require.NoError(t, err)
+		assert.Equal(t, contentResponse, gotContent)
+	})
+}
+
+func Test_HTTPClient_error(t *testing.T) {
+	testWithHTTPPlugin(t, func(service *fake.MockModuleService, client *plugin.HTTPClient) {
+		service.EXPECT().HandleAction(gomock.Any(), gomock.Any()).Return(plugin.ActionResponse{}, errors.New("boom"))
+
+		_, err := client.HandleAction(context.Background(), action.Payload{})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "plugin error (500): boom")
+	})
+}
+
+func Test_HTTPClient_Ping(t *testing.T) {
+	testWithHTTPPlugin(t, func(service *fake.MockModuleService, client *plugin.HTTPClient) {
+		require.NoError(t, client.Ping(context.Background()))
+	})
+
+	client, err := plugin.NewHTTPClient("http://127.0.0.1:1")
+	require.NoError(t, err)
+	require.Error(t, client.Ping(context.Background()))
+}
+
+// Test_HTTPClient_wire_format checks the JSON a plugin written in another
+// language sends is understood.
+func Test_HTTPClient_wire_format(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/register", r.URL.Path)
+		_, _ = w.Write([]byte(`{
+  "name": "python-plugin",
+  "description": "written in python",
+  "capabilities": {
+    "supportsTab": [{"group": "apps", "version": "v1", "kind": "Deployment"}],
+    "actionNames": ["python-plugin/deploy"]
+  },
+  "settings": [{"name": "token", "type": "secret"}]
+}`))
+	}))
+	defer server.Close()
+
+	client, err := plugin.NewHTTPClient(server.URL + "/")
+	require.NoError(t, err)
+
+	got, err := client.Register(context.Background(), "address")
+	require.NoError(t, err)
+
+	expected := plugin.Metadata{
+		Name:        "python-plugin",
+		Description: "written in python",
+		Capabilities: plugin.Capabilities{
+			SupportsTab: []schema.GroupVersionKind{{Group: "apps", Version: "v1", Kind: "Deployment"}},
+			ActionNames: []string{"python-plugin/deploy"},
+		},
+		Settings: []plugin.SettingField{{Name: "token", Type: plugin.SettingTypeSecret}},
+	}
+	assert.Equal(t, expected, got)
+}
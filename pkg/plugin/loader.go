@@ -8,15 +8,14 @@ package plugin
 import (
 	"os"
 	"path/filepath"
-	"runtime"
 	"sort"
 	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/afero"
-)
 
-const configDir = "lissio"
+	"github.com/kubenext/lissio/internal/util/configdir"
+)
 
 // Config is configuration for the plugin manager.
 type Config interface {
@@ -50,11 +49,7 @@ func (c *defaultConfig) PluginDirs() ([]string, error) {
 		return []string{}, errors.Errorf("running dash in a container is not yet supported: No $HOME env var")
 	}
 
-	defaultDir := filepath.Join(home, ".config", configDir, "plugins")
-
-	if runtime.GOOS == "windows" || os.Getenv("XDG_CONFIG_HOME") != "" {
-		defaultDir = filepath.Join(home, configDir, "plugins")
-	}
+	defaultDir := filepath.Join(configdir.Dir(home), "plugins")
 
 	if path := os.Getenv("LISSIO_PLUGIN_PATH"); path != "" {
 		path = strings.Trim(path, string(filepath.ListSeparator))
@@ -66,21 +61,7 @@ func (c *defaultConfig) PluginDirs() ([]string, error) {
 
 func (c *defaultConfig) Home() string {
 	if c.homeFn == nil {
-		c.homeFn = func() string {
-			switch runtime.GOOS {
-			case "windows":
-				return os.Getenv("LOCALAPPDATA")
-
-			case "darwin":
-				return os.Getenv("HOME")
-
-			default: // Unix
-				if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
-					return dir
-				}
-			}
-			return os.Getenv("HOME")
-		}
+		c.homeFn = configdir.Home
 	}
 
 	return c.homeFn()
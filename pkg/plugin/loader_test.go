@@ -47,7 +47,7 @@ func Test_AvailablePlugins(t *testing.T) {
 			envPaths := customPath + ":/another/one"
 			os.Setenv(test.envVar, envPaths)
 
-			configPath := filepath.Join(test.homePath, ".config", configDir, "plugins")
+			configPath := filepath.Join(test.homePath, ".config", "lissio", "plugins")
 
 			err := fs.MkdirAll(configPath, 0700)
 			require.NoError(t, err, "unable to create test home directory")
@@ -85,7 +85,7 @@ func Test_AvailablePlugins(t *testing.T) {
 			xdgPath := "/home/xdg_config_path"
 			os.Setenv(test.envVar, xdgPath)
 
-			configPath := filepath.Join(test.homePath, configDir, "plugins")
+			configPath := filepath.Join(test.homePath, "lissio", "plugins")
 
 			err := fs.MkdirAll(configPath, 0700)
 			require.NoError(t, err, "unable to create test home directory")
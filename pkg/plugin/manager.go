@@ -11,6 +11,7 @@ package plugin
 
 import (
 	"context"
+	"fmt"
 	"os/exec"
 	"path/filepath"
 	"sort"
@@ -19,13 +20,16 @@ import (
 
 	"github.com/hashicorp/go-plugin"
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/kubenext/lissio/internal/log"
 	"github.com/kubenext/lissio/internal/module"
 	"github.com/kubenext/lissio/internal/portforward"
 	"github.com/kubenext/lissio/pkg/action"
 	"github.com/kubenext/lissio/pkg/plugin/api"
+	"github.com/kubenext/lissio/pkg/store"
 	"github.com/kubenext/lissio/pkg/view/component"
 )
 
@@ -76,6 +80,7 @@ type ManagerStore interface {
 	GetCommand(name string) (string, error)
 	Clients() map[string]Client
 	ClientNames() []string
+	Remove(name string)
 }
 
 // DefaultStore is the default implement of ManagerStore.
@@ -83,6 +88,11 @@ type DefaultStore struct {
 	clients  map[string]Client
 	metadata map[string]Metadata
 	commands map[string]string
+
+	// instrument wraps services so calls to them can be observed.
+	instrument func(name string, service Service) Service
+
+	mu sync.RWMutex
 }
 
 var _ ManagerStore = (*DefaultStore)(nil)
@@ -102,6 +112,9 @@ func (s *DefaultStore) Store(name string, client Client, metadata *Metadata, cmd
 		return errors.New("metadata is nil")
 	}
 
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	s.clients[name] = client
 	s.metadata[name] = *metadata
 	s.commands[name] = cmd
@@ -111,7 +124,9 @@ func (s *DefaultStore) Store(name string, client Client, metadata *Metadata, cmd
 
 // GetService gets the service for a plugin.
 func (s *DefaultStore) GetService(name string) (Service, error) {
+	s.mu.RLock()
 	client, ok := s.clients[name]
+	s.mu.RUnlock()
 	if !ok {
 		return nil, errors.Errorf("plugin %q doesn't have a client", name)
 	}
@@ -131,11 +146,18 @@ func (s *DefaultStore) GetService(name string) (Service, error) {
 		return nil, errors.Errorf("unknown type for plugin %q: %T", name, raw)
 	}
 
+	if s.instrument != nil {
+		service = s.instrument(name, service)
+	}
+
 	return service, nil
 }
 
 // GetMetadata gets the metadata for a plugin.
 func (s *DefaultStore) GetMetadata(name string) (*Metadata, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
 	metadata, ok := s.metadata[name]
 	if !ok {
 		return nil, errors.Errorf("plugin %q doesn't have metadata", name)
@@ -146,6 +168,9 @@ func (s *DefaultStore) GetMetadata(name string) (*Metadata, error) {
 
 // GetCommand gets the command for a plugin.
 func (s *DefaultStore) GetCommand(name string) (string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
 	cmd, ok := s.commands[name]
 	if !ok {
 		return "", errors.Errorf("plugin %q doesn't have command", name)
@@ -156,7 +181,25 @@ func (s *DefaultStore) GetCommand(name string) (string, error) {
 
 // Clients returns all the clients in the store.
 func (s *DefaultStore) Clients() map[string]Client {
-	return s.clients
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	clients := make(map[string]Client, len(s.clients))
+	for name, client := range s.clients {
+		clients[name] = client
+	}
+
+	return clients
+}
+
+// Remove removes a plugin from the store.
+func (s *DefaultStore) Remove(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.clients, name)
+	delete(s.metadata, name)
+	delete(s.commands, name)
 }
 
 // ClientNames returns the client names in the store.
@@ -171,6 +214,34 @@ func (s *DefaultStore) ClientNames() []string {
 type config struct {
 	cmd  string
 	name string
+	// url is the address of a plugin reached over HTTP. Plugins with a url
+	// aren't started by the dashboard.
+	url string
+}
+
+// registration is what a running plugin registered with the dashboard.
+type registration struct {
+	module      module.Module
+	actionNames []string
+}
+
+// PluginStatus is the status of a loaded plugin.
+type PluginStatus struct {
+	// Name is the name of the plugin binary.
+	Name string
+	// Cmd is the path to the plugin binary.
+	Cmd string
+	// URL is the address of a plugin reached over HTTP.
+	URL string
+	// State is the state of the plugin.
+	State PluginState
+	// Metadata is the plugin's metadata. It is nil if the plugin isn't running.
+	Metadata *Metadata
+	// Health is the health of the plugin.
+	Health Health
+	// Settings are the plugin's current settings. They are only known
+	// while the plugin is running.
+	Settings Settings
 }
 
 // ManagerInterface is an interface which represent a plugin manager.
@@ -186,23 +257,107 @@ type ManagerInterface interface {
 
 	// ObjectStatus returns the object status
 	ObjectStatus(ctx context.Context, object runtime.Object) (*ObjectStatusResponse, error)
+
+	// ObjectVisitorGVKs returns the GVKs plugins find related objects for.
+	ObjectVisitorGVKs() []schema.GroupVersionKind
+
+	// RelatedObjects returns references to objects plugins consider
+	// related to an object.
+	RelatedObjects(ctx context.Context, object runtime.Object) ([]store.Key, error)
+
+	// ListColumns returns the columns plugins add to a list of objects
+	// and the cells for each of the list's items, in the same order.
+	ListColumns(ctx context.Context, list *unstructured.UnstructuredList) ([]string, []component.TableRow, error)
+
+	// Plugins returns the status of loaded plugins.
+	Plugins() []PluginStatus
+
+	// Enable enables and starts a plugin.
+	Enable(ctx context.Context, name string) error
+
+	// Disable stops a plugin and disables it.
+	Disable(ctx context.Context, name string) error
+
+	// Restart restarts a plugin.
+	Restart(ctx context.Context, name string) error
+
+	// UpdateSettings saves settings for a plugin and sends them to it.
+	UpdateSettings(ctx context.Context, name string, settings Settings) error
 }
 
 // ModuleRegistrar is a module registrar.
 type ModuleRegistrar interface {
 	// Register registers a module.
 	Register(mod module.Module) error
+	// Unregister unregisters a module.
+	Unregister(mod module.Module)
+}
+
+// pluginActionFunc creates a dispatcher function for a plugin action. Alerts
+// from the plugin are sent to the alerter. Results and redirects are sent if
+// the alerter is an action.Responder.
+func pluginActionFunc(actionName string, service Service) action.DispatcherFunc {
+	return func(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+		resp, err := service.HandleAction(ctx, payload)
+		if err != nil {
+			return err
+		}
+
+		for _, alert := range resp.Alerts {
+			alerter.SendAlert(alert)
+		}
+
+		responder, ok := alerter.(action.Responder)
+		if !ok {
+			return nil
+		}
+
+		if resp.Result != nil {
+			responder.SendActionResult(actionName, resp.Result)
+		}
+
+		if resp.Redirect != "" {
+			responder.SetContentPath(resp.Redirect)
+		}
+
+		return nil
+	}
 }
 
 // ActionRegistrar is an action registrar.
 type ActionRegistrar interface {
 	// Register registers an action.
 	Register(actionPath string, actionFunc action.DispatcherFunc) error
+	// Unregister unregisters an action.
+	Unregister(actionPath string)
 }
 
+const (
+	// defaultRPCTimeout is how long plugins have to respond while content
+	// is generated.
+	defaultRPCTimeout = 5 * time.Second
+)
+
 // ManagerOption is an option for configuring Manager.
 type ManagerOption func(*Manager)
 
+// WatchPluginDirs configures the manager to watch plugin directories. New
+// or changed plugin binaries are (re)started and plugins whose binaries
+// are removed are unloaded.
+func WatchPluginDirs(config Config) ManagerOption {
+	return func(m *Manager) {
+		m.PluginConfig = config
+	}
+}
+
+// WithSettingsStore configures where the manager persists plugin settings.
+// Settings are kept in memory if it isn't used.
+func WithSettingsStore(settingsStore SettingsStore) ManagerOption {
+	return func(m *Manager) {
+		m.settingsStore = settingsStore
+	}
+}
+
 // Manager manages plugins
 type Manager struct {
 	PortForwarder   portforward.PortForwarder
@@ -211,11 +366,27 @@ type Manager struct {
 	ModuleRegistrar ModuleRegistrar
 	ActionRegistrar ActionRegistrar
 
+	// PluginConfig is the configuration for the plugin directories. The
+	// directories are watched if it is set.
+	PluginConfig Config
+
+	// RPCTimeout is how long each plugin has to respond when it is called
+	// while content is generated.
+	RPCTimeout time.Duration
+
 	Runners Runners
 
 	configs []config
 	store   ManagerStore
 
+	disabled      map[string]bool
+	registrations map[string]registration
+
+	healths    map[string]*pluginHealth
+	healthLock sync.Mutex
+
+	settingsStore SettingsStore
+
 	lock sync.Mutex
 }
 
@@ -223,15 +394,24 @@ var _ ManagerInterface = (*Manager)(nil)
 
 // NewManager creates an instance of Manager.
 func NewManager(apiService api.API, moduleRegistrar ModuleRegistrar, actionRegistrar ActionRegistrar, options ...ManagerOption) *Manager {
+	store := NewDefaultStore()
+
 	m := &Manager{
-		store:           NewDefaultStore(),
+		store:           store,
 		ClientFactory:   NewDefaultClientFactory(),
 		Runners:         newDefaultRunners(),
+		RPCTimeout:      defaultRPCTimeout,
 		API:             apiService,
 		ModuleRegistrar: moduleRegistrar,
 		ActionRegistrar: actionRegistrar,
+		disabled:        make(map[string]bool),
+		registrations:   make(map[string]registration),
+		healths:         make(map[string]*pluginHealth),
+		settingsStore:   newMemorySettingsStore(),
 	}
 
+	store.instrument = m.instrument
+
 	for _, option := range options {
 		option(m)
 	}
@@ -254,17 +434,37 @@ func (m *Manager) Load(cmd string) error {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
-	name := filepath.Base(cmd)
+	return m.load(cmd)
+}
 
-	for _, c := range m.configs {
-		if name == c.name {
-			return errors.Errorf("tried to load plugin %q more than once", name)
-		}
+// LoadURL loads a plugin which runs on its own and is reached over HTTP at
+// a URL. The plugin serves the plugin service as JSON (see HTTPServer).
+func (m *Manager) LoadURL(name, pluginURL string) error {
+	if _, err := NewHTTPClient(pluginURL); err != nil {
+		return err
 	}
 
-	c := config{
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return m.add(config{
 		name: name,
+		url:  pluginURL,
+	})
+}
+
+func (m *Manager) load(cmd string) error {
+	return m.add(config{
+		name: filepath.Base(cmd),
 		cmd:  cmd,
+	})
+}
+
+func (m *Manager) add(c config) error {
+	for _, existing := range m.configs {
+		if c.name == existing.name {
+			return errors.Errorf("tried to load plugin %q more than once", c.name)
+		}
 	}
 
 	m.configs = append(m.configs, c)
@@ -289,22 +489,299 @@ func (m *Manager) Start(ctx context.Context) error {
 	logger := log.From(ctx)
 	logger.With("addr", m.API.Addr()).Debugf("starting plugin api service")
 
+	m.lock.Lock()
+	var configs []config
+	for _, c := range m.configs {
+		if !m.disabled[c.name] {
+			configs = append(configs, c)
+		}
+	}
+	m.lock.Unlock()
+
+	for _, c := range configs {
+		if err := m.startUnlocked(ctx, c); err != nil {
+			logger.WithErr(err).With("plugin-name", c.name).Errorf("start plugin")
+			m.lock.Lock()
+			m.crashed(ctx, c.name, err, time.Now())
+			m.lock.Unlock()
+		}
+	}
+
+	go m.watchPlugins(ctx)
+
+	if m.PluginConfig != nil {
+		files, err := scanPluginDirs(m.PluginConfig)
+		if err != nil {
+			return errors.Wrap(err, "scan plugin directories")
+		}
+
+		go m.watchPluginDirs(ctx, files)
+	}
+
+	return nil
+}
+
+// Plugins returns the status of loaded plugins.
+func (m *Manager) Plugins() []PluginStatus {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
-	for i := range m.configs {
-		c := m.configs[i]
+	var list []PluginStatus
 
-		if err := m.start(ctx, c); err != nil {
-			return err
+	for _, c := range m.configs {
+		h := m.health(c.name)
+
+		status := PluginStatus{
+			Name:   c.name,
+			Cmd:    c.cmd,
+			URL:    c.url,
+			State:  PluginStateStopped,
+			Health: h.health(),
 		}
+
+		metadata, err := m.store.GetMetadata(c.name)
+		if err == nil {
+			status.Metadata = metadata
+			if len(metadata.Settings) > 0 {
+				status.Settings = ResolveSettings(metadata.Settings, m.loadSettings(c.name))
+			}
+		}
+
+		switch {
+		case m.disabled[c.name]:
+			status.State = PluginStateDisabled
+		case h.isCrashing():
+			status.State = PluginStateCrashing
+		case err == nil:
+			status.State = PluginStateRunning
+		}
+
+		list = append(list, status)
 	}
 
-	go m.watchPlugins(ctx)
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+
+	return list
+}
+
+// Enable enables and starts a plugin. The plugin is started without
+// holding the lock.
+func (m *Manager) Enable(ctx context.Context, name string) error {
+	m.lock.Lock()
+
+	c, ok := m.config(name)
+	if !ok {
+		m.lock.Unlock()
+		return errors.Errorf("plugin %q is not loaded", name)
+	}
+
+	if !m.disabled[name] {
+		m.lock.Unlock()
+		return nil
+	}
+
+	delete(m.disabled, name)
+	m.health(name).reset()
+	m.lock.Unlock()
+
+	return m.startUnlocked(ctx, c)
+}
+
+// Disable stops a plugin and disables it. A disabled plugin isn't
+// restarted until it is enabled.
+func (m *Manager) Disable(ctx context.Context, name string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.config(name); !ok {
+		return errors.Errorf("plugin %q is not loaded", name)
+	}
+
+	m.disabled[name] = true
+	m.health(name).reset()
+	m.stop(ctx, name)
 
 	return nil
 }
 
+// UpdateSettings saves settings for a plugin and sends them to it. Settings
+// which aren't included keep their current values.
+func (m *Manager) UpdateSettings(ctx context.Context, name string, settings Settings) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.config(name); !ok {
+		return errors.Errorf("plugin %q is not loaded", name)
+	}
+
+	metadata, err := m.store.GetMetadata(name)
+	if err != nil {
+		return errors.Errorf("plugin %q is not running", name)
+	}
+
+	if err := ValidateSettings(metadata.Settings, settings); err != nil {
+		return err
+	}
+
+	values := m.loadSettings(name)
+	for key, value := range settings {
+		values[key] = value
+	}
+
+	if err := m.settingsStore.Save(name, values); err != nil {
+		return errors.Wrapf(err, "save settings for plugin %q", name)
+	}
+
+	service, err := m.store.GetService(name)
+	if err != nil {
+		return err
+	}
+
+	if err := service.UpdateSettings(ctx, ResolveSettings(metadata.Settings, values)); err != nil {
+		return errors.Wrapf(err, "send settings to plugin %q", name)
+	}
+
+	return nil
+}
+
+// loadSettings loads the saved settings for a plugin. Plugins whose settings
+// can't be loaded use their defaults.
+func (m *Manager) loadSettings(name string) Settings {
+	settings, err := m.settingsStore.Load(name)
+	if err != nil || settings == nil {
+		return Settings{}
+	}
+
+	return settings
+}
+
+// Restart restarts a plugin. The plugin is stopped under the lock and
+// started without holding it.
+func (m *Manager) Restart(ctx context.Context, name string) error {
+	m.lock.Lock()
+
+	c, ok := m.config(name)
+	if !ok {
+		m.lock.Unlock()
+		return errors.Errorf("plugin %q is not loaded", name)
+	}
+
+	if m.disabled[name] {
+		m.lock.Unlock()
+		return errors.Errorf("plugin %q is disabled", name)
+	}
+
+	m.stop(ctx, name)
+	m.health(name).reset()
+	m.lock.Unlock()
+
+	return m.startUnlocked(ctx, c)
+}
+
+// unload stops a plugin and forgets about it.
+func (m *Manager) unload(ctx context.Context, name string) {
+	m.stop(ctx, name)
+
+	var configs []config
+	for _, c := range m.configs {
+		if c.name != name {
+			configs = append(configs, c)
+		}
+	}
+
+	m.configs = configs
+	delete(m.disabled, name)
+
+	m.healthLock.Lock()
+	delete(m.healths, name)
+	m.healthLock.Unlock()
+}
+
+func (m *Manager) config(name string) (config, bool) {
+	for _, c := range m.configs {
+		if c.name == name {
+			return c, true
+		}
+	}
+
+	return config{}, false
+}
+
+// stop unregisters a plugin's module and actions and kills its process.
+func (m *Manager) stop(ctx context.Context, name string) {
+	m.unregister(ctx, name)
+
+	client, ok := m.store.Clients()[name]
+	if !ok {
+		return
+	}
+
+	log.From(ctx).With("plugin-name", name).Debugf("stopping plugin")
+
+	m.store.Remove(name)
+	client.Kill()
+}
+
+// unregister unregisters the module and actions a plugin registered.
+func (m *Manager) unregister(ctx context.Context, name string) {
+	r, ok := m.registrations[name]
+	if !ok {
+		return
+	}
+
+	pluginLogger := log.From(ctx).With("plugin-name", name)
+
+	for _, actionName := range r.actionNames {
+		pluginLogger.With("action-path", actionName).Infof("unregistering plugin action")
+		m.ActionRegistrar.Unregister(actionName)
+	}
+
+	if r.module != nil {
+		m.ModuleRegistrar.Unregister(r.module)
+	}
+
+	delete(m.registrations, name)
+}
+
+// health returns the health tracker for a plugin.
+func (m *Manager) health(name string) *pluginHealth {
+	m.healthLock.Lock()
+	defer m.healthLock.Unlock()
+
+	h, ok := m.healths[name]
+	if !ok {
+		h = newPluginHealth()
+		m.healths[name] = h
+	}
+
+	return h
+}
+
+// instrument wraps a plugin's service so its RPCs are recorded in the
+// plugin's health.
+func (m *Manager) instrument(name string, service Service) Service {
+	return &instrumentedService{
+		Service:  service,
+		observer: m.health(name).observe,
+	}
+}
+
+// crashed records a plugin crash. The plugin is stopped so it isn't called
+// while it waits to be restarted.
+func (m *Manager) crashed(ctx context.Context, name string, err error, now time.Time) {
+	h := m.health(name)
+	h.crashed(now, err)
+
+	health := h.health()
+	log.From(ctx).
+		With("plugin-name", name, "crashes", health.Crashes, "next-restart", health.NextRestart).
+		Warnf("plugin crashed")
+
+	m.stop(ctx, name)
+}
+
 func (m *Manager) watchPlugins(ctx context.Context) {
 	logger := log.From(ctx)
 
@@ -318,89 +795,196 @@ func (m *Manager) watchPlugins(ctx context.Context) {
 			running = false
 			break
 		case <-timer.C:
-			for clientName, client := range m.store.Clients() {
-				rpcClient, err := client.Client()
-				if err != nil {
-					logger.WithErr(err).Errorf("retrieve plugin client for ping")
-				}
+			m.checkPlugins(ctx, time.Now())
 
-				if err := rpcClient.Ping(); err != nil {
-					logger.With("plugin-name", clientName).Infof("restarting plugin")
+			timer.Reset(5 * time.Second)
+		}
+	}
 
-					cmd, err := m.store.GetCommand(clientName)
-					if err != nil {
-						logger.WithErr(err).Errorf("unable to find command for plugin")
-						continue
-					}
+}
 
-					c := config{
-						name: clientName,
-						cmd:  cmd,
-					}
+// checkPlugins pings running plugins and restarts crashed plugins whose
+// backoff has passed. Plugins are pinged and started without holding the
+// lock so a slow plugin doesn't block the manager.
+func (m *Manager) checkPlugins(ctx context.Context, now time.Time) {
+	m.lock.Lock()
+	clients := m.store.Clients()
+	m.lock.Unlock()
 
-					if err := m.start(ctx, c); err != nil {
-						logger.WithErr(err).Errorf("unable to restart plugin")
-						continue
-					}
-				}
+	for clientName, client := range clients {
+		rpcClient, err := client.Client()
+		if err == nil {
+			err = rpcClient.Ping()
+		}
+
+		if err != nil {
+			m.lock.Lock()
+			// The plugin may have been restarted or unloaded while it
+			// was pinged.
+			if m.store.Clients()[clientName] == client {
+				m.crashed(ctx, clientName, err, now)
 			}
+			m.lock.Unlock()
+			continue
+		}
 
-			timer.Reset(5 * time.Second)
+		m.health(clientName).healthy(now)
+	}
+
+	m.lock.Lock()
+	var due []config
+	for _, c := range m.configs {
+		if !m.disabled[c.name] && m.health(c.name).restartDue(now) {
+			due = append(due, c)
+		}
+	}
+	m.lock.Unlock()
+
+	for _, c := range due {
+		log.From(ctx).With("plugin-name", c.name).Infof("restarting plugin")
+		if err := m.startUnlocked(ctx, c); err != nil {
+			m.lock.Lock()
+			m.crashed(ctx, c.name, err, now)
+			m.lock.Unlock()
 		}
 	}
+}
 
+// launchedPlugin is a plugin whose process has started and registered but
+// which the manager doesn't track yet.
+type launchedPlugin struct {
+	client   Client
+	raw      interface{}
+	service  Service
+	metadata Metadata
 }
 
-func (m *Manager) start(ctx context.Context, c config) error {
-	client := m.ClientFactory.Init(ctx, c.cmd)
+// startUnlocked starts a plugin without holding the lock while its process
+// starts and registers. The plugin is only kept if it is still loaded and
+// enabled once it has started. The caller must not hold the lock.
+func (m *Manager) startUnlocked(ctx context.Context, c config) error {
+	p, err := m.launch(ctx, c)
+	if err != nil {
+		return err
+	}
 
-	rpcClient, err := client.Client()
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if current, ok := m.config(c.name); !ok || current != c || m.disabled[c.name] {
+		p.client.Kill()
+		return nil
+	}
+
+	// The plugin may have been started again while this instance was
+	// launched.
+	m.stop(ctx, c.name)
+
+	return m.commit(ctx, c, p)
+}
+
+// launch starts a plugin's process, registers it with the API and sends
+// its settings. It doesn't change the manager's state, so it can be called
+// without holding the lock.
+func (m *Manager) launch(ctx context.Context, c config) (*launchedPlugin, error) {
+	h := m.health(c.name)
+
+	client, err := m.client(ctx, c, h)
 	if err != nil {
-		return errors.Wrapf(err, "get rpc client for %q", c.name)
+		return nil, err
 	}
 
-	pluginLogger := log.From(ctx).With("plugin-name", c.name)
+	// A plugin which fails before it is launched is killed since nothing
+	// else tracks its process.
+	launched := false
+	defer func() {
+		if !launched {
+			client.Kill()
+		}
+	}()
+
+	rpcClient, err := client.Client()
+	if err != nil {
+		return nil, errors.Wrapf(err, "get rpc client for %q", c.name)
+	}
 
 	raw, err := rpcClient.Dispense("plugin")
 	if err != nil {
-		return errors.Wrapf(err, "dispensing plugin for %q", c.name)
+		return nil, errors.Wrapf(err, "dispensing plugin for %q", c.name)
 	}
 
 	service, ok := raw.(Service)
 	if !ok {
-		return errors.Errorf("unknown type for plugin %q: %T", c.name, raw)
+		return nil, errors.Errorf("unknown type for plugin %q: %T", c.name, raw)
 	}
+	service = m.instrument(c.name, service)
 
 	metadata, err := service.Register(ctx, m.API.Addr())
 	if err != nil {
-		return errors.Wrapf(err, "register plugin %q", c.name)
+		return nil, errors.Wrapf(err, "register plugin %q", c.name)
+	}
+
+	if len(metadata.Settings) > 0 {
+		settings := ResolveSettings(metadata.Settings, m.loadSettings(c.name))
+		if err := service.UpdateSettings(ctx, settings); err != nil {
+			return nil, errors.Wrapf(err, "send settings to plugin %q", c.name)
+		}
 	}
 
-	if err := m.store.Store(c.name, client, &metadata, c.cmd); err != nil {
+	launched = true
+
+	return &launchedPlugin{
+		client:   client,
+		raw:      raw,
+		service:  service,
+		metadata: metadata,
+	}, nil
+}
+
+// commit tracks a launched plugin and registers its module and actions.
+// The caller must hold the lock.
+func (m *Manager) commit(ctx context.Context, c config, p *launchedPlugin) error {
+	// A plugin which is restarted registers its module and actions again.
+	m.unregister(ctx, c.name)
+
+	if err := m.store.Store(c.name, p.client, &p.metadata, c.cmd); err != nil {
+		p.client.Kill()
 		return errors.Wrapf(err, "storing plugin")
 	}
 
+	pluginLogger := log.From(ctx).With("plugin-name", c.name)
+	metadata := p.metadata
+
+	r := registration{}
+	defer func() {
+		m.registrations[c.name] = r
+	}()
+
 	for _, actionName := range metadata.Capabilities.ActionNames {
 		pluginLogger.With("action-path", actionName).Infof("registering plugin action")
-		err := m.ActionRegistrar.Register(actionName, func(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
-			return service.HandleAction(ctx, payload)
-		})
+		err := m.ActionRegistrar.Register(actionName, pluginActionFunc(actionName, p.service))
 
 		if err != nil {
 			return errors.Wrap(err, "configuring plugin action")
 		}
+
+		r.actionNames = append(r.actionNames, actionName)
 	}
 
 	pluginLogger.With(
 		"cmd", c.cmd,
+		"url", c.url,
 		"metadata", metadata,
 	).Infof("registered plugin %q", metadata.Name)
 
+	h := m.health(c.name)
+
 	if metadata.Capabilities.IsModule {
-		service, ok := raw.(ModuleService)
+		moduleService, ok := p.raw.(ModuleService)
 		if !ok {
-			return errors.Errorf("plugin type %T is a not a module", raw)
+			return errors.Errorf("plugin type %T is a not a module", p.raw)
 		}
+		service := instrumentModuleService(moduleService, h.observe)
 
 		pluginLogger.Infof("plugin supports navigation")
 
@@ -412,11 +996,26 @@ func (m *Manager) start(ctx context.Context, c config) error {
 		if err := m.ModuleRegistrar.Register(mp); err != nil {
 			return errors.Wrapf(err, "register module %s", metadata.Name)
 		}
+
+		r.module = mp
 	}
 
+	h.started(time.Now())
+
 	return nil
 }
 
+// client creates the client for a plugin. Plugin binaries are started and
+// their logs are captured so they can be shown with their health.
+func (m *Manager) client(ctx context.Context, c config, h *pluginHealth) (Client, error) {
+	if c.url != "" {
+		return newHTTPPluginClient(c.url)
+	}
+
+	clientLogger := newPluginLogger(log.From(ctx).With("plugin-name", c.name), h.logs)
+	return m.ClientFactory.Init(log.WithLoggerContext(ctx, clientLogger), c.cmd), nil
+}
+
 // Stop stops all plugins.
 func (m *Manager) Stop(ctx context.Context) {
 	logger := log.From(ctx)
@@ -438,6 +1037,7 @@ func (m *Manager) Print(ctx context.Context, object runtime.Object) (*PrintRespo
 	}
 
 	runner, ch := m.Runners.Print(m.store)
+	pluginErrs := m.isolate(ctx, "Print", &runner)
 	done := make(chan bool)
 
 	var pr PrintResponse
@@ -452,12 +1052,20 @@ func (m *Manager) Print(ctx context.Context, object runtime.Object) (*PrintRespo
 		done <- true
 	}()
 
-	if err := runner.Run(ctx, object, m.store.ClientNames()); err != nil {
+	if err := runner.Run(ctx, object, m.runnablePlugins(ctx)); err != nil {
 		return nil, err
 	}
 	close(ch)
 
 	<-done
+
+	pluginErrs.each(func(name string, err error) {
+		pr.Items = append(pr.Items, component.FlexLayoutItem{
+			Width: component.WidthFull,
+			View:  pluginErrorComponent(name, err),
+		})
+	})
+
 	return &pr, nil
 }
 
@@ -468,6 +1076,7 @@ func (m *Manager) Tabs(ctx context.Context, object runtime.Object) ([]component.
 	}
 
 	runner, ch := m.Runners.Tab(m.store)
+	pluginErrs := m.isolate(ctx, "PrintTab", &runner)
 	done := make(chan bool)
 
 	var tabs []component.Tab
@@ -480,13 +1089,22 @@ func (m *Manager) Tabs(ctx context.Context, object runtime.Object) ([]component.
 		done <- true
 	}()
 
-	if err := runner.Run(ctx, object, m.store.ClientNames()); err != nil {
+	if err := runner.Run(ctx, object, m.runnablePlugins(ctx)); err != nil {
 		return nil, err
 	}
 
 	close(ch)
 	<-done
 
+	pluginErrs.each(func(name string, err error) {
+		layout := component.NewFlexLayout(name)
+		layout.AddSections(component.FlexLayoutSection{
+			{Width: component.WidthFull, View: pluginErrorComponent(name, err)},
+		})
+
+		tabs = append(tabs, component.Tab{Name: name, Contents: *layout})
+	})
+
 	sort.Slice(tabs, func(i, j int) bool {
 		return tabs[i].Name < tabs[j].Name
 	})
@@ -501,6 +1119,7 @@ func (m *Manager) ObjectStatus(ctx context.Context, object runtime.Object) (*Obj
 	}
 
 	runner, ch := m.Runners.ObjectStatus(m.store)
+	pluginErrs := m.isolate(ctx, "ObjectStatus", &runner)
 	done := make(chan bool)
 
 	var osr ObjectStatusResponse
@@ -513,11 +1132,216 @@ func (m *Manager) ObjectStatus(ctx context.Context, object runtime.Object) (*Obj
 		done <- true
 	}()
 
-	if err := runner.Run(ctx, object, m.store.ClientNames()); err != nil {
+	if err := runner.Run(ctx, object, m.runnablePlugins(ctx)); err != nil {
 		return nil, err
 	}
 	close(ch)
 
 	<-done
+
+	pluginErrs.each(func(name string, err error) {
+		osr.ObjectStatus.Details = append(osr.ObjectStatus.Details, pluginErrorComponent(name, err))
+	})
+
 	return &osr, nil
 }
+
+// ObjectVisitorGVKs returns the GVKs plugins find related objects for.
+func (m *Manager) ObjectVisitorGVKs() []schema.GroupVersionKind {
+	seen := make(map[schema.GroupVersionKind]bool)
+	var list []schema.GroupVersionKind
+
+	for _, name := range m.store.ClientNames() {
+		metadata, err := m.store.GetMetadata(name)
+		if err != nil {
+			continue
+		}
+
+		for _, gvk := range metadata.Capabilities.SupportsObjectVisitor {
+			if seen[gvk] {
+				continue
+			}
+			seen[gvk] = true
+			list = append(list, gvk)
+		}
+	}
+
+	return list
+}
+
+// RelatedObjects returns references to objects plugins consider related
+// to an object.
+func (m *Manager) RelatedObjects(ctx context.Context, object runtime.Object) ([]store.Key, error) {
+	if m.Runners == nil {
+		return nil, errors.New("runners is nil")
+	}
+
+	runner, ch := m.Runners.RelatedObjects(m.store)
+	m.isolate(ctx, "RelatedObjects", &runner)
+	done := make(chan bool)
+
+	var keys []store.Key
+
+	go func() {
+		for resp := range ch {
+			keys = append(keys, resp.Objects...)
+		}
+
+		done <- true
+	}()
+
+	if err := runner.Run(ctx, object, m.runnablePlugins(ctx)); err != nil {
+		return nil, err
+	}
+	close(ch)
+
+	<-done
+	return keys, nil
+}
+
+// ListColumns returns the columns plugins add to a list of objects and the
+// cells for each of the list's items, in the same order. Columns are ordered
+// by plugin name. Plugins which fail leave their cells empty.
+func (m *Manager) ListColumns(ctx context.Context, list *unstructured.UnstructuredList) ([]string, []component.TableRow, error) {
+	if m.Runners == nil {
+		return nil, nil, errors.New("runners is nil")
+	}
+
+	if list == nil || len(list.Items) == 0 {
+		return nil, nil, nil
+	}
+
+	names := m.runnablePlugins(ctx)
+	sort.Strings(names)
+
+	gvk := list.Items[0].GroupVersionKind()
+	seen := make(map[string]bool)
+	var columns []string
+
+	for _, name := range names {
+		metadata, err := m.store.GetMetadata(name)
+		if err != nil {
+			continue
+		}
+
+		for _, column := range metadata.Capabilities.ListColumnsFor(gvk) {
+			if seen[column] {
+				continue
+			}
+			seen[column] = true
+			columns = append(columns, column)
+		}
+	}
+
+	if len(columns) == 0 {
+		return nil, nil, nil
+	}
+
+	runner, ch := m.Runners.ListColumns(m.store)
+	m.isolate(ctx, "ListColumns", &runner)
+	done := make(chan bool)
+
+	rows := make([]component.TableRow, len(list.Items))
+	for i := range rows {
+		rows[i] = component.TableRow{}
+	}
+
+	go func() {
+		for resp := range ch {
+			for i, row := range resp.Rows {
+				if i >= len(rows) {
+					break
+				}
+
+				for column, cell := range row {
+					rows[i][column] = cell
+				}
+			}
+		}
+
+		done <- true
+	}()
+
+	if err := runner.Run(ctx, list, names); err != nil {
+		return nil, nil, err
+	}
+	close(ch)
+
+	<-done
+	return columns, rows, nil
+}
+
+// runnablePlugins returns the names of the plugins runners should call.
+// Plugins whose RPCs failed repeatedly are skipped for a while.
+func (m *Manager) runnablePlugins(ctx context.Context) []string {
+	var list []string
+
+	for _, name := range m.store.ClientNames() {
+		if m.health(name).skipped() {
+			log.From(ctx).With("plugin-name", name).Debugf("skipping failing plugin")
+			continue
+		}
+
+		list = append(list, name)
+	}
+
+	return list
+}
+
+// isolate configures a runner so a slow or failing plugin doesn't fail the
+// run. Each plugin has a deadline and plugin errors are logged with the RPC
+// and collected so callers can show them in place of the plugin's content.
+func (m *Manager) isolate(ctx context.Context, rpc string, runner *DefaultRunner) *pluginErrors {
+	pluginErrs := &pluginErrors{}
+
+	runner.Timeout = m.RPCTimeout
+	runner.ErrorFunc = func(name string, err error) {
+		log.From(ctx).WithErr(err).With("plugin-name", name, "rpc", rpc).Warnf("plugin failed")
+		pluginErrs.add(name, err)
+	}
+
+	return pluginErrs
+}
+
+// pluginErrors are the errors returned for plugins while a runner runs.
+// It is safe for concurrent use.
+type pluginErrors struct {
+	errs map[string]error
+	mu   sync.Mutex
+}
+
+func (e *pluginErrors) add(name string, err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.errs == nil {
+		e.errs = make(map[string]error)
+	}
+
+	e.errs[name] = err
+}
+
+// each calls fn for each error in plugin name order.
+func (e *pluginErrors) each(fn func(name string, err error)) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	var names []string
+	for name := range e.errs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		fn(name, e.errs[name])
+	}
+}
+
+// pluginErrorComponent is shown in place of content a plugin failed to
+// generate.
+func pluginErrorComponent(name string, err error) *component.Error {
+	title := component.TitleFromString(fmt.Sprintf("Plugin %s", name))
+
+	// The error is flattened to its message so stack traces aren't shown.
+	return component.NewError(title, fmt.Errorf("%s", err))
+}
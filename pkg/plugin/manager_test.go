@@ -7,15 +7,20 @@ package plugin_test
 
 import (
 	"context"
+	"fmt"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/hashicorp/go-plugin"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	"github.com/kubenext/lissio/internal/gvk"
 	"github.com/kubenext/lissio/internal/testutil"
 	dashPlugin "github.com/kubenext/lissio/pkg/plugin"
 	"github.com/kubenext/lissio/pkg/plugin/api"
@@ -69,6 +74,9 @@ func TestManager(t *testing.T) {
 		Name: name,
 	}
 	store.EXPECT().Store(gomock.Eq(name), gomock.Eq(client), gomock.Eq(metadata), name)
+	// The plugin isn't running when it is started, and is running when the
+	// manager stops.
+	store.EXPECT().Clients().Return(map[string]dashPlugin.Client{})
 	store.EXPECT().Clients().Return(map[string]dashPlugin.Client{name: client})
 
 	options = append(options, func(m *dashPlugin.Manager) {
@@ -147,6 +155,93 @@ func TestManager_Print(t *testing.T) {
 	assert.Equal(t, expected, got)
 }
 
+func TestManager_Print_plugin_error(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	pod := testutil.CreatePod("pod")
+
+	store := fake.NewMockManagerStore(controller)
+	moduleRegistrar := fake.NewMockModuleRegistrar(controller)
+	actionRegistrar := fake.NewMockActionRegistrar(controller)
+
+	store.EXPECT().ClientNames().Return([]string{"plugin1", "plugin2"})
+
+	ch := make(chan dashPlugin.PrintResponse)
+	printRunner := dashPlugin.DefaultRunner{
+		RunFunc: func(ctx context.Context, name string, gvk schema.GroupVersionKind, object runtime.Object) error {
+			if name == "plugin1" {
+				return errors.New("plugin failed")
+			}
+
+			ch <- dashPlugin.PrintResponse{
+				Config: []component.SummarySection{{Header: "resp"}},
+			}
+			return nil
+		},
+	}
+
+	runners := fake.NewMockRunners(controller)
+	runners.EXPECT().
+		Print(gomock.Eq(store)).Return(printRunner, ch)
+
+	manager := dashPlugin.NewManager(&stubAPIService{}, moduleRegistrar, actionRegistrar, func(m *dashPlugin.Manager) {
+		m.Runners = runners
+	})
+	manager.SetStore(store)
+
+	got, err := manager.Print(context.Background(), pod)
+	require.NoError(t, err)
+
+	expected := &dashPlugin.PrintResponse{
+		Config: []component.SummarySection{{Header: "resp"}},
+		Items: []component.FlexLayoutItem{
+			{
+				Width: component.WidthFull,
+				View:  component.NewError(component.TitleFromString("Plugin plugin1"), fmt.Errorf("plugin failed")),
+			},
+		},
+	}
+	assert.Equal(t, expected, got)
+}
+
+func TestManager_ObjectStatus_plugin_error(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	pod := testutil.CreatePod("pod")
+
+	store := fake.NewMockManagerStore(controller)
+	moduleRegistrar := fake.NewMockModuleRegistrar(controller)
+	actionRegistrar := fake.NewMockActionRegistrar(controller)
+
+	store.EXPECT().ClientNames().Return([]string{"plugin1"})
+
+	ch := make(chan dashPlugin.ObjectStatusResponse)
+	objectStatusRunner := dashPlugin.DefaultRunner{
+		RunFunc: func(ctx context.Context, name string, gvk schema.GroupVersionKind, object runtime.Object) error {
+			return errors.New("plugin failed")
+		},
+	}
+
+	runners := fake.NewMockRunners(controller)
+	runners.EXPECT().
+		ObjectStatus(gomock.Eq(store)).Return(objectStatusRunner, ch)
+
+	manager := dashPlugin.NewManager(&stubAPIService{}, moduleRegistrar, actionRegistrar, func(m *dashPlugin.Manager) {
+		m.Runners = runners
+	})
+	manager.SetStore(store)
+
+	got, err := manager.ObjectStatus(context.Background(), pod)
+	require.NoError(t, err)
+
+	expected := []component.Component{
+		component.NewError(component.TitleFromString("Plugin plugin1"), fmt.Errorf("plugin failed")),
+	}
+	assert.Equal(t, expected, got.ObjectStatus.Details)
+}
+
 func TestManager_Tabs(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
@@ -239,3 +334,242 @@ func (f *stubAPIService) Addr() string {
 func (f *stubAPIService) Start(context.Context) error {
 	return nil
 }
+
+func TestManager_Disable_Enable_Restart(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	clientFactory := fake.NewMockClientFactory(controller)
+	moduleRegistrar := fake.NewMockModuleRegistrar(controller)
+	actionRegistrar := fake.NewMockActionRegistrar(controller)
+
+	name := "plugin1"
+
+	client := newFakePluginClient(name, controller)
+	clientFactory.EXPECT().Init(gomock.Any(), gomock.Eq(name)).Return(client).Times(3)
+
+	manager := dashPlugin.NewManager(&stubAPIService{}, moduleRegistrar, actionRegistrar, func(m *dashPlugin.Manager) {
+		m.ClientFactory = clientFactory
+	})
+
+	require.NoError(t, manager.Load(name))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, manager.Start(ctx))
+
+	assertState := func(state dashPlugin.PluginState, running bool) {
+		plugins := manager.Plugins()
+		require.Len(t, plugins, 1)
+		assert.Equal(t, name, plugins[0].Name)
+		assert.Equal(t, state, plugins[0].State)
+		if running {
+			assert.Equal(t, &dashPlugin.Metadata{Name: name}, plugins[0].Metadata)
+		} else {
+			assert.Nil(t, plugins[0].Metadata)
+		}
+	}
+
+	assertState(dashPlugin.PluginStateRunning, true)
+
+	require.NoError(t, manager.Disable(ctx, name))
+	assertState(dashPlugin.PluginStateDisabled, false)
+	assert.Error(t, manager.Restart(ctx, name))
+
+	require.NoError(t, manager.Enable(ctx, name))
+	assertState(dashPlugin.PluginStateRunning, true)
+
+	require.NoError(t, manager.Restart(ctx, name))
+	assertState(dashPlugin.PluginStateRunning, true)
+
+	assert.Error(t, manager.Enable(ctx, "invalid"))
+	assert.Error(t, manager.Disable(ctx, "invalid"))
+
+	manager.Stop(ctx)
+}
+
+func TestManager_Restart_does_not_block(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	clientFactory := fake.NewMockClientFactory(controller)
+	moduleRegistrar := fake.NewMockModuleRegistrar(controller)
+	actionRegistrar := fake.NewMockActionRegistrar(controller)
+
+	name := "plugin1"
+	metadata := dashPlugin.Metadata{Name: name}
+
+	registering := make(chan struct{})
+	release := make(chan struct{})
+
+	service := fake.NewMockService(controller)
+	gomock.InOrder(
+		service.EXPECT().Register(gomock.Any(), gomock.Any()).Return(metadata, nil),
+		service.EXPECT().Register(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(context.Context, string) (dashPlugin.Metadata, error) {
+				close(registering)
+				<-release
+				return metadata, nil
+			}),
+	)
+
+	clientProtocol := fake.NewMockClientProtocol(controller)
+	clientProtocol.EXPECT().Dispense("plugin").Return(service, nil).AnyTimes()
+
+	client := &fakePluginClient{service: service, clientProtocol: clientProtocol, name: name}
+	clientFactory.EXPECT().Init(gomock.Any(), gomock.Eq(name)).Return(client).Times(2)
+
+	manager := dashPlugin.NewManager(&stubAPIService{}, moduleRegistrar, actionRegistrar, func(m *dashPlugin.Manager) {
+		m.ClientFactory = clientFactory
+	})
+
+	require.NoError(t, manager.Load(name))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, manager.Start(ctx))
+
+	restarted := make(chan error, 1)
+	go func() {
+		restarted <- manager.Restart(ctx, name)
+	}()
+
+	<-registering
+
+	listed := make(chan []dashPlugin.PluginStatus, 1)
+	go func() {
+		listed <- manager.Plugins()
+	}()
+
+	select {
+	case plugins := <-listed:
+		require.Len(t, plugins, 1)
+	case <-time.After(time.Second):
+		t.Fatal("plugins were not listed while a plugin restarted")
+	}
+
+	close(release)
+	require.NoError(t, <-restarted)
+
+	manager.Stop(ctx)
+}
+
+func TestManager_UpdateSettings(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	name := "plugin1"
+	metadata := &dashPlugin.Metadata{
+		Name: name,
+		Settings: []dashPlugin.SettingField{
+			{Name: "url", Default: "https://example.com"},
+			{Name: "project", Default: "DEFAULT"},
+			{Name: "limit", Type: dashPlugin.SettingTypeNumber, Default: "10"},
+		},
+	}
+
+	store := fake.NewMockManagerStore(controller)
+	store.EXPECT().GetMetadata(name).Return(metadata, nil).AnyTimes()
+
+	service := fake.NewMockService(controller)
+	service.EXPECT().
+		UpdateSettings(gomock.Any(), dashPlugin.Settings{"url": "https://jira.example.com", "project": "DEFAULT", "limit": "10"}).
+		Return(nil)
+	store.EXPECT().GetService(name).Return(service, nil)
+
+	settingsStore := fake.NewMockSettingsStore(controller)
+	settingsStore.EXPECT().Load(name).Return(dashPlugin.Settings{}, nil)
+	settingsStore.EXPECT().Save(name, dashPlugin.Settings{"url": "https://jira.example.com"}).Return(nil)
+
+	manager := dashPlugin.NewManager(&stubAPIService{}, fake.NewMockModuleRegistrar(controller), fake.NewMockActionRegistrar(controller),
+		dashPlugin.WithSettingsStore(settingsStore))
+	manager.SetStore(store)
+	require.NoError(t, manager.Load(name))
+
+	ctx := context.Background()
+	require.NoError(t, manager.UpdateSettings(ctx, name, dashPlugin.Settings{"url": "https://jira.example.com"}))
+
+	assert.Error(t, manager.UpdateSettings(ctx, name, dashPlugin.Settings{"limit": "ten"}))
+	assert.Error(t, manager.UpdateSettings(ctx, "invalid", dashPlugin.Settings{}))
+}
+
+func TestManager_ListColumns(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	metadata := dashPlugin.Metadata{
+		Name: "remote",
+		Capabilities: dashPlugin.Capabilities{
+			SupportsListColumns: []dashPlugin.ListColumns{
+				{GroupVersionKind: gvk.Pod, Columns: []string{"Cost"}},
+			},
+		},
+	}
+
+	service := fake.NewMockService(controller)
+	service.EXPECT().Register(gomock.Any(), gomock.Any()).Return(metadata, nil)
+	service.EXPECT().ListColumns(gomock.Any(), gomock.Any()).
+		Return(dashPlugin.ListColumnsResponse{
+			Rows: []component.TableRow{{"Cost": component.NewText("$1")}},
+		}, nil)
+
+	server := httptest.NewServer(dashPlugin.NewHTTPServer(service))
+	defer server.Close()
+
+	moduleRegistrar := fake.NewMockModuleRegistrar(controller)
+	actionRegistrar := fake.NewMockActionRegistrar(controller)
+
+	manager := dashPlugin.NewManager(&stubAPIService{}, moduleRegistrar, actionRegistrar)
+	require.NoError(t, manager.LoadURL("remote", server.URL))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, manager.Start(ctx))
+	defer manager.Stop(ctx)
+
+	pods := testutil.ToUnstructuredList(t, testutil.CreatePod("pod1"), testutil.CreatePod("pod2"))
+
+	columns, rows, err := manager.ListColumns(ctx, pods)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"Cost"}, columns)
+	assert.Equal(t, []component.TableRow{{"Cost": component.NewText("$1")}, {}}, rows)
+
+	deployments := testutil.ToUnstructuredList(t, testutil.CreateDeployment("deployment"))
+	columns, rows, err = manager.ListColumns(ctx, deployments)
+	require.NoError(t, err)
+	assert.Empty(t, columns)
+	assert.Empty(t, rows)
+}
+
+func TestManager_LoadURL(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	service := fake.NewMockService(controller)
+	service.EXPECT().Register(gomock.Any(), gomock.Any()).Return(dashPlugin.Metadata{Name: "remote"}, nil)
+
+	server := httptest.NewServer(dashPlugin.NewHTTPServer(service))
+	defer server.Close()
+
+	moduleRegistrar := fake.NewMockModuleRegistrar(controller)
+	actionRegistrar := fake.NewMockActionRegistrar(controller)
+
+	manager := dashPlugin.NewManager(&stubAPIService{}, moduleRegistrar, actionRegistrar)
+
+	require.Error(t, manager.LoadURL("invalid", "localhost:8080"))
+	require.NoError(t, manager.LoadURL("remote", server.URL))
+	require.Error(t, manager.LoadURL("remote", server.URL))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, manager.Start(ctx))
+
+	plugins := manager.Plugins()
+	require.Len(t, plugins, 1)
+	assert.Equal(t, "remote", plugins[0].Name)
+	assert.Equal(t, server.URL, plugins[0].URL)
+	assert.Equal(t, dashPlugin.PluginStateRunning, plugins[0].State)
+
+	manager.Stop(ctx)
+}
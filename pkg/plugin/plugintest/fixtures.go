@@ -0,0 +1,68 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugintest
+
+import (
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// loadFixtures loads the objects in a YAML or JSON file. Files can hold
+// many documents separated by `---`, and lists are expanded into their
+// items.
+func loadFixtures(fixturePath string) ([]*unstructured.Unstructured, error) {
+	f, err := os.Open(fixturePath)
+	if err != nil {
+		return nil, errors.Wrap(err, "open fixture")
+	}
+	defer f.Close()
+
+	return decodeFixtures(f)
+}
+
+func decodeFixtures(r io.Reader) ([]*unstructured.Unstructured, error) {
+	decoder := yaml.NewYAMLOrJSONDecoder(r, 4096)
+
+	var objects []*unstructured.Unstructured
+	for {
+		var m map[string]interface{}
+		if err := decoder.Decode(&m); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, errors.Wrap(err, "decode fixture")
+		}
+
+		if len(m) == 0 {
+			continue
+		}
+
+		object := &unstructured.Unstructured{Object: m}
+		if object.GetAPIVersion() == "" || object.GetKind() == "" {
+			return nil, errors.Errorf("fixture %q requires apiVersion and kind", object.GetName())
+		}
+
+		if !object.IsList() {
+			objects = append(objects, object)
+			continue
+		}
+
+		list, err := object.ToList()
+		if err != nil {
+			return nil, errors.Wrap(err, "convert fixture to list")
+		}
+
+		for i := range list.Items {
+			objects = append(objects, &list.Items[i])
+		}
+	}
+
+	return objects, nil
+}
@@ -0,0 +1,47 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugintest
+
+import (
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("plugintest.update", false, "update plugintest golden files")
+
+// AssertGolden asserts the JSON for a value, usually a response from the
+// plugin, matches the golden file `testdata/<name>.golden`. Run the tests
+// with `-plugintest.update` to create or update golden files.
+func AssertGolden(t *testing.T, name string, value interface{}) {
+	t.Helper()
+
+	actual, err := json.MarshalIndent(value, "", "  ")
+	require.NoError(t, err)
+	actual = append(actual, '\n')
+
+	goldenPath := filepath.Join("testdata", name+".golden")
+
+	if *update {
+		require.NoError(t, os.MkdirAll(filepath.Dir(goldenPath), 0755))
+		require.NoError(t, ioutil.WriteFile(goldenPath, actual, 0644))
+		return
+	}
+
+	expected, err := ioutil.ReadFile(goldenPath)
+	if os.IsNotExist(err) {
+		t.Fatalf("golden file %s does not exist: run the test with -plugintest.update to create it", goldenPath)
+	}
+	require.NoError(t, err)
+
+	assert.JSONEq(t, string(expected), string(actual), "golden file %s", goldenPath)
+}
@@ -0,0 +1,273 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package plugintest runs plugins built with the service package in tests.
+// A Harness serves the plugin over gRPC, as Lissio does, and gives it a
+// dashboard backed by objects loaded from YAML fixtures.
+package plugintest
+
+import (
+	"context"
+	"testing"
+
+	goplugin "github.com/hashicorp/go-plugin"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubenext/lissio/pkg/action"
+	"github.com/kubenext/lissio/pkg/navigation"
+	"github.com/kubenext/lissio/pkg/plugin"
+	"github.com/kubenext/lissio/pkg/plugin/api"
+	"github.com/kubenext/lissio/pkg/plugin/service"
+	"github.com/kubenext/lissio/pkg/store"
+	"github.com/kubenext/lissio/pkg/view/component"
+)
+
+// Option is an option for configuring Harness.
+type Option func(h *Harness)
+
+// WithFixtures adds the objects in YAML or JSON files to the dashboard.
+// Files can hold many documents separated by `---`.
+func WithFixtures(fixturePaths ...string) Option {
+	return func(h *Harness) {
+		h.fixturePaths = append(h.fixturePaths, fixturePaths...)
+	}
+}
+
+// WithObjects adds objects to the dashboard.
+func WithObjects(objects ...runtime.Object) Option {
+	return func(h *Harness) {
+		h.objects = append(h.objects, objects...)
+	}
+}
+
+// WithSettings sets the plugin's settings. Settings which aren't set use
+// their default.
+func WithSettings(settings plugin.Settings) Option {
+	return func(h *Harness) {
+		h.settings = settings
+	}
+}
+
+// Harness runs a plugin against an in-memory dashboard. Calls to the
+// plugin go through the same gRPC client and server Lissio uses.
+type Harness struct {
+	t *testing.T
+
+	fixturePaths []string
+	objects      []runtime.Object
+	settings     plugin.Settings
+
+	ctx      context.Context
+	cancel   context.CancelFunc
+	store    *memoryStore
+	client   *goplugin.GRPCClient
+	server   *goplugin.GRPCServer
+	service  plugin.ModuleService
+	metadata plugin.Metadata
+}
+
+// New creates an instance of Harness and registers the plugin. The test
+// fails if the plugin can't be started. Call Close when the test is done.
+func New(t *testing.T, p *service.Plugin, options ...Option) *Harness {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	h := &Harness{
+		t:      t,
+		ctx:    ctx,
+		cancel: cancel,
+		store:  newMemoryStore(),
+	}
+
+	for _, option := range options {
+		option(h)
+	}
+
+	if err := h.start(p); err != nil {
+		h.Close()
+		t.Fatalf("start plugin: %v", err)
+	}
+
+	return h
+}
+
+func (h *Harness) start(p *service.Plugin) error {
+	if err := h.seed(); err != nil {
+		return err
+	}
+
+	dashboardService := &dashboardService{
+		GRPCService: &api.GRPCService{ObjectStore: h.store},
+	}
+
+	dashboardAPI, err := api.New(dashboardService)
+	if err != nil {
+		return errors.Wrap(err, "create dashboard api")
+	}
+
+	if err := dashboardAPI.Start(h.ctx); err != nil {
+		return errors.Wrap(err, "start dashboard api")
+	}
+
+	h.client, h.server = goplugin.TestPluginGRPCConn(h.t, map[string]goplugin.Plugin{
+		plugin.Name: &plugin.ServicePlugin{Impl: p.Service()},
+	})
+
+	raw, err := h.client.Dispense(plugin.Name)
+	if err != nil {
+		return errors.Wrap(err, "dispense plugin")
+	}
+
+	moduleService, ok := raw.(plugin.ModuleService)
+	if !ok {
+		return errors.Errorf("unknown plugin service type %T", raw)
+	}
+	h.service = moduleService
+
+	h.metadata, err = h.service.Register(h.ctx, dashboardAPI.Addr())
+	if err != nil {
+		return errors.Wrap(err, "register plugin")
+	}
+
+	if len(h.metadata.Settings) > 0 {
+		if err := h.UpdateSettings(h.settings); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// seed adds the fixtures and objects to the store.
+func (h *Harness) seed() error {
+	for _, fixturePath := range h.fixturePaths {
+		objects, err := loadFixtures(fixturePath)
+		if err != nil {
+			return errors.Wrapf(err, "load fixtures from %s", fixturePath)
+		}
+
+		for _, object := range objects {
+			if err := h.store.add(object); err != nil {
+				return errors.Wrapf(err, "add fixture from %s", fixturePath)
+			}
+		}
+	}
+
+	for _, object := range h.objects {
+		m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+		if err != nil {
+			return errors.Wrap(err, "convert object to unstructured")
+		}
+
+		if err := h.store.add(&unstructured.Unstructured{Object: m}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Close stops the plugin and the dashboard.
+func (h *Harness) Close() {
+	if h.client != nil {
+		_ = h.client.Close()
+	}
+
+	if h.server != nil {
+		h.server.Stop()
+	}
+
+	h.cancel()
+}
+
+// Metadata returns the metadata the plugin registered with.
+func (h *Harness) Metadata() plugin.Metadata {
+	return h.metadata
+}
+
+// Store returns the store behind the dashboard. Changes the plugin makes
+// through its dashboard client are visible in the store.
+func (h *Harness) Store() store.Store {
+	return h.store
+}
+
+// Get gets an object from the store. The test fails if the object
+// doesn't exist.
+func (h *Harness) Get(key store.Key) *unstructured.Unstructured {
+	object, found, err := h.store.Get(h.ctx, key)
+	if err != nil {
+		h.t.Fatalf("get %s: %v", key, err)
+	}
+
+	if !found {
+		h.t.Fatalf("%s was not found", key)
+	}
+
+	return object
+}
+
+// Print calls the plugin's printer for an object.
+func (h *Harness) Print(object runtime.Object) (plugin.PrintResponse, error) {
+	return h.service.Print(h.ctx, object)
+}
+
+// PrintTab calls the plugin's tab printer for an object.
+func (h *Harness) PrintTab(object runtime.Object) (plugin.TabResponse, error) {
+	return h.service.PrintTab(h.ctx, object)
+}
+
+// ObjectStatus calls the plugin's object status handler for an object.
+func (h *Harness) ObjectStatus(object runtime.Object) (plugin.ObjectStatusResponse, error) {
+	return h.service.ObjectStatus(h.ctx, object)
+}
+
+// RelatedObjects calls the plugin's object visitor for an object.
+func (h *Harness) RelatedObjects(object runtime.Object) (plugin.RelatedObjectsResponse, error) {
+	return h.service.RelatedObjects(h.ctx, object)
+}
+
+// HandleAction calls the plugin's action handler with a payload.
+func (h *Harness) HandleAction(payload action.Payload) (plugin.ActionResponse, error) {
+	return h.service.HandleAction(h.ctx, payload)
+}
+
+// UpdateSettings validates settings and sends them to the plugin the way
+// Lissio does when a user saves them.
+func (h *Harness) UpdateSettings(settings plugin.Settings) error {
+	if err := plugin.ValidateSettings(h.metadata.Settings, settings); err != nil {
+		return err
+	}
+
+	return h.service.UpdateSettings(h.ctx, plugin.ResolveSettings(h.metadata.Settings, settings))
+}
+
+// Navigation calls the plugin's navigation handler.
+func (h *Harness) Navigation() (navigation.Navigation, error) {
+	return h.service.Navigation(h.ctx)
+}
+
+// Content calls the plugin's route for a content path. The path is
+// relative to the plugin.
+func (h *Harness) Content(contentPath string) (component.ContentResponse, error) {
+	return h.service.Content(h.ctx, contentPath)
+}
+
+// dashboardService is the dashboard service plugins call. Port forwards
+// need a cluster, so they aren't available.
+type dashboardService struct {
+	*api.GRPCService
+}
+
+var _ api.Service = (*dashboardService)(nil)
+
+// PortForward returns an error. There is no cluster to forward to.
+func (s *dashboardService) PortForward(ctx context.Context, req api.PortForwardRequest) (api.PortForwardResponse, error) {
+	return api.PortForwardResponse{}, errors.New("port forwarding is not available in plugintest")
+}
+
+// CancelPortForward does nothing.
+func (s *dashboardService) CancelPortForward(ctx context.Context, id string) {
+}
@@ -0,0 +1,216 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugintest_test
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kubenext/lissio/internal/gvk"
+	"github.com/kubenext/lissio/internal/testutil"
+	"github.com/kubenext/lissio/pkg/action"
+	"github.com/kubenext/lissio/pkg/navigation"
+	"github.com/kubenext/lissio/pkg/plugin"
+	"github.com/kubenext/lissio/pkg/plugin/plugintest"
+	"github.com/kubenext/lissio/pkg/plugin/service"
+	"github.com/kubenext/lissio/pkg/store"
+	"github.com/kubenext/lissio/pkg/view/component"
+)
+
+var (
+	configMapKey = store.Key{Namespace: "default", APIVersion: "v1", Kind: "ConfigMap", Name: "web-config"}
+	webKey       = store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod", Name: "web"}
+)
+
+// newTestPlugin creates a plugin which uses its dashboard client for each
+// of its handlers.
+func newTestPlugin(t *testing.T) *service.Plugin {
+	var greeting string
+
+	capabilities := &plugin.Capabilities{
+		SupportsPrinterConfig: []schema.GroupVersionKind{gvk.Pod},
+		SupportsTab:           []schema.GroupVersionKind{gvk.Pod},
+		SupportsObjectStatus:  []schema.GroupVersionKind{gvk.Pod},
+		ActionNames:           []string{"test/scale"},
+		IsModule:              true,
+	}
+
+	print := func(request *service.PrintRequest) (plugin.PrintResponse, error) {
+		pod, ok := request.Object.(*unstructured.Unstructured)
+		if !ok {
+			return plugin.PrintResponse{}, errors.Errorf("unexpected object %T", request.Object)
+		}
+
+		selector := labels.Set(pod.GetLabels())
+		key := store.Key{Namespace: pod.GetNamespace(), APIVersion: "v1", Kind: "Pod", Selector: &selector}
+		pods, err := request.DashboardClient.List(request.Context(), key)
+		if err != nil {
+			return plugin.PrintResponse{}, err
+		}
+
+		return plugin.PrintResponse{
+			Config: []component.SummarySection{
+				{Header: "Greeting", Content: component.NewText(greeting)},
+				{Header: "Pods With Labels", Content: component.NewText(fmt.Sprintf("%d", len(pods.Items)))},
+			},
+		}, nil
+	}
+
+	printTab := func(request *service.PrintRequest) (plugin.TabResponse, error) {
+		layout := component.NewFlexLayout("Test")
+		layout.AddSections(component.FlexLayoutSection{
+			{Width: component.WidthFull, View: component.NewText("tab content")},
+		})
+
+		return plugin.TabResponse{Tab: &component.Tab{Name: "Test", Contents: *layout}}, nil
+	}
+
+	objectStatus := func(request *service.PrintRequest) (plugin.ObjectStatusResponse, error) {
+		return plugin.ObjectStatusResponse{
+			ObjectStatus: component.PodSummary{
+				Details: []component.Component{component.NewText("checked by test")},
+			},
+		}, nil
+	}
+
+	handleAction := func(request *service.ActionRequest) error {
+		replicas, err := request.Payload.String("replicas")
+		if err != nil {
+			return err
+		}
+
+		configMap, found, err := request.DashboardClient.Get(request.Context(), configMapKey)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return errors.New("config map not found")
+		}
+
+		if err := unstructured.SetNestedField(configMap.Object, replicas, "data", "replicas"); err != nil {
+			return err
+		}
+
+		if err := request.DashboardClient.Update(request.Context(), configMap); err != nil {
+			return err
+		}
+
+		request.SendAlert(action.AlertTypeInfo, "scaled to "+replicas, 0)
+		return nil
+	}
+
+	settingFields := []plugin.SettingField{
+		{Name: "greeting", Type: plugin.SettingTypeString, Default: "hello"},
+	}
+
+	updateSettings := func(request *service.SettingsRequest) error {
+		greeting = request.Settings["greeting"]
+		return nil
+	}
+
+	navigationHandler := func(request *service.NavigationRequest) (navigation.Navigation, error) {
+		return navigation.Navigation{Title: "Test", Path: request.GeneratePath()}, nil
+	}
+
+	initRoutes := func(router *service.Router) {
+		router.HandleFunc("/*", func(request *service.Request) (component.ContentResponse, error) {
+			return component.ContentResponse{
+				Title:      component.TitleFromString("Test"),
+				Components: []component.Component{component.NewText("path " + request.Path)},
+			}, nil
+		})
+	}
+
+	p, err := service.Register("test", "a test plugin", capabilities,
+		service.WithPrinter(print),
+		service.WithTabPrinter(printTab),
+		service.WithObjectStatus(objectStatus),
+		service.WithActionHandler(handleAction),
+		service.WithSettings(settingFields, updateSettings),
+		service.WithNavigation(navigationHandler, initRoutes))
+	require.NoError(t, err)
+
+	return p
+}
+
+func TestHarness(t *testing.T) {
+	h := plugintest.New(t, newTestPlugin(t),
+		plugintest.WithFixtures(filepath.Join("testdata", "fixtures.yaml")),
+		plugintest.WithObjects(testutil.CreatePod("other")))
+	defer h.Close()
+
+	assert.Equal(t, "test", h.Metadata().Name)
+
+	pod := h.Get(webKey)
+
+	printResponse, err := h.Print(pod)
+	require.NoError(t, err)
+	plugintest.AssertGolden(t, "print", printResponse)
+
+	tabResponse, err := h.PrintTab(pod)
+	require.NoError(t, err)
+	plugintest.AssertGolden(t, "print_tab", tabResponse)
+
+	statusResponse, err := h.ObjectStatus(pod)
+	require.NoError(t, err)
+	plugintest.AssertGolden(t, "object_status", statusResponse)
+
+	nav, err := h.Navigation()
+	require.NoError(t, err)
+	assert.Equal(t, navigation.Navigation{Title: "Test", Path: "test"}, nav)
+
+	contentResponse, err := h.Content("/nested")
+	require.NoError(t, err)
+	plugintest.AssertGolden(t, "content", contentResponse)
+}
+
+func TestHarness_HandleAction(t *testing.T) {
+	h := plugintest.New(t, newTestPlugin(t),
+		plugintest.WithFixtures(filepath.Join("testdata", "fixtures.yaml")))
+	defer h.Close()
+
+	actionResponse, err := h.HandleAction(action.Payload{"action": "test/scale", "replicas": "3"})
+	require.NoError(t, err)
+	require.Len(t, actionResponse.Alerts, 1)
+	assert.Equal(t, "scaled to 3", actionResponse.Alerts[0].Message)
+
+	configMap := h.Get(configMapKey)
+	replicas, _, err := unstructured.NestedString(configMap.Object, "data", "replicas")
+	require.NoError(t, err)
+	assert.Equal(t, "3", replicas)
+
+	_, err = h.HandleAction(action.Payload{"action": "test/scale"})
+	require.Error(t, err)
+}
+
+func TestHarness_settings(t *testing.T) {
+	h := plugintest.New(t, newTestPlugin(t),
+		plugintest.WithFixtures(filepath.Join("testdata", "fixtures.yaml")),
+		plugintest.WithSettings(plugin.Settings{"greeting": "hi"}))
+	defer h.Close()
+
+	pod := h.Get(webKey)
+
+	printResponse, err := h.Print(pod)
+	require.NoError(t, err)
+	require.NotEmpty(t, printResponse.Config)
+	assert.Equal(t, component.NewText("hi"), printResponse.Config[0].Content)
+
+	require.Error(t, h.UpdateSettings(plugin.Settings{"unknown": "value"}))
+	require.NoError(t, h.UpdateSettings(plugin.Settings{}))
+
+	printResponse, err = h.Print(pod)
+	require.NoError(t, err)
+	assert.Equal(t, component.NewText("hello"), printResponse.Config[0].Content)
+}
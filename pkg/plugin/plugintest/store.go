@@ -0,0 +1,320 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugintest
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubenext/lissio/internal/cluster"
+	"github.com/kubenext/lissio/pkg/store"
+)
+
+// memoryStore is a store.Store which keeps objects in memory. It stands
+// in for the cluster when a plugin is tested.
+type memoryStore struct {
+	objects         map[store.Key]*unstructured.Unstructured
+	watches         []memoryWatch
+	updateFns       []store.UpdateFn
+	resourceVersion int
+
+	mu sync.Mutex
+}
+
+var _ store.Store = (*memoryStore)(nil)
+
+type memoryWatch struct {
+	key     store.Key
+	handler cache.ResourceEventHandler
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{
+		objects: make(map[store.Key]*unstructured.Unstructured),
+	}
+}
+
+// add adds an object to the store without notifying watchers.
+func (s *memoryStore) add(object *unstructured.Unstructured) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, err := store.KeyFromObject(object)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := s.objects[key]; ok {
+		return errors.Errorf("%s already exists", key)
+	}
+
+	object = object.DeepCopy()
+	if object.GetResourceVersion() == "" {
+		s.setResourceVersion(object)
+	}
+
+	s.objects[key] = object
+	return nil
+}
+
+// List lists objects matching a key. Objects are sorted by namespace and name.
+func (s *memoryStore) List(ctx context.Context, key store.Key) (*unstructured.UnstructuredList, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	list := &unstructured.UnstructuredList{}
+	for _, object := range s.objects {
+		if keyMatches(key, object) {
+			list.Items = append(list.Items, *object.DeepCopy())
+		}
+	}
+
+	sort.Slice(list.Items, func(i, j int) bool {
+		a, b := list.Items[i], list.Items[j]
+		if a.GetNamespace() != b.GetNamespace() {
+			return a.GetNamespace() < b.GetNamespace()
+		}
+		return a.GetName() < b.GetName()
+	})
+
+	return list, false, nil
+}
+
+// Get gets an object.
+func (s *memoryStore) Get(ctx context.Context, key store.Key) (*unstructured.Unstructured, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	object, ok := s.objects[objectKey(key)]
+	if !ok {
+		return nil, false, nil
+	}
+
+	return object.DeepCopy(), true, nil
+}
+
+// Delete deletes an object.
+func (s *memoryStore) Delete(ctx context.Context, key store.Key) error {
+	s.mu.Lock()
+
+	key = objectKey(key)
+	object, ok := s.objects[key]
+	if !ok {
+		s.mu.Unlock()
+		return errors.Errorf("%s not found", key)
+	}
+	delete(s.objects, key)
+
+	handlers := s.handlers(object)
+	s.mu.Unlock()
+
+	for _, handler := range handlers {
+		handler.OnDelete(object.DeepCopy())
+	}
+	s.updated()
+
+	return nil
+}
+
+// Create creates an object.
+func (s *memoryStore) Create(ctx context.Context, object *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	key, err := store.KeyFromObject(object)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+
+	if _, ok := s.objects[key]; ok {
+		s.mu.Unlock()
+		return nil, errors.Errorf("%s already exists", key)
+	}
+
+	object = object.DeepCopy()
+	s.setResourceVersion(object)
+	s.objects[key] = object
+
+	handlers := s.handlers(object)
+	s.mu.Unlock()
+
+	for _, handler := range handlers {
+		handler.OnAdd(object.DeepCopy())
+	}
+	s.updated()
+
+	return object.DeepCopy(), nil
+}
+
+// Watch watches objects matching a key. Objects which already exist are
+// sent to the handler as added.
+func (s *memoryStore) Watch(ctx context.Context, key store.Key, handler cache.ResourceEventHandler) error {
+	s.mu.Lock()
+
+	s.watches = append(s.watches, memoryWatch{key: key, handler: handler})
+
+	var existing []*unstructured.Unstructured
+	for _, object := range s.objects {
+		if keyMatches(key, object) {
+			existing = append(existing, object.DeepCopy())
+		}
+	}
+
+	s.mu.Unlock()
+
+	for _, object := range existing {
+		handler.OnAdd(object)
+	}
+
+	return nil
+}
+
+// Unwatch removes the watches for group version kinds.
+func (s *memoryStore) Unwatch(ctx context.Context, groupVersionKinds ...schema.GroupVersionKind) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var watches []memoryWatch
+	for _, watch := range s.watches {
+		unwatched := false
+		for _, groupVersionKind := range groupVersionKinds {
+			if watch.key.GroupVersionKind() == groupVersionKind {
+				unwatched = true
+			}
+		}
+
+		if !unwatched {
+			watches = append(watches, watch)
+		}
+	}
+
+	s.watches = watches
+	return nil
+}
+
+// UpdateClusterClient does nothing. There is no cluster.
+func (s *memoryStore) UpdateClusterClient(ctx context.Context, client cluster.ClientInterface) error {
+	return nil
+}
+
+// RegisterOnUpdate registers a function which is called after the store
+// changes.
+func (s *memoryStore) RegisterOnUpdate(fn store.UpdateFn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.updateFns = append(s.updateFns, fn)
+}
+
+// Update updates an object.
+func (s *memoryStore) Update(ctx context.Context, key store.Key, updater func(*unstructured.Unstructured) error) error {
+	if updater == nil {
+		return errors.New("updater is nil")
+	}
+
+	s.mu.Lock()
+
+	key = objectKey(key)
+	current, ok := s.objects[key]
+	if !ok {
+		s.mu.Unlock()
+		return errors.Errorf("%s not found", key)
+	}
+
+	object := current.DeepCopy()
+	if err := updater(object); err != nil {
+		s.mu.Unlock()
+		return errors.Wrap(err, "update object")
+	}
+
+	s.setResourceVersion(object)
+	s.objects[key] = object
+
+	handlers := s.handlers(object)
+	s.mu.Unlock()
+
+	for _, handler := range handlers {
+		handler.OnUpdate(current.DeepCopy(), object.DeepCopy())
+	}
+	s.updated()
+
+	return nil
+}
+
+// IsLoading is always false. Objects are available once they are added.
+func (s *memoryStore) IsLoading(ctx context.Context, key store.Key) bool {
+	return false
+}
+
+// handlers returns the handlers watching an object. The caller must
+// hold the lock.
+func (s *memoryStore) handlers(object *unstructured.Unstructured) []cache.ResourceEventHandler {
+	var handlers []cache.ResourceEventHandler
+	for _, watch := range s.watches {
+		if keyMatches(watch.key, object) {
+			handlers = append(handlers, watch.handler)
+		}
+	}
+
+	return handlers
+}
+
+// setResourceVersion gives an object a new resource version. The caller
+// must hold the lock.
+func (s *memoryStore) setResourceVersion(object *unstructured.Unstructured) {
+	s.resourceVersion++
+	object.SetResourceVersion(strconv.Itoa(s.resourceVersion))
+}
+
+func (s *memoryStore) updated() {
+	s.mu.Lock()
+	fns := make([]store.UpdateFn, len(s.updateFns))
+	copy(fns, s.updateFns)
+	s.mu.Unlock()
+
+	for _, fn := range fns {
+		fn(s)
+	}
+}
+
+// objectKey returns the key an object is stored under.
+func objectKey(key store.Key) store.Key {
+	return store.Key{
+		Namespace:  key.Namespace,
+		APIVersion: key.APIVersion,
+		Kind:       key.Kind,
+		Name:       key.Name,
+	}
+}
+
+// keyMatches returns true if an object matches a key's group version kind,
+// namespace, name and label selector.
+func keyMatches(key store.Key, object *unstructured.Unstructured) bool {
+	if object.GetAPIVersion() != key.APIVersion || object.GetKind() != key.Kind {
+		return false
+	}
+
+	if key.Namespace != "" && object.GetNamespace() != key.Namespace {
+		return false
+	}
+
+	if key.Name != "" && object.GetName() != key.Name {
+		return false
+	}
+
+	if key.Selector != nil && !key.Selector.AsSelector().Matches(labels.Set(object.GetLabels())) {
+		return false
+	}
+
+	return true
+}
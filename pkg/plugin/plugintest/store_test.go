@@ -0,0 +1,100 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugintest
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubenext/lissio/pkg/store"
+)
+
+const testFixtures = `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: web
+  namespace: default
+  labels:
+    app: web
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: worker
+  namespace: default
+  labels:
+    app: worker
+`
+
+func Test_memoryStore(t *testing.T) {
+	objects, err := decodeFixtures(strings.NewReader(testFixtures))
+	require.NoError(t, err)
+	require.Len(t, objects, 2)
+
+	s := newMemoryStore()
+	for _, object := range objects {
+		require.NoError(t, s.add(object))
+	}
+	require.Error(t, s.add(objects[0]))
+
+	ctx := context.Background()
+
+	selector := labels.Set{"app": "web"}
+	list, _, err := s.List(ctx, store.Key{APIVersion: "v1", Kind: "Pod", Selector: &selector})
+	require.NoError(t, err)
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, "web", list.Items[0].GetName())
+
+	var events []string
+	handler := cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			events = append(events, "add "+obj.(*unstructured.Unstructured).GetName())
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			events = append(events, "update "+newObj.(*unstructured.Unstructured).GetName())
+		},
+		DeleteFunc: func(obj interface{}) {
+			events = append(events, "delete "+obj.(*unstructured.Unstructured).GetName())
+		},
+	}
+
+	webKey := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod", Name: "web"}
+	require.NoError(t, s.Watch(ctx, webKey, handler))
+
+	err = s.Update(ctx, webKey, func(object *unstructured.Unstructured) error {
+		object.SetLabels(map[string]string{"app": "web", "tier": "frontend"})
+		return nil
+	})
+	require.NoError(t, err)
+
+	object, found, err := s.Get(ctx, webKey)
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, "frontend", object.GetLabels()["tier"])
+	assert.Equal(t, "3", object.GetResourceVersion())
+
+	require.NoError(t, s.Delete(ctx, webKey))
+	require.Error(t, s.Delete(ctx, webKey))
+
+	_, found, err = s.Get(ctx, webKey)
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	assert.Equal(t, []string{"add web", "update web", "delete web"}, events)
+}
+
+func Test_decodeFixtures_requires_kind(t *testing.T) {
+	_, err := decodeFixtures(strings.NewReader("metadata:\n  name: web\n"))
+	require.Error(t, err)
+}
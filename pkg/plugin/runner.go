@@ -7,9 +7,11 @@ package plugin
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 	"golang.org/x/sync/errgroup"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
@@ -28,6 +30,12 @@ type Runners interface {
 	// ObjectStatus returns a runner for object status. The caller should
 	// close the channel when they are done with it.
 	ObjectStatus(ManagerStore) (DefaultRunner, chan ObjectStatusResponse)
+	// RelatedObjects returns a runner for related objects. The caller should
+	// close the channel when they are done with it.
+	RelatedObjects(ManagerStore) (DefaultRunner, chan RelatedObjectsResponse)
+	// ListColumns returns a runner for list columns. The caller should
+	// close the channel when they are done with it.
+	ListColumns(ManagerStore) (DefaultRunner, chan ListColumnsResponse)
 }
 
 type defaultRunners struct{}
@@ -53,9 +61,28 @@ func (dr *defaultRunners) ObjectStatus(store ManagerStore) (DefaultRunner, chan
 	return ObjectStatusRunner(store, ch), ch
 }
 
+func (dr *defaultRunners) RelatedObjects(store ManagerStore) (DefaultRunner, chan RelatedObjectsResponse) {
+	ch := make(chan RelatedObjectsResponse)
+	return RelatedObjectsRunner(store, ch), ch
+}
+
+func (dr *defaultRunners) ListColumns(store ManagerStore) (DefaultRunner, chan ListColumnsResponse) {
+	ch := make(chan ListColumnsResponse)
+	return ListColumnsRunner(store, ch), ch
+}
+
 // DefaultRunner runs a function against all plugins
 type DefaultRunner struct {
 	RunFunc func(ctx context.Context, name string, gvk schema.GroupVersionKind, object runtime.Object) error
+
+	// Timeout is the deadline for each plugin. Plugins have no deadline
+	// if it is zero.
+	Timeout time.Duration
+
+	// ErrorFunc is called with the errors returned for plugins. If it is
+	// set, a failing plugin doesn't fail the run so the results from the
+	// other plugins can be used.
+	ErrorFunc func(name string, err error)
 }
 
 // Run runs the runner for an object with the provided clients.
@@ -71,11 +98,17 @@ func (pr *DefaultRunner) Run(ctx context.Context, object runtime.Object, clientN
 	for _, name := range clientNames {
 		fn := func(name string) func() error {
 			return func() error {
-				if err := pr.RunFunc(ctx, name, gvk, object); err != nil {
-					return errors.Wrap(err, "running")
+				err := pr.runPlugin(ctx, name, gvk, object)
+				if err == nil {
+					return nil
 				}
 
-				return nil
+				if pr.ErrorFunc != nil {
+					pr.ErrorFunc(name, err)
+					return nil
+				}
+
+				return errors.Wrap(err, "running")
 			}
 		}
 
@@ -89,6 +122,22 @@ func (pr *DefaultRunner) Run(ctx context.Context, object runtime.Object, clientN
 	return nil
 }
 
+func (pr *DefaultRunner) runPlugin(ctx context.Context, name string, gvk schema.GroupVersionKind, object runtime.Object) error {
+	if pr.Timeout <= 0 {
+		return pr.RunFunc(ctx, name, gvk, object)
+	}
+
+	pluginCtx, cancel := context.WithTimeout(ctx, pr.Timeout)
+	defer cancel()
+
+	err := pr.RunFunc(pluginCtx, name, gvk, object)
+	if err != nil && ctx.Err() == nil && pluginCtx.Err() == context.DeadlineExceeded {
+		return errors.Wrapf(err, "plugin %q timed out after %s", name, pr.Timeout)
+	}
+
+	return err
+}
+
 func (pr *DefaultRunner) validate(object runtime.Object) error {
 	if object == nil {
 		return errors.New("object is nil")
@@ -207,3 +256,91 @@ func ObjectStatusRunner(store ManagerStore, ch chan<- ObjectStatusResponse) Defa
 		},
 	}
 }
+
+// RelatedObjectsRunner is a runner for related objects.
+func RelatedObjectsRunner(store ManagerStore, ch chan<- RelatedObjectsResponse) DefaultRunner {
+	return DefaultRunner{
+		RunFunc: func(ctx context.Context, name string, gvk schema.GroupVersionKind, object runtime.Object) error {
+			metadata, err := store.GetMetadata(name)
+			if err != nil {
+				return err
+			}
+
+			if !metadata.Capabilities.HasObjectVisitorSupport(gvk) {
+				return nil
+			}
+
+			service, err := store.GetService(name)
+			if err != nil {
+				return err
+			}
+
+			resp, err := service.RelatedObjects(ctx, object)
+			if err != nil {
+				return errors.Wrapf(err, "find related objects with plugin %q", name)
+			}
+
+			ch <- resp
+			return nil
+		},
+	}
+}
+
+// ListColumnsRunner is a runner for list columns. It is run with a list of
+// objects and plugins are called once with all of the list's items. Cells
+// for columns a plugin didn't declare are dropped.
+func ListColumnsRunner(store ManagerStore, ch chan<- ListColumnsResponse) DefaultRunner {
+	return DefaultRunner{
+		RunFunc: func(ctx context.Context, name string, _ schema.GroupVersionKind, object runtime.Object) error {
+			list, ok := object.(*unstructured.UnstructuredList)
+			if !ok {
+				return errors.Errorf("list columns requires an unstructured list; got %T", object)
+			}
+
+			if len(list.Items) == 0 {
+				return nil
+			}
+
+			metadata, err := store.GetMetadata(name)
+			if err != nil {
+				return err
+			}
+
+			columns := metadata.Capabilities.ListColumnsFor(list.Items[0].GroupVersionKind())
+			if len(columns) == 0 {
+				return nil
+			}
+
+			service, err := store.GetService(name)
+			if err != nil {
+				return err
+			}
+
+			var objects []runtime.Object
+			for i := range list.Items {
+				objects = append(objects, &list.Items[i])
+			}
+
+			resp, err := service.ListColumns(ctx, objects)
+			if err != nil {
+				return errors.Wrapf(err, "list columns with plugin %q", name)
+			}
+
+			declared := make(map[string]bool, len(columns))
+			for _, column := range columns {
+				declared[column] = true
+			}
+
+			for _, row := range resp.Rows {
+				for column := range row {
+					if !declared[column] {
+						delete(row, column)
+					}
+				}
+			}
+
+			ch <- resp
+			return nil
+		},
+	}
+}
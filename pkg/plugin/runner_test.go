@@ -8,6 +8,7 @@ package plugin_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
@@ -20,6 +21,7 @@ import (
 	"github.com/kubenext/lissio/internal/testutil"
 	"github.com/kubenext/lissio/pkg/plugin"
 	"github.com/kubenext/lissio/pkg/plugin/fake"
+	"github.com/kubenext/lissio/pkg/store"
 	"github.com/kubenext/lissio/pkg/view/component"
 )
 
@@ -84,6 +86,49 @@ func TestDefaultRunner_run_func_returns_error(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestDefaultRunner_error_func(t *testing.T) {
+	pr := plugin.DefaultRunner{
+		RunFunc: func(ctx context.Context, name string, gvk schema.GroupVersionKind, object runtime.Object) error {
+			if name == "plugin1" {
+				return errors.Errorf("error")
+			}
+			return nil
+		},
+	}
+
+	var failed []string
+	pr.ErrorFunc = func(name string, err error) {
+		failed = append(failed, name)
+	}
+
+	object := testutil.CreateDeployment("deployment")
+	clientNames := []string{"plugin1", "plugin2"}
+
+	ctx := context.Background()
+	err := pr.Run(ctx, object, clientNames)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"plugin1"}, failed)
+}
+
+func TestDefaultRunner_timeout(t *testing.T) {
+	pr := plugin.DefaultRunner{
+		RunFunc: func(ctx context.Context, name string, gvk schema.GroupVersionKind, object runtime.Object) error {
+			<-ctx.Done()
+			return ctx.Err()
+		},
+		Timeout: time.Millisecond,
+	}
+
+	object := testutil.CreateDeployment("deployment")
+	clientNames := []string{"plugin1"}
+
+	ctx := context.Background()
+	err := pr.Run(ctx, object, clientNames)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `plugin "plugin1" timed out after 1ms`)
+}
+
 func Test_PrintRunner(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
@@ -186,3 +231,124 @@ func Test_TabRunner(t *testing.T) {
 	ctx := context.Background()
 	require.NoError(t, runner.Run(ctx, object, clientNames))
 }
+
+func Test_RelatedObjectsRunner(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	managerStore := fake.NewMockManagerStore(controller)
+	service := fake.NewMockService(controller)
+
+	object := testutil.CreateDeployment("deployment")
+	clientNames := []string{"plugin1", "plugin2"}
+
+	plugin1Metadata := &plugin.Metadata{
+		Capabilities: plugin.Capabilities{
+			SupportsObjectVisitor: []schema.GroupVersionKind{gvk.Deployment},
+		},
+	}
+	managerStore.EXPECT().
+		GetMetadata(gomock.Eq("plugin1")).Return(plugin1Metadata, nil)
+
+	plugin2Metadata := &plugin.Metadata{}
+	managerStore.EXPECT().
+		GetMetadata(gomock.Eq("plugin2")).Return(plugin2Metadata, nil)
+
+	managerStore.EXPECT().
+		GetService(gomock.Eq("plugin1")).Return(service, nil)
+
+	relatedObjectsResponse := plugin.RelatedObjectsResponse{
+		Objects: []store.Key{
+			{Namespace: "namespace", APIVersion: "v1", Kind: "Secret", Name: "secret"},
+		},
+	}
+
+	service.EXPECT().
+		RelatedObjects(gomock.Any(), gomock.Eq(object)).Return(relatedObjectsResponse, nil)
+
+	ch := make(chan plugin.RelatedObjectsResponse)
+	defer close(ch)
+
+	runner := plugin.RelatedObjectsRunner(managerStore, ch)
+
+	done := make(chan bool)
+	go func() {
+		resp := <-ch
+		assert.Equal(t, relatedObjectsResponse, resp)
+		done <- true
+	}()
+
+	defer func() {
+		<-done
+	}()
+
+	ctx := context.Background()
+	require.NoError(t, runner.Run(ctx, object, clientNames))
+}
+
+func Test_ListColumnsRunner(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	managerStore := fake.NewMockManagerStore(controller)
+	service := fake.NewMockService(controller)
+
+	list := testutil.ToUnstructuredList(t,
+		testutil.CreateDeployment("deployment1"),
+		testutil.CreateDeployment("deployment2"))
+	clientNames := []string{"plugin1", "plugin2"}
+
+	plugin1Metadata := &plugin.Metadata{
+		Capabilities: plugin.Capabilities{
+			SupportsListColumns: []plugin.ListColumns{
+				{GroupVersionKind: gvk.Deployment, Columns: []string{"Cost"}},
+			},
+		},
+	}
+	managerStore.EXPECT().
+		GetMetadata(gomock.Eq("plugin1")).Return(plugin1Metadata, nil)
+
+	plugin2Metadata := &plugin.Metadata{}
+	managerStore.EXPECT().
+		GetMetadata(gomock.Eq("plugin2")).Return(plugin2Metadata, nil)
+
+	managerStore.EXPECT().
+		GetService(gomock.Eq("plugin1")).Return(service, nil)
+
+	service.EXPECT().
+		ListColumns(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, objects []runtime.Object) (plugin.ListColumnsResponse, error) {
+			require.Len(t, objects, 2)
+			return plugin.ListColumnsResponse{
+				Rows: []component.TableRow{
+					{"Cost": component.NewText("$1"), "Undeclared": component.NewText("x")},
+					{"Cost": component.NewText("$2")},
+				},
+			}, nil
+		})
+
+	ch := make(chan plugin.ListColumnsResponse)
+	defer close(ch)
+
+	runner := plugin.ListColumnsRunner(managerStore, ch)
+
+	done := make(chan bool)
+	go func() {
+		resp := <-ch
+		expected := plugin.ListColumnsResponse{
+			Rows: []component.TableRow{
+				{"Cost": component.NewText("$1")},
+				{"Cost": component.NewText("$2")},
+			},
+		}
+		assert.Equal(t, expected, resp)
+		done <- true
+	}()
+
+	defer func() {
+		<-done
+	}()
+
+	ctx := context.Background()
+	require.NoError(t, runner.Run(ctx, list, clientNames))
+}
@@ -17,6 +17,9 @@ type Dashboard interface {
 	List(ctx context.Context, key store.Key) (*unstructured.UnstructuredList, error)
 	Get(ctx context.Context, key store.Key) (*unstructured.Unstructured, bool, error)
 	Update(ctx context.Context, object *unstructured.Unstructured) error
+	Create(ctx context.Context, object *unstructured.Unstructured) (*unstructured.Unstructured, error)
+	Delete(ctx context.Context, key store.Key) error
+	Watch(ctx context.Context, key store.Key) (<-chan api.WatchEvent, error)
 	PortForward(ctx context.Context, req api.PortForwardRequest) (api.PortForwardResponse, error)
 	CancelPortForward(ctx context.Context, id string)
 	ForceFrontendUpdate(ctx context.Context) error
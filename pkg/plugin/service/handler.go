@@ -23,6 +23,8 @@ type Handler struct {
 	description  string
 	capabilities *plugin.Capabilities
 
+	settingFields []plugin.SettingField
+
 	dashboardFactory func(dashboardAPIAddress string) (Dashboard, error)
 	dashboardClient  Dashboard
 	router           *Router
@@ -55,6 +57,7 @@ func (p *Handler) Register(ctx context.Context, dashboardAPIAddress string) (plu
 		Name:         p.name,
 		Description:  p.description,
 		Capabilities: *p.capabilities,
+		Settings:     p.settingFields,
 	}, nil
 }
 
@@ -103,10 +106,40 @@ func (p *Handler) ObjectStatus(ctx context.Context, object runtime.Object) (plug
 	return p.HandlerFuncs.ObjectStatus(request)
 }
 
+// RelatedObjects finds objects related to an object.
+func (p *Handler) RelatedObjects(ctx context.Context, object runtime.Object) (plugin.RelatedObjectsResponse, error) {
+	if p.HandlerFuncs.RelatedObjects == nil {
+		return plugin.RelatedObjectsResponse{}, nil
+	}
+
+	request := &PrintRequest{
+		baseRequest:     newBaseRequest(ctx, p.name),
+		DashboardClient: p.dashboardClient,
+		Object:          object,
+	}
+
+	return p.HandlerFuncs.RelatedObjects(request)
+}
+
+// ListColumns creates cells for the columns the plugin adds to a list of objects.
+func (p *Handler) ListColumns(ctx context.Context, objects []runtime.Object) (plugin.ListColumnsResponse, error) {
+	if p.HandlerFuncs.ListColumns == nil {
+		return plugin.ListColumnsResponse{}, nil
+	}
+
+	request := &ListColumnsRequest{
+		baseRequest:     newBaseRequest(ctx, p.name),
+		DashboardClient: p.dashboardClient,
+		Objects:         objects,
+	}
+
+	return p.HandlerFuncs.ListColumns(request)
+}
+
 // HandleAction handles actions given a payload.
-func (p *Handler) HandleAction(ctx context.Context, payload action.Payload) error {
+func (p *Handler) HandleAction(ctx context.Context, payload action.Payload) (plugin.ActionResponse, error) {
 	if p.HandlerFuncs.HandleAction == nil {
-		return nil
+		return plugin.ActionResponse{}, nil
 	}
 
 	request := &ActionRequest{
@@ -115,7 +148,26 @@ func (p *Handler) HandleAction(ctx context.Context, payload action.Payload) erro
 		Payload:         payload,
 	}
 
-	return p.HandlerFuncs.HandleAction(request)
+	if err := p.HandlerFuncs.HandleAction(request); err != nil {
+		return plugin.ActionResponse{}, err
+	}
+
+	return request.response, nil
+}
+
+// UpdateSettings updates the plugin's settings.
+func (p *Handler) UpdateSettings(ctx context.Context, settings plugin.Settings) error {
+	if p.HandlerFuncs.UpdateSettings == nil {
+		return nil
+	}
+
+	request := &SettingsRequest{
+		baseRequest:     newBaseRequest(ctx, p.name),
+		DashboardClient: p.dashboardClient,
+		Settings:        settings,
+	}
+
+	return p.HandlerFuncs.UpdateSettings(request)
 }
 
 // Navigation creates navigation.
@@ -8,6 +8,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/kubenext/lissio/internal/gvk"
@@ -16,6 +17,8 @@ import (
 	"github.com/kubenext/lissio/pkg/navigation"
 	"github.com/kubenext/lissio/pkg/plugin"
 	"github.com/kubenext/lissio/pkg/plugin/service/fake"
+	"github.com/kubenext/lissio/pkg/store"
+	"github.com/kubenext/lissio/pkg/view/component"
 )
 
 func TestHandler_Register(t *testing.T) {
@@ -230,6 +233,36 @@ func TestHandler_ObjectStatus_using_supplied_function(t *testing.T) {
 	assert.True(t, ran)
 }
 
+func TestHandler_RelatedObjects_using_supplied_function(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashboardClient := fake.NewMockDashboard(controller)
+	pod := testutil.CreatePod("pod")
+
+	expected := plugin.RelatedObjectsResponse{
+		Objects: []store.Key{
+			{Namespace: pod.Namespace, APIVersion: "v1", Kind: "Secret", Name: "secret"},
+		},
+	}
+
+	h := Handler{
+		dashboardClient: dashboardClient,
+		HandlerFuncs: HandlerFuncs{
+			RelatedObjects: func(r *PrintRequest) (plugin.RelatedObjectsResponse, error) {
+				assert.Equal(t, dashboardClient, r.DashboardClient)
+				assert.Equal(t, pod, r.Object)
+				return expected, nil
+			},
+		},
+	}
+
+	ctx := context.Background()
+	got, err := h.RelatedObjects(ctx, pod)
+	require.NoError(t, err)
+	assert.Equal(t, expected, got)
+}
+
 func TestHandler_HandleAction_default(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
@@ -243,8 +276,9 @@ func TestHandler_HandleAction_default(t *testing.T) {
 	payload := action.Payload{"foo": "bar"}
 
 	ctx := context.Background()
-	err := h.HandleAction(ctx, payload)
+	got, err := h.HandleAction(ctx, payload)
 	require.NoError(t, err)
+	assert.Equal(t, plugin.ActionResponse{}, got)
 }
 
 func TestHandler_HandleAction_using_supplied_function(t *testing.T) {
@@ -265,15 +299,98 @@ func TestHandler_HandleAction_using_supplied_function(t *testing.T) {
 				assert.Equal(t, dashboardClient, r.DashboardClient)
 				assert.Equal(t, payload, r.Payload)
 
+				r.SendAlert(action.AlertTypeInfo, "deployed", 0)
+				r.SetResult(action.Payload{"revision": "2"})
+				r.Redirect("/plugin/deployments")
+
 				return nil
 			},
 		},
 	}
 
 	ctx := context.Background()
-	err := h.HandleAction(ctx, payload)
-	assert.NoError(t, err)
+	got, err := h.HandleAction(ctx, payload)
+	require.NoError(t, err)
 	assert.True(t, ran)
+
+	expected := plugin.ActionResponse{
+		Alerts:   []action.Alert{{Type: action.AlertTypeInfo, Message: "deployed"}},
+		Result:   action.Payload{"revision": "2"},
+		Redirect: "/plugin/deployments",
+	}
+	assert.Equal(t, expected, got)
+}
+
+func TestHandler_HandleAction_error(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	h := Handler{
+		dashboardClient: fake.NewMockDashboard(controller),
+		HandlerFuncs: HandlerFuncs{
+			HandleAction: func(r *ActionRequest) error {
+				r.SendAlert(action.AlertTypeInfo, "deployed", 0)
+				return errors.New("failed")
+			},
+		},
+	}
+
+	_, err := h.HandleAction(context.Background(), action.Payload{})
+	assert.Error(t, err)
+}
+
+func TestHandler_UpdateSettings(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashboardClient := fake.NewMockDashboard(controller)
+
+	settings := plugin.Settings{"url": "https://jira.example.com"}
+
+	ran := false
+
+	h := Handler{
+		dashboardClient: dashboardClient,
+		HandlerFuncs: HandlerFuncs{
+			UpdateSettings: func(r *SettingsRequest) error {
+				ran = true
+				assert.Equal(t, dashboardClient, r.DashboardClient)
+				assert.Equal(t, settings, r.Settings)
+				return nil
+			},
+		},
+	}
+
+	ctx := context.Background()
+	require.NoError(t, h.UpdateSettings(ctx, settings))
+	assert.True(t, ran)
+}
+
+func TestHandler_ListColumns(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashboardClient := fake.NewMockDashboard(controller)
+
+	objects := []runtime.Object{testutil.CreatePod("pod")}
+	rows := []component.TableRow{{"Cost": component.NewText("$1")}}
+
+	h := Handler{
+		dashboardClient: dashboardClient,
+		HandlerFuncs: HandlerFuncs{
+			ListColumns: func(r *ListColumnsRequest) (plugin.ListColumnsResponse, error) {
+				assert.Equal(t, dashboardClient, r.DashboardClient)
+				assert.Equal(t, objects, r.Objects)
+				return plugin.ListColumnsResponse{Rows: rows}, nil
+			},
+		},
+	}
+
+	ctx := context.Background()
+	got, err := h.ListColumns(ctx, objects)
+	require.NoError(t, err)
+
+	assert.Equal(t, plugin.ListColumnsResponse{Rows: rows}, got)
 }
 
 func TestHandler_Navigation_default(t *testing.T) {
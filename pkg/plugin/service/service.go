@@ -9,6 +9,7 @@ import (
 	"context"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -46,6 +47,20 @@ func WithObjectStatus(fn HandlerObjectStatusFunc) PluginOption {
 	}
 }
 
+// WithObjectVisitor configures the plugin to find objects related to an object.
+func WithObjectVisitor(fn HandlerRelatedObjectsFunc) PluginOption {
+	return func(p *Plugin) {
+		p.pluginHandler.HandlerFuncs.RelatedObjects = fn
+	}
+}
+
+// WithListColumns configures the plugin to add columns to object lists.
+func WithListColumns(fn HandlerListColumnsFunc) PluginOption {
+	return func(p *Plugin) {
+		p.pluginHandler.HandlerFuncs.ListColumns = fn
+	}
+}
+
 // WithActionHandler configures the plugin to handle actions.
 func WithActionHandler(fn HandlerActionFunc) PluginOption {
 	return func(p *Plugin) {
@@ -53,6 +68,15 @@ func WithActionHandler(fn HandlerActionFunc) PluginOption {
 	}
 }
 
+// WithSettings configures the plugin to have settings. The settings are
+// sent to the handler when the plugin starts and whenever a user changes them.
+func WithSettings(fields []plugin.SettingField, fn HandlerSettingsFunc) PluginOption {
+	return func(p *Plugin) {
+		p.pluginHandler.settingFields = fields
+		p.pluginHandler.HandlerFuncs.UpdateSettings = fn
+	}
+}
+
 // WithNavigation configures the plugin to handle navigation and routes.
 func WithNavigation(fn HandlerNavigationFunc, routerInit HandlerInitRoutesFunc) PluginOption {
 	return func(p *Plugin) {
@@ -129,6 +153,12 @@ func (p *Plugin) Serve() {
 	p.serverFactory(p.pluginHandler)
 }
 
+// Service returns the service Serve serves. It is used to run the plugin
+// without starting a plugin process, for example in tests.
+func (p *Plugin) Service() plugin.Service {
+	return p.pluginHandler
+}
+
 type baseRequest struct {
 	ctx        context.Context
 	pluginName string
@@ -157,12 +187,48 @@ type PrintRequest struct {
 	Object          runtime.Object
 }
 
+// ListColumnsRequest is a request for list column cells.
+type ListColumnsRequest struct {
+	baseRequest
+
+	DashboardClient Dashboard
+	// Objects are the listed objects.
+	Objects []runtime.Object
+}
+
 // ActionRequest is a request for actions.
 type ActionRequest struct {
 	baseRequest
 
 	DashboardClient Dashboard
 	Payload         action.Payload
+
+	response plugin.ActionResponse
+}
+
+// SendAlert sends an alert to the user who performed the action. Alerts
+// with an expiration less than one will not expire.
+func (r *ActionRequest) SendAlert(alertType action.AlertType, message string, expiration time.Duration) {
+	r.response.Alerts = append(r.response.Alerts, action.CreateAlert(alertType, message, expiration))
+}
+
+// SetResult sets the result of the action. The result is sent to the
+// client which performed the action.
+func (r *ActionRequest) SetResult(result action.Payload) {
+	r.response.Result = result
+}
+
+// Redirect redirects the client which performed the action to a content path.
+func (r *ActionRequest) Redirect(contentPath string) {
+	r.response.Redirect = contentPath
+}
+
+// SettingsRequest is a request to update settings.
+type SettingsRequest struct {
+	baseRequest
+
+	DashboardClient Dashboard
+	Settings        plugin.Settings
 }
 
 // NavigationRequest is a request for navigation.
@@ -175,16 +241,22 @@ type NavigationRequest struct {
 type HandlerPrinterFunc func(request *PrintRequest) (plugin.PrintResponse, error)
 type HandlerTabPrintFunc func(request *PrintRequest) (plugin.TabResponse, error)
 type HandlerObjectStatusFunc func(request *PrintRequest) (plugin.ObjectStatusResponse, error)
+type HandlerRelatedObjectsFunc func(request *PrintRequest) (plugin.RelatedObjectsResponse, error)
+type HandlerListColumnsFunc func(request *ListColumnsRequest) (plugin.ListColumnsResponse, error)
 type HandlerActionFunc func(request *ActionRequest) error
+type HandlerSettingsFunc func(request *SettingsRequest) error
 type HandlerNavigationFunc func(request *NavigationRequest) (navigation.Navigation, error)
 type HandlerInitRoutesFunc func(router *Router)
 
 // HandlerFuncs are functions for configuring a plugin.
 type HandlerFuncs struct {
-	Print        HandlerPrinterFunc
-	PrintTab     HandlerTabPrintFunc
-	ObjectStatus HandlerObjectStatusFunc
-	HandleAction HandlerActionFunc
-	Navigation   HandlerNavigationFunc
-	InitRoutes   HandlerInitRoutesFunc
+	Print          HandlerPrinterFunc
+	PrintTab       HandlerTabPrintFunc
+	ObjectStatus   HandlerObjectStatusFunc
+	RelatedObjects HandlerRelatedObjectsFunc
+	ListColumns    HandlerListColumnsFunc
+	HandleAction   HandlerActionFunc
+	UpdateSettings HandlerSettingsFunc
+	Navigation     HandlerNavigationFunc
+	InitRoutes     HandlerInitRoutesFunc
 }
@@ -0,0 +1,224 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+
+	"github.com/kubenext/lissio/internal/util/configdir"
+)
+
+// SettingType is the type of a plugin setting.
+type SettingType string
+
+const (
+	// SettingTypeString is a text setting.
+	SettingTypeString SettingType = "string"
+	// SettingTypeSecret is a text setting whose value isn't shown.
+	SettingTypeSecret SettingType = "secret"
+	// SettingTypeNumber is a numeric setting.
+	SettingTypeNumber SettingType = "number"
+	// SettingTypeBool is a true or false setting.
+	SettingTypeBool SettingType = "bool"
+)
+
+// SettingField describes a setting a plugin can be configured with.
+type SettingField struct {
+	// Name is the name of the setting.
+	Name string
+	// Label is shown to users when the setting is edited.
+	Label string
+	// Type is the type of the setting. Strings are assumed if it is blank.
+	Type SettingType
+	// Default is the value of the setting if the user hasn't set it.
+	Default string
+	// Description describes the setting.
+	Description string
+}
+
+// Settings are the values of a plugin's settings keyed by setting name.
+type Settings map[string]string
+
+// ResolveSettings returns the value for each field. Fields without a value
+// use their default and values without a field are dropped.
+func ResolveSettings(fields []SettingField, values Settings) Settings {
+	settings := make(Settings, len(fields))
+
+	for _, field := range fields {
+		value, ok := values[field.Name]
+		if !ok {
+			value = field.Default
+		}
+
+		settings[field.Name] = value
+	}
+
+	return settings
+}
+
+// ValidateSettings returns an error if a value doesn't match the type of its
+// field or there is no field for it.
+func ValidateSettings(fields []SettingField, values Settings) error {
+	types := make(map[string]SettingType, len(fields))
+	for _, field := range fields {
+		types[field.Name] = field.Type
+	}
+
+	for name, value := range values {
+		settingType, ok := types[name]
+		if !ok {
+			return errors.Errorf("unknown setting %q", name)
+		}
+
+		switch settingType {
+		case SettingTypeNumber:
+			if _, err := strconv.ParseFloat(value, 64); err != nil {
+				return errors.Errorf("setting %q requires a number", name)
+			}
+		case SettingTypeBool:
+			if _, err := strconv.ParseBool(value); err != nil {
+				return errors.Errorf("setting %q requires true or false", name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// SettingsStore persists plugin settings.
+type SettingsStore interface {
+	// Load loads the settings for a plugin. There are no settings if the
+	// plugin's settings were never saved.
+	Load(name string) (Settings, error)
+	// Save saves the settings for a plugin.
+	Save(name string, settings Settings) error
+}
+
+// FileSettingsStore stores plugin settings as JSON files in the Lissio
+// configuration directory.
+type FileSettingsStore struct {
+	config Config
+
+	mu sync.Mutex
+}
+
+var _ SettingsStore = (*FileSettingsStore)(nil)
+
+// NewFileSettingsStore creates an instance of FileSettingsStore.
+func NewFileSettingsStore(config Config) *FileSettingsStore {
+	return &FileSettingsStore{
+		config: config,
+	}
+}
+
+// Load loads the settings for a plugin.
+func (s *FileSettingsStore) Load(name string) (Settings, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	settingsPath, err := s.path(name)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := afero.ReadFile(s.config.Fs(), settingsPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return Settings{}, nil
+		}
+		return nil, errors.Wrapf(err, "read settings for plugin %q", name)
+	}
+
+	var settings Settings
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return nil, errors.Wrapf(err, "decode settings for plugin %q", name)
+	}
+
+	return settings, nil
+}
+
+// Save saves the settings for a plugin.
+func (s *FileSettingsStore) Save(name string, settings Settings) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	settingsPath, err := s.path(name)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(settings, "", "  ")
+	if err != nil {
+		return errors.Wrapf(err, "encode settings for plugin %q", name)
+	}
+
+	if err := s.config.Fs().MkdirAll(filepath.Dir(settingsPath), 0700); err != nil {
+		return errors.Wrap(err, "create plugin settings directory")
+	}
+
+	// Settings can contain secrets so only the user can read them.
+	if err := afero.WriteFile(s.config.Fs(), settingsPath, data, 0600); err != nil {
+		return errors.Wrapf(err, "write settings for plugin %q", name)
+	}
+
+	return nil
+}
+
+func (s *FileSettingsStore) path(name string) (string, error) {
+	home := s.config.Home()
+	if home == "" {
+		return "", errors.New("unable to find home directory for plugin settings")
+	}
+
+	return filepath.Join(configdir.Dir(home), "plugin-settings", name+".json"), nil
+}
+
+// memorySettingsStore keeps plugin settings in memory.
+type memorySettingsStore struct {
+	settings map[string]Settings
+
+	mu sync.Mutex
+}
+
+var _ SettingsStore = (*memorySettingsStore)(nil)
+
+func newMemorySettingsStore() *memorySettingsStore {
+	return &memorySettingsStore{
+		settings: make(map[string]Settings),
+	}
+}
+
+func (s *memorySettingsStore) Load(name string) (Settings, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	settings := make(Settings)
+	for key, value := range s.settings[name] {
+		settings[key] = value
+	}
+
+	return settings, nil
+}
+
+func (s *memorySettingsStore) Save(name string, settings Settings) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	saved := make(Settings)
+	for key, value := range settings {
+		saved[key] = value
+	}
+	s.settings[name] = saved
+
+	return nil
+}
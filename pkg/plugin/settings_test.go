@@ -0,0 +1,103 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubenext/lissio/internal/util/configdir"
+)
+
+var testSettingFields = []SettingField{
+	{Name: "url", Type: SettingTypeString, Default: "https://example.com"},
+	{Name: "token", Type: SettingTypeSecret},
+	{Name: "limit", Type: SettingTypeNumber, Default: "10"},
+	{Name: "verbose", Type: SettingTypeBool, Default: "false"},
+}
+
+func TestResolveSettings(t *testing.T) {
+	got := ResolveSettings(testSettingFields, Settings{
+		"url":     "https://jira.example.com",
+		"unknown": "value",
+	})
+
+	expected := Settings{
+		"url":     "https://jira.example.com",
+		"token":   "",
+		"limit":   "10",
+		"verbose": "false",
+	}
+	assert.Equal(t, expected, got)
+}
+
+func TestValidateSettings(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings Settings
+		isErr    bool
+	}{
+		{
+			name:     "valid",
+			settings: Settings{"url": "https://jira.example.com", "limit": "2.5", "verbose": "true"},
+		},
+		{
+			name:     "unknown setting",
+			settings: Settings{"unknown": "value"},
+			isErr:    true,
+		},
+		{
+			name:     "invalid number",
+			settings: Settings{"limit": "ten"},
+			isErr:    true,
+		},
+		{
+			name:     "invalid bool",
+			settings: Settings{"verbose": "yes please"},
+			isErr:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateSettings(testSettingFields, test.settings)
+			if test.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestFileSettingsStore(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	home := filepath.Join("/home", "user")
+
+	settingsStore := NewFileSettingsStore(&defaultConfig{
+		fs:     fs,
+		homeFn: func() string { return home },
+	})
+
+	got, err := settingsStore.Load("plugin1")
+	require.NoError(t, err)
+	assert.Empty(t, got)
+
+	settings := Settings{"url": "https://jira.example.com"}
+	require.NoError(t, settingsStore.Save("plugin1", settings))
+
+	got, err = settingsStore.Load("plugin1")
+	require.NoError(t, err)
+	assert.Equal(t, settings, got)
+
+	fi, err := fs.Stat(filepath.Join(configdir.Dir(home), "plugin-settings", "plugin1.json"))
+	require.NoError(t, err)
+	assert.Equal(t, "-rw-------", fi.Mode().String())
+}
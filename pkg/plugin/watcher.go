@@ -0,0 +1,238 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"context"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/kubenext/lissio/internal/log"
+)
+
+const (
+	// pluginDirSettleDelay is how long the watcher waits for changes to
+	// plugin directories to stop before it scans them, so binaries which
+	// are still being written aren't started.
+	pluginDirSettleDelay = 500 * time.Millisecond
+	// pluginDirRetryInterval is how often plugin directories which can't
+	// be watched are tried again. fsnotify can only watch directories
+	// which exist, and plugin directories are often created after the
+	// dashboard starts.
+	pluginDirRetryInterval = 30 * time.Second
+)
+
+// pluginFile is the state of a plugin binary on disk.
+type pluginFile struct {
+	modTime time.Time
+	size    int64
+}
+
+// scanPluginDirs returns the state of the plugin binaries in the plugin
+// directories keyed by path.
+func scanPluginDirs(config Config) (map[string]pluginFile, error) {
+	paths, err := AvailablePlugins(config)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string]pluginFile)
+
+	for _, pluginPath := range paths {
+		fi, err := config.Fs().Stat(pluginPath)
+		if err != nil {
+			// The binary was removed after the directory was read.
+			continue
+		}
+
+		files[pluginPath] = pluginFile{
+			modTime: fi.ModTime(),
+			size:    fi.Size(),
+		}
+	}
+
+	return files, nil
+}
+
+// diffPluginFiles compares two scans of the plugin directories. It returns
+// the paths of plugin binaries which were added, changed or removed.
+func diffPluginFiles(previous, current map[string]pluginFile) (added, changed, removed []string) {
+	for pluginPath, file := range current {
+		previousFile, ok := previous[pluginPath]
+		switch {
+		case !ok:
+			added = append(added, pluginPath)
+		case previousFile != file:
+			changed = append(changed, pluginPath)
+		}
+	}
+
+	for pluginPath := range previous {
+		if _, ok := current[pluginPath]; !ok {
+			removed = append(removed, pluginPath)
+		}
+	}
+
+	sort.Strings(added)
+	sort.Strings(changed)
+	sort.Strings(removed)
+
+	return added, changed, removed
+}
+
+// watchPluginDirs watches the plugin directories and syncs plugins with the
+// binaries in them when they change.
+func (m *Manager) watchPluginDirs(ctx context.Context, files map[string]pluginFile) {
+	logger := log.From(ctx)
+
+	fsWatcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		logger.WithErr(err).Errorf("create plugin directory watcher")
+		return
+	}
+	defer func() {
+		if err := fsWatcher.Close(); err != nil {
+			logger.WithErr(err).Errorf("close plugin directory watcher")
+		}
+	}()
+
+	watched := make(map[string]bool)
+	watchDirs := func() bool {
+		dirs, err := m.PluginConfig.PluginDirs()
+		if err != nil {
+			logger.WithErr(err).Errorf("get plugin directories")
+			return false
+		}
+
+		added := false
+		for _, dir := range dirs {
+			if watched[dir] {
+				continue
+			}
+
+			if err := fsWatcher.Add(dir); err != nil {
+				continue
+			}
+
+			watched[dir] = true
+			added = true
+		}
+
+		return added
+	}
+
+	rescan := func() {
+		current, err := scanPluginDirs(m.PluginConfig)
+		if err != nil {
+			logger.WithErr(err).Errorf("scan plugin directories")
+			return
+		}
+
+		m.syncPluginFiles(ctx, files, current)
+		files = current
+	}
+
+	// Binaries may have been added between the initial scan and the
+	// directories being watched.
+	if watchDirs() {
+		rescan()
+	}
+
+	retry := time.NewTicker(pluginDirRetryInterval)
+	defer retry.Stop()
+
+	var settle <-chan time.Time
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Infof("shutting down plugin directory watcher")
+			return
+		case event, ok := <-fsWatcher.Events:
+			if !ok {
+				return
+			}
+
+			if event.Op&fsnotify.Remove == fsnotify.Remove && watched[event.Name] {
+				// The directory is no longer watched. It is watched
+				// again if it is recreated.
+				delete(watched, event.Name)
+			}
+
+			settle = time.After(pluginDirSettleDelay)
+		case err, ok := <-fsWatcher.Errors:
+			if !ok {
+				return
+			}
+
+			logger.WithErr(err).Errorf("watch plugin directories")
+		case <-settle:
+			settle = nil
+			rescan()
+		case <-retry.C:
+			if watchDirs() {
+				rescan()
+			}
+		}
+	}
+}
+
+// syncPluginFiles loads added plugins, restarts changed plugins and unloads
+// removed plugins. Plugins are started without holding the lock since
+// starting a plugin waits for its binary to run and register.
+func (m *Manager) syncPluginFiles(ctx context.Context, previous, current map[string]pluginFile) {
+	added, changed, removed := diffPluginFiles(previous, current)
+
+	var starts []config
+
+	m.lock.Lock()
+
+	for _, pluginPath := range removed {
+		name := filepath.Base(pluginPath)
+		if c, ok := m.config(name); !ok || c.cmd != pluginPath {
+			continue
+		}
+
+		log.From(ctx).With("plugin-name", name).Infof("plugin was removed; unloading")
+		m.unload(ctx, name)
+	}
+
+	for _, pluginPath := range changed {
+		name := filepath.Base(pluginPath)
+		c, ok := m.config(name)
+		if !ok || c.cmd != pluginPath || m.disabled[name] {
+			continue
+		}
+
+		log.From(ctx).With("plugin-name", name).Infof("plugin changed; restarting")
+		m.stop(ctx, name)
+		m.health(name).reset()
+		starts = append(starts, c)
+	}
+
+	for _, pluginPath := range added {
+		name := filepath.Base(pluginPath)
+		if err := m.load(pluginPath); err != nil {
+			log.From(ctx).WithErr(err).With("plugin-name", name).Errorf("load plugin")
+			continue
+		}
+
+		log.From(ctx).With("plugin-name", name).Infof("plugin was added; starting")
+		c, _ := m.config(name)
+		starts = append(starts, c)
+	}
+
+	m.lock.Unlock()
+
+	for _, c := range starts {
+		if err := m.startUnlocked(ctx, c); err != nil {
+			log.From(ctx).WithErr(err).With("plugin-name", c.name).Errorf("start plugin")
+		}
+	}
+}
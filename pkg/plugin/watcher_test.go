@@ -0,0 +1,65 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_scanPluginDirs(t *testing.T) {
+	os.Unsetenv("LISSIO_PLUGIN_PATH")
+	os.Unsetenv("XDG_CONFIG_HOME")
+
+	fs := afero.NewMemMapFs()
+	c := &defaultConfig{
+		fs: fs,
+		homeFn: func() string {
+			return "/home/user"
+		},
+	}
+
+	dir := filepath.Join("/home/user", ".config", "lissio", "plugins")
+	require.NoError(t, afero.WriteFile(fs, filepath.Join(dir, "a-plugin"), []byte("guts"), 0755))
+	require.NoError(t, afero.WriteFile(fs, filepath.Join(dir, "not-a-plugin"), []byte("guts"), 0600))
+
+	modTime := time.Unix(1561939200, 0)
+	require.NoError(t, fs.Chtimes(filepath.Join(dir, "a-plugin"), modTime, modTime))
+
+	got, err := scanPluginDirs(c)
+	require.NoError(t, err)
+
+	expected := map[string]pluginFile{
+		filepath.Join(dir, "a-plugin"): {modTime: modTime, size: 4},
+	}
+	assert.Equal(t, expected, got)
+}
+
+func Test_diffPluginFiles(t *testing.T) {
+	now := time.Now()
+
+	previous := map[string]pluginFile{
+		"/plugins/unchanged": {modTime: now, size: 1},
+		"/plugins/changed":   {modTime: now, size: 1},
+		"/plugins/removed":   {modTime: now, size: 1},
+	}
+	current := map[string]pluginFile{
+		"/plugins/unchanged": {modTime: now, size: 1},
+		"/plugins/changed":   {modTime: now.Add(time.Second), size: 2},
+		"/plugins/added":     {modTime: now, size: 1},
+	}
+
+	added, changed, removed := diffPluginFiles(previous, current)
+	assert.Equal(t, []string{"/plugins/added"}, added)
+	assert.Equal(t, []string{"/plugins/changed"}, changed)
+	assert.Equal(t, []string{"/plugins/removed"}, removed)
+}
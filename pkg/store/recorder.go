@@ -0,0 +1,67 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package store
+
+import (
+	"context"
+	"sync"
+)
+
+type keyRecorderContextKey struct{}
+
+// KeyRecorder records the keys read from a store. It is used to find out
+// which objects generated content depends on.
+type KeyRecorder struct {
+	keys []Key
+	seen map[string]bool
+
+	mu sync.Mutex
+}
+
+// WithKeyRecorder returns a context which records the keys stores read
+// with it.
+func WithKeyRecorder(ctx context.Context) (context.Context, *KeyRecorder) {
+	recorder := &KeyRecorder{
+		seen: make(map[string]bool),
+	}
+
+	return context.WithValue(ctx, keyRecorderContextKey{}, recorder), recorder
+}
+
+// RecordKey records a key if the context has a KeyRecorder. Stores call
+// it when they read objects.
+func RecordKey(ctx context.Context, key Key) {
+	recorder, ok := ctx.Value(keyRecorderContextKey{}).(*KeyRecorder)
+	if !ok {
+		return
+	}
+
+	recorder.record(key)
+}
+
+func (r *KeyRecorder) record(key Key) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	s := key.String()
+	if r.seen[s] {
+		return
+	}
+
+	r.seen[s] = true
+	r.keys = append(r.keys, key)
+}
+
+// Keys returns the recorded keys in the order they were first read.
+func (r *KeyRecorder) Keys() []Key {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	keys := make([]Key, len(r.keys))
+	copy(keys, r.keys)
+
+	return keys
+}
@@ -0,0 +1,28 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyRecorder(t *testing.T) {
+	podKey := Key{Namespace: "default", APIVersion: "v1", Kind: "Pod"}
+	serviceKey := Key{Namespace: "default", APIVersion: "v1", Kind: "Service", Name: "service"}
+
+	// Recording without a recorder does nothing.
+	RecordKey(context.Background(), podKey)
+
+	ctx, recorder := WithKeyRecorder(context.Background())
+	RecordKey(ctx, podKey)
+	RecordKey(ctx, serviceKey)
+	RecordKey(ctx, podKey)
+
+	assert.Equal(t, []Key{podKey, serviceKey}, recorder.Keys())
+}
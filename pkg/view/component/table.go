@@ -27,6 +27,7 @@ type TableConfig struct {
 	EmptyContent string                 `json:"emptyContent"`
 	Loading      bool                   `json:"loading"`
 	Filters      map[string]TableFilter `json:"filters"`
+	Pagination   *TablePagination       `json:"pagination,omitempty"`
 }
 
 // TableCol describes a column from a table. Accessor is the key this
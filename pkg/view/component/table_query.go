@@ -0,0 +1,120 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+const (
+	// DefaultTablePageSize is the page size for table queries which don't
+	// set one.
+	DefaultTablePageSize = 10
+	// MaxTablePageSize is the largest page size for table queries.
+	MaxTablePageSize = 500
+)
+
+// TableQuery selects the rows a paginated table shows. Pages start at 1.
+type TableQuery struct {
+	Page     int    `json:"page"`
+	PageSize int    `json:"pageSize"`
+	SortBy   string `json:"sortBy,omitempty"`
+	Reverse  bool   `json:"reverse,omitempty"`
+	Filter   string `json:"filter,omitempty"`
+}
+
+// Normalize returns the query with defaults for unset values. Pages past
+// the last page of total rows are moved to the last page.
+func (q TableQuery) Normalize(total int) TableQuery {
+	if q.PageSize < 1 {
+		q.PageSize = DefaultTablePageSize
+	}
+	if q.PageSize > MaxTablePageSize {
+		q.PageSize = MaxTablePageSize
+	}
+
+	lastPage := (total + q.PageSize - 1) / q.PageSize
+	if lastPage < 1 {
+		lastPage = 1
+	}
+
+	if q.Page < 1 {
+		q.Page = 1
+	}
+	if q.Page > lastPage {
+		q.Page = lastPage
+	}
+
+	return q
+}
+
+// Bounds returns the start and end indexes of the query's page in total
+// rows.
+func (q TableQuery) Bounds(total int) (int, int) {
+	q = q.Normalize(total)
+
+	start := (q.Page - 1) * q.PageSize
+	end := start + q.PageSize
+	if end > total {
+		end = total
+	}
+
+	return start, end
+}
+
+// TablePagination describes the page of rows a table contains. Name is
+// the name clients use to change the table's query.
+type TablePagination struct {
+	Name  string     `json:"name"`
+	Query TableQuery `json:"query"`
+	Total int        `json:"total"`
+}
+
+// SetPagination sets the table's pagination. The table's rows should
+// already be the page of rows described by the pagination.
+func (t *Table) SetPagination(pagination TablePagination) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.Config.Pagination = &pagination
+}
+
+// Paginate sorts the table's rows and replaces them with the page of rows
+// selected by query. The query's filter isn't applied to rows.
+func (t *Table) Paginate(name string, query TableQuery) {
+	t.SortRows(query.SortBy, query.Reverse)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	total := len(t.Config.Rows)
+	start, end := query.Bounds(total)
+	t.Config.Rows = t.Config.Rows[start:end]
+
+	t.Config.Pagination = &TablePagination{
+		Name:  name,
+		Query: query.Normalize(total),
+		Total: total,
+	}
+}
+
+// SortRows sorts the table's rows by a column if the table has the column.
+func (t *Table) SortRows(name string, reverse bool) {
+	if name == "" || !t.hasColumn(name) {
+		return
+	}
+
+	t.Sort(name, reverse)
+}
+
+func (t *Table) hasColumn(name string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, col := range t.Config.Columns {
+		if col.Accessor == name {
+			return true
+		}
+	}
+
+	return false
+}
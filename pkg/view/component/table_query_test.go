@@ -0,0 +1,101 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTableQuery_Normalize(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    TableQuery
+		total    int
+		expected TableQuery
+	}{
+		{
+			name:     "defaults",
+			total:    30,
+			expected: TableQuery{Page: 1, PageSize: DefaultTablePageSize},
+		},
+		{
+			name:     "page in range",
+			query:    TableQuery{Page: 3, PageSize: 10, SortBy: "Name"},
+			total:    30,
+			expected: TableQuery{Page: 3, PageSize: 10, SortBy: "Name"},
+		},
+		{
+			name:     "page past the end",
+			query:    TableQuery{Page: 4, PageSize: 10},
+			total:    30,
+			expected: TableQuery{Page: 3, PageSize: 10},
+		},
+		{
+			name:     "no rows",
+			query:    TableQuery{Page: 2, PageSize: 10},
+			expected: TableQuery{Page: 1, PageSize: 10},
+		},
+		{
+			name:     "page size too large",
+			query:    TableQuery{Page: 1, PageSize: 10000},
+			total:    30,
+			expected: TableQuery{Page: 1, PageSize: MaxTablePageSize},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.query.Normalize(test.total))
+		})
+	}
+}
+
+func TestTableQuery_Bounds(t *testing.T) {
+	query := TableQuery{Page: 3, PageSize: 10}
+
+	start, end := query.Bounds(25)
+	assert.Equal(t, 20, start)
+	assert.Equal(t, 25, end)
+
+	start, end = query.Bounds(0)
+	assert.Equal(t, 0, start)
+	assert.Equal(t, 0, end)
+}
+
+func TestTable_Paginate(t *testing.T) {
+	table := NewTable("table", "placeholder", NewTableCols("a"))
+	for i := 0; i < 5; i++ {
+		table.Add(TableRow{"a": NewText(fmt.Sprintf("%d", i))})
+	}
+
+	table.Paginate("table", TableQuery{Page: 2, PageSize: 2, SortBy: "a", Reverse: true})
+
+	expected := []TableRow{
+		{"a": NewText("2")},
+		{"a": NewText("1")},
+	}
+	assert.Equal(t, expected, table.Rows())
+	assert.Equal(t, &TablePagination{
+		Name:  "table",
+		Query: TableQuery{Page: 2, PageSize: 2, SortBy: "a", Reverse: true},
+		Total: 5,
+	}, table.Config.Pagination)
+}
+
+func TestTable_SortRows_unknownColumn(t *testing.T) {
+	rows := []TableRow{
+		{"a": NewText("2")},
+		{"a": NewText("1")},
+	}
+	table := NewTableWithRows("table", "placeholder", NewTableCols("a"), rows)
+
+	table.SortRows("b", false)
+
+	assert.Equal(t, rows, table.Rows())
+}
@@ -243,9 +243,24 @@ export interface TableView extends View {
     emptyContent: string;
     loading: boolean;
     filters: TableFilters;
+    pagination?: TablePagination;
   };
 }
 
+export interface TableQuery {
+  page: number;
+  pageSize: number;
+  sortBy?: string;
+  reverse?: boolean;
+  filter?: string;
+}
+
+export interface TablePagination {
+  name: string;
+  query: TableQuery;
+  total: number;
+}
+
 export interface TableFilters {
   [key: string]: TableFilter;
 }
@@ -5,7 +5,9 @@
 import { async, ComponentFixture, TestBed } from '@angular/core/testing';
 
 import { OverviewModule } from '../../overview.module';
-import { DatagridComponent } from './datagrid.component';
+import { DatagridComponent, SetTableQueryMessage } from './datagrid.component';
+import { WebsocketService } from '../../services/websocket/websocket.service';
+import { WebsocketServiceMock } from '../../services/websocket/mock';
 
 describe('DatagridComponent', () => {
   let component: DatagridComponent;
@@ -14,6 +16,7 @@ describe('DatagridComponent', () => {
   beforeEach(async(() => {
     TestBed.configureTestingModule({
       imports: [OverviewModule],
+      providers: [{ provide: WebsocketService, useClass: WebsocketServiceMock }],
     }).compileComponents();
   }));
 
@@ -26,4 +29,41 @@ describe('DatagridComponent', () => {
   it('should create', () => {
     expect(component).toBeTruthy();
   });
+
+  describe('with server pagination', () => {
+    let websocketService: WebsocketService;
+
+    beforeEach(() => {
+      websocketService = TestBed.get(WebsocketService);
+      spyOn(websocketService, 'sendMessage');
+
+      component.pagination = {
+        name: 'Pods',
+        query: { page: 1, pageSize: 10 },
+        total: 30,
+      };
+    });
+
+    it('sends a query when the page changes', () => {
+      component.refresh({ page: { current: 2, size: 10 } });
+      expect(websocketService.sendMessage).toHaveBeenCalledWith(
+        SetTableQueryMessage,
+        { table: 'Pods', page: 2, pageSize: 10 }
+      );
+    });
+
+    it('does not send a query when nothing changed', () => {
+      component.refresh({ page: { current: 1, size: 10 } });
+      expect(websocketService.sendMessage).not.toHaveBeenCalled();
+    });
+
+    it('returns to the first page when the filter changes', () => {
+      component.pagination.query.page = 3;
+      component.filterChanged('web');
+      expect(websocketService.sendMessage).toHaveBeenCalledWith(
+        SetTableQueryMessage,
+        { table: 'Pods', page: 1, pageSize: 10, filter: 'web' }
+      );
+    });
+  });
 });
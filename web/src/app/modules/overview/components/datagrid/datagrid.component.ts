@@ -3,10 +3,20 @@
 //
 
 import { Component, Input, OnChanges, SimpleChanges } from '@angular/core';
-import { TableFilters, TableRow, TableView } from 'src/app/models/content';
+import { ClrDatagridStateInterface } from '@clr/angular';
+import {
+  TableFilters,
+  TablePagination,
+  TableQuery,
+  TableRow,
+  TableView,
+} from 'src/app/models/content';
 import trackByIndex from 'src/app/util/trackBy/trackByIndex';
 import trackByIdentity from 'src/app/util/trackBy/trackByIdentity';
 import { ViewService } from '../../services/view/view.service';
+import { WebsocketService } from '../../services/websocket/websocket.service';
+
+export const SetTableQueryMessage = 'setTableQuery';
 
 @Component({
   selector: 'app-view-datagrid',
@@ -22,12 +32,17 @@ export class DatagridComponent implements OnChanges {
   placeholder: string;
   lastUpdated: Date;
   filters: TableFilters;
+  pagination: TablePagination;
+  filterText = '';
 
   identifyRow = trackByIndex;
   identifyColumn = trackByIdentity;
   loading: boolean;
 
-  constructor(private viewService: ViewService) {}
+  constructor(
+    private viewService: ViewService,
+    private websocketService: WebsocketService
+  ) {}
 
   ngOnChanges(changes: SimpleChanges): void {
     if (changes.view) {
@@ -40,10 +55,70 @@ export class DatagridComponent implements OnChanges {
       this.lastUpdated = new Date();
       this.loading = current.config.loading;
       this.filters = current.config.filters;
+      this.pagination = current.config.pagination;
+      if (this.pagination) {
+        this.filterText = this.pagination.query.filter || '';
+      }
     }
   }
 
   hasFilter(columnName: string): boolean {
     return !!this.view.config.filters[columnName];
   }
+
+  /**
+   * Asks the server for the rows matching the datagrid's state. Only
+   * tables paginated by the server are refreshed.
+   */
+  refresh(state: ClrDatagridStateInterface) {
+    if (!this.pagination) {
+      return;
+    }
+
+    const query: TableQuery = { ...this.pagination.query };
+    if (state.page) {
+      query.page = state.page.current || query.page;
+      query.pageSize = state.page.size || query.pageSize;
+    }
+    if (state.sort && typeof state.sort.by === 'string') {
+      query.sortBy = state.sort.by;
+      query.reverse = state.sort.reverse;
+    }
+
+    this.setQuery(query);
+  }
+
+  filterChanged(filter: string) {
+    if (!this.pagination) {
+      return;
+    }
+
+    this.setQuery({ ...this.pagination.query, page: 1, filter });
+  }
+
+  sortOrder(columnName: string): number {
+    if (!this.pagination || this.pagination.query.sortBy !== columnName) {
+      return 0;
+    }
+    return this.pagination.query.reverse ? -1 : 1;
+  }
+
+  private setQuery(query: TableQuery) {
+    const current = this.pagination.query;
+    if (
+      query.page === current.page &&
+      query.pageSize === current.pageSize &&
+      (query.sortBy || '') === (current.sortBy || '') &&
+      !!query.reverse === !!current.reverse &&
+      (query.filter || '') === (current.filter || '')
+    ) {
+      return;
+    }
+
+    this.pagination = { ...this.pagination, query };
+    this.websocketService.sendMessage(SetTableQueryMessage, {
+      table: this.pagination.name,
+      ...query,
+    });
+  }
 }
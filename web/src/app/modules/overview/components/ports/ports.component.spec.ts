@@ -3,6 +3,8 @@
 //
 
 import { async, ComponentFixture, TestBed } from '@angular/core/testing';
+import { FormsModule } from '@angular/forms';
+import { ClarityModule } from '@clr/angular';
 
 import { PortsComponent } from './ports.component';
 
@@ -12,6 +14,7 @@ describe('PortsComponent', () => {
 
   beforeEach(async(() => {
     TestBed.configureTestingModule({
+      imports: [FormsModule, ClarityModule],
       declarations: [PortsComponent],
     }).compileComponents();
   }));
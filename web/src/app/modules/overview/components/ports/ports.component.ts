@@ -18,7 +18,10 @@ import {
   NotifierSession,
   NotifierSignalType,
 } from 'src/app/services/notifier/notifier.service';
-import { PortForwardService } from 'src/app/services/port-forward/port-forward.service';
+import {
+  PortForwardOptions,
+  PortForwardService,
+} from 'src/app/services/port-forward/port-forward.service';
 
 @Component({
   selector: 'app-ports',
@@ -29,6 +32,9 @@ export class PortsComponent implements OnChanges, OnDestroy {
   private notifierSession: NotifierSession;
   private submittedPFCreation: string;
   private submittedPFRemoval: string;
+  private configuringPort: Port;
+
+  options: PortForwardOptions = {};
 
   @Input() view: PortsView;
   @Output() portLoad: EventEmitter<boolean> = new EventEmitter(true);
@@ -68,11 +74,30 @@ export class PortsComponent implements OnChanges, OnDestroy {
     return item.config.name;
   }
 
+  isConfiguring(port: Port) {
+    return (
+      this.configuringPort &&
+      this.configuringPort.config.name === port.config.name &&
+      this.configuringPort.config.port === port.config.port
+    );
+  }
+
+  configurePortForward(port: Port) {
+    this.configuringPort = port;
+    this.options = {};
+  }
+
+  cancelPortForward() {
+    this.configuringPort = undefined;
+    this.options = {};
+  }
+
   startPortForward(port: Port) {
     this.portLoad.emit(true);
     this.submittedPFCreation = port.config.name;
 
-    this.portForwardService.create(port);
+    this.portForwardService.create(port, this.options);
+    this.cancelPortForward();
   }
 
   removePortForward(port: Port) {
@@ -17,6 +17,7 @@ import { BehaviorSubject, combineLatest } from 'rxjs';
 import { ContentService } from './services/content/content.service';
 import { WebsocketService } from './services/websocket/websocket.service';
 import { KubeContextService } from './services/kube-context/kube-context.service';
+import { LinkService } from './services/link/link.service';
 import { take } from 'rxjs/operators';
 import _ from 'lodash';
 
@@ -60,7 +61,8 @@ export class OverviewComponent implements OnInit, OnDestroy {
     private viewService: ViewService,
     private contentService: ContentService,
     private websocketService: WebsocketService,
-    private kubeContextService: KubeContextService
+    private kubeContextService: KubeContextService,
+    private linkService: LinkService
   ) {
     this.contentService.current.subscribe(contentResponse => {
       this.setContent(contentResponse);
@@ -80,6 +82,11 @@ export class OverviewComponent implements OnInit, OnDestroy {
     });
   }
 
+  copyLink() {
+    // The selected tab is kept in the fragment.
+    this.linkService.copyLink(this.route.snapshot.fragment);
+  }
+
   ngOnDestroy() {
     this.resetView();
   }
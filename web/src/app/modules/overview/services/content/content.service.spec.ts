@@ -6,6 +6,7 @@
 import { TestBed } from '@angular/core/testing';
 
 import {
+  ContentPatchMessage,
   ContentService,
   ContentUpdate,
   ContentUpdateMessage,
@@ -68,6 +69,27 @@ describe('ContentService', () => {
         expect(current).toEqual({ content: update.content })
       );
     });
+
+    describe('content patch', () => {
+      beforeEach(() => {
+        const backendService = TestBed.get(WebsocketService);
+        backendService.triggerHandler(ContentPatchMessage, {
+          patch: [
+            {
+              op: 'add',
+              path: '/content/title/0',
+              value: { metadata: { type: 'text' }, config: { value: 'Pods' } },
+            },
+          ],
+        });
+      });
+
+      it('patches the last content', () => {
+        service.current.subscribe(current =>
+          expect(current.content.title.length).toEqual(1)
+        );
+      });
+    });
   });
 
   describe('label filters updated', () => {
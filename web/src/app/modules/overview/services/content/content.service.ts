@@ -8,8 +8,10 @@ import {
   LabelFilterService,
 } from '../../../../services/label-filter/label-filter.service';
 import { NamespaceService } from '../../../../services/namespace/namespace.service';
+import { applyPatch, JSONPatchOperation } from './json-patch';
 
 export const ContentUpdateMessage = 'content';
+export const ContentPatchMessage = 'contentPatch';
 
 export interface ContentUpdate {
   content: Content;
@@ -18,6 +20,10 @@ export interface ContentUpdate {
   queryParams: { [key: string]: string[] };
 }
 
+export interface ContentPatch {
+  patch: JSONPatchOperation[];
+}
+
 const emptyContentResponse: ContentResponse = {
   content: { viewComponents: [], title: [] },
 };
@@ -30,6 +36,7 @@ export class ContentService {
   current = new BehaviorSubject<ContentResponse>(emptyContentResponse);
 
   private previousContentPath = '';
+  private lastUpdate: ContentUpdate;
 
   private filters: Filter[] = [];
   get currentFilters(): Filter[] {
@@ -43,21 +50,20 @@ export class ContentService {
     private namespaceService: NamespaceService
   ) {
     websocketService.registerHandler(ContentUpdateMessage, data => {
-      const response = data as ContentUpdate;
-      this.setContent(response.content);
-      namespaceService.setNamespace(response.namespace);
-
-      if (response.contentPath) {
-        if (this.previousContentPath.length > 0) {
-          if (response.contentPath !== this.previousContentPath) {
-            const segments = response.contentPath.split('/');
-            this.router.navigate(segments, {
-              queryParams: response.queryParams,
-            });
-          }
-        }
+      this.handleUpdate(data as ContentUpdate);
+    });
 
-        this.previousContentPath = response.contentPath;
+    websocketService.registerHandler(ContentPatchMessage, data => {
+      if (!this.lastUpdate) {
+        console.error('received a content patch before content');
+        return;
+      }
+
+      const response = data as ContentPatch;
+      try {
+        this.handleUpdate(applyPatch(this.lastUpdate, response.patch));
+      } catch (err) {
+        console.error('unable to apply content patch', err);
       }
     });
 
@@ -75,6 +81,25 @@ export class ContentService {
     this.websocketService.sendMessage('setContentPath', payload);
   }
 
+  private handleUpdate(response: ContentUpdate) {
+    this.lastUpdate = response;
+    this.setContent(response.content);
+    this.namespaceService.setNamespace(response.namespace);
+
+    if (response.contentPath) {
+      if (this.previousContentPath.length > 0) {
+        if (response.contentPath !== this.previousContentPath) {
+          const segments = response.contentPath.split('/');
+          this.router.navigate(segments, {
+            queryParams: response.queryParams,
+          });
+        }
+      }
+
+      this.previousContentPath = response.contentPath;
+    }
+  }
+
   private setContent(content: Content) {
     const contentResponse: ContentResponse = {
       content,
@@ -0,0 +1,46 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+import { applyPatch } from './json-patch';
+
+describe('applyPatch', () => {
+  it('updates object fields', () => {
+    const document = { a: 1, b: { c: 'd' }, e: true };
+    const patched = applyPatch(document, [
+      { op: 'remove', path: '/e' },
+      { op: 'replace', path: '/a', value: 2 },
+      { op: 'replace', path: '/b/c', value: 'f' },
+      { op: 'add', path: '/g', value: null },
+    ]);
+
+    expect(patched).toEqual({ a: 2, b: { c: 'f' }, g: null } as any);
+    expect(document).toEqual({ a: 1, b: { c: 'd' }, e: true });
+  });
+
+  it('updates arrays', () => {
+    const patched = applyPatch({ rows: [1, 2, 3, 4] }, [
+      { op: 'remove', path: '/rows/2' },
+      { op: 'remove', path: '/rows/1' },
+      { op: 'add', path: '/rows/1', value: 5 },
+    ]);
+
+    expect(patched).toEqual({ rows: [1, 5, 4] });
+  });
+
+  it('unescapes paths', () => {
+    const patched = applyPatch({ 'a/b': 1, 'c~d': 1 }, [
+      { op: 'replace', path: '/a~1b', value: 2 },
+      { op: 'replace', path: '/c~0d', value: 2 },
+    ]);
+
+    expect(patched).toEqual({ 'a/b': 2, 'c~d': 2 });
+  });
+
+  it('throws when a path does not exist', () => {
+    expect(() =>
+      applyPatch({ a: {} }, [{ op: 'replace', path: '/b/c', value: 1 }])
+    ).toThrowError();
+  });
+});
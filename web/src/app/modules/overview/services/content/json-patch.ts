@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+export interface JSONPatchOperation {
+  op: 'add' | 'remove' | 'replace';
+  path: string;
+  value?: any;
+}
+
+/**
+ * Applies an RFC 6902 JSON patch to a copy of a document. Only the
+ * operations the server creates (add, remove and replace) are supported.
+ */
+export function applyPatch<T>(document: T, patch: JSONPatchOperation[]): T {
+  let result: any = JSON.parse(JSON.stringify(document));
+
+  patch.forEach(operation => {
+    const tokens = parsePointer(operation.path);
+    if (tokens.length === 0) {
+      if (operation.op === 'remove') {
+        throw new Error('unable to remove the document root');
+      }
+      result = operation.value;
+      return;
+    }
+
+    const parent = tokens
+      .slice(0, -1)
+      .reduce((current, token) => child(current, token), result);
+    const last = tokens[tokens.length - 1];
+
+    if (Array.isArray(parent)) {
+      const index = last === '-' ? parent.length : arrayIndex(parent, last);
+      switch (operation.op) {
+        case 'add':
+          parent.splice(index, 0, operation.value);
+          break;
+        case 'remove':
+          parent.splice(index, 1);
+          break;
+        case 'replace':
+          parent[index] = operation.value;
+          break;
+        default:
+          throw new Error(`unsupported patch operation ${operation.op}`);
+      }
+      return;
+    }
+
+    if (parent === null || typeof parent !== 'object') {
+      throw new Error(`patch path ${operation.path} was not found`);
+    }
+
+    switch (operation.op) {
+      case 'add':
+      case 'replace':
+        parent[last] = operation.value;
+        break;
+      case 'remove':
+        delete parent[last];
+        break;
+      default:
+        throw new Error(`unsupported patch operation ${operation.op}`);
+    }
+  });
+
+  return result;
+}
+
+function parsePointer(path: string): string[] {
+  if (path === '') {
+    return [];
+  }
+
+  return path
+    .split('/')
+    .slice(1)
+    .map(token => token.replace(/~1/g, '/').replace(/~0/g, '~'));
+}
+
+function child(current: any, token: string): any {
+  if (Array.isArray(current)) {
+    return current[arrayIndex(current, token)];
+  }
+
+  if (current === null || typeof current !== 'object' || !(token in current)) {
+    throw new Error(`patch path token ${token} was not found`);
+  }
+
+  return current[token];
+}
+
+function arrayIndex(array: any[], token: string): number {
+  const index = Number(token);
+  if (!Number.isInteger(index) || index < 0 || index > array.length) {
+    throw new Error(`invalid array index ${token}`);
+  }
+  return index;
+}
@@ -0,0 +1,60 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+import { inject, TestBed } from '@angular/core/testing';
+
+import { CopyLinkMessage, LinkService } from './link.service';
+import { WebsocketService } from '../websocket/websocket.service';
+import { WebsocketServiceMock } from '../websocket/mock';
+
+describe('LinkService', () => {
+  beforeEach(() =>
+    TestBed.configureTestingModule({
+      providers: [
+        LinkService,
+        {
+          provide: WebsocketService,
+          useClass: WebsocketServiceMock,
+        },
+      ],
+    })
+  );
+
+  it('should be created', () => {
+    const service: LinkService = TestBed.get(LinkService);
+    expect(service).toBeTruthy();
+  });
+
+  describe('copy link', () => {
+    it('sends the selected tab', inject(
+      [LinkService, WebsocketService],
+      (svc: LinkService, backendService: WebsocketServiceMock) => {
+        spyOn(backendService, 'sendMessage');
+        svc.copyLink('yaml');
+        expect(backendService.sendMessage).toHaveBeenCalledWith(
+          CopyLinkMessage,
+          { tab: 'yaml' }
+        );
+      }
+    ));
+  });
+
+  describe('link update', () => {
+    it('copies the absolute link', inject(
+      [LinkService, WebsocketService],
+      (svc: LinkService, backendService: WebsocketServiceMock) => {
+        const writeText = spyOn(navigator.clipboard, 'writeText').and.returnValue(
+          Promise.resolve()
+        );
+        backendService.triggerHandler('link', {
+          link: '#/overview/namespace/default',
+        });
+        expect(writeText).toHaveBeenCalledWith(
+          svc.absoluteLink('#/overview/namespace/default')
+        );
+      }
+    ));
+  });
+});
@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+import { Injectable } from '@angular/core';
+import { WebsocketService } from '../websocket/websocket.service';
+import {
+  NotifierService,
+  NotifierSession,
+  NotifierSignalType,
+} from '../../../../services/notifier/notifier.service';
+
+export const CopyLinkMessage = 'copyLink';
+export const LinkUpdateMessage = 'link';
+
+const signalDuration = 3000;
+
+interface LinkUpdate {
+  link: string;
+}
+
+@Injectable({
+  providedIn: 'root',
+})
+export class LinkService {
+  private notifierSession: NotifierSession;
+
+  constructor(
+    private websocketService: WebsocketService,
+    notifierService: NotifierService
+  ) {
+    this.notifierSession = notifierService.createSession();
+
+    websocketService.registerHandler(LinkUpdateMessage, data => {
+      const update = data as LinkUpdate;
+      this.copy(this.absoluteLink(update.link));
+    });
+  }
+
+  /**
+   * Asks the server for a link to the current view. The server doesn't
+   * know which tab is selected, so it is sent with the request.
+   */
+  copyLink(tab?: string) {
+    this.websocketService.sendMessage(CopyLinkMessage, { tab });
+  }
+
+  absoluteLink(link: string): string {
+    const loc = window.location;
+    return loc.origin + loc.pathname + link;
+  }
+
+  private copy(link: string) {
+    navigator.clipboard.writeText(link).then(
+      () => this.notify(NotifierSignalType.INFO, 'Copied link to clipboard'),
+      () => this.notify(NotifierSignalType.ERROR, `Unable to copy ${link}`)
+    );
+  }
+
+  private notify(type: NotifierSignalType, message: string) {
+    const id = this.notifierSession.pushSignal(type, message);
+    setTimeout(() => this.notifierSession.removeSignal(id), signalDuration);
+  }
+}
@@ -122,6 +122,8 @@ export class WebsocketService implements BackendService {
     }
     newURI += '//' + loc.host;
     newURI += loc.pathname + 'api/v1/stream';
+    // The server shows the view in the link when the client connects.
+    newURI += '?link=' + encodeURIComponent(loc.href);
     return newURI;
   }
 
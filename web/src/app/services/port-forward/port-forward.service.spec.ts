@@ -60,6 +60,46 @@ describe('PortForwardService', () => {
     });
   });
 
+  describe('create port forward with options', () => {
+    let websocketService: WebsocketService;
+
+    const port: Port = {
+      config: {
+        apiVersion: 'apiVersion',
+        kind: 'kind',
+        name: 'name',
+        namespace: 'namespace',
+        port: 1234,
+        state: undefined,
+        protocol: '',
+      },
+      metadata: undefined,
+    };
+
+    beforeEach(() => {
+      websocketService = TestBed.get(WebsocketService);
+      spyOn(websocketService, 'sendMessage');
+
+      service.create(port, { localPort: 8080, alias: 'web', persist: true });
+    });
+
+    it('sends the local port, alias and persist option', () => {
+      expect(websocketService.sendMessage).toHaveBeenCalledWith(
+        'startPortForward',
+        {
+          apiVersion: port.config.apiVersion,
+          kind: port.config.kind,
+          name: port.config.name,
+          namespace: port.config.namespace,
+          port: port.config.port,
+          localPort: 8080,
+          alias: 'web',
+          persist: true,
+        }
+      );
+    });
+  });
+
   describe('remove port forward', () => {
     let websocketService: WebsocketService;
 
@@ -8,21 +8,41 @@ import { Port } from 'src/app/models/content';
 import getAPIBase from '../common/getAPIBase';
 import { WebsocketService } from '../../modules/overview/services/websocket/websocket.service';
 
+export interface PortForwardOptions {
+  // localPort is chosen at random when it is not set.
+  localPort?: number;
+  alias?: string;
+  // persist restores the port forward when Lissio restarts.
+  persist?: boolean;
+}
+
 @Injectable({
   providedIn: 'root',
 })
 export class PortForwardService {
   constructor(private websocketService: WebsocketService) {}
 
-  public create(port: Port) {
+  public create(port: Port, options: PortForwardOptions = {}) {
     const config = port.config;
-    this.websocketService.sendMessage('startPortForward', {
+    const payload: { [key: string]: any } = {
       apiVersion: config.apiVersion,
       kind: config.kind,
       name: config.name,
       namespace: config.namespace,
       port: config.port,
-    });
+    };
+
+    if (options.localPort) {
+      payload.localPort = options.localPort;
+    }
+    if (options.alias) {
+      payload.alias = options.alias;
+    }
+    if (options.persist) {
+      payload.persist = true;
+    }
+
+    this.websocketService.sendMessage('startPortForward', payload);
   }
 
   public remove(id: string) {